@@ -1,9 +1,11 @@
 package scale_codec
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 )
 
 var ErrExpectedOneByteRead = errors.New("expected one byte read")
@@ -36,15 +38,11 @@ func (b Bool) MarshalSCALE() ([]byte, error) {
 
 func (b *Bool) UnmarshalSCALE(byteReader io.Reader) error {
 	bValue := make([]byte, 1)
-	n, err := byteReader.Read(bValue)
+	err := readFull(byteReader, bValue)
 	if err != nil {
 		return err
 	}
 
-	if n != 1 {
-		return fmt.Errorf("%w: %v", ErrExpectedOneByteRead, n)
-	}
-
 	switch bValue[0] {
 	case 0x01:
 		b.Value = true
@@ -57,6 +55,18 @@ func (b *Bool) UnmarshalSCALE(byteReader io.Reader) error {
 	return nil
 }
 
+func (b Bool) String() string {
+	return strconv.FormatBool(b.Value)
+}
+
+func (b Bool) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
+}
+
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &b.Value)
+}
+
 type OptionBool struct {
 	*Bool
 }
@@ -75,15 +85,11 @@ func (o OptionBool) MarshalSCALE() ([]byte, error) {
 
 func (o *OptionBool) UnmarshalSCALE(r io.Reader) error {
 	bValue := make([]byte, 1)
-	n, err := r.Read(bValue)
+	err := readFull(r, bValue)
 	if err != nil {
 		return err
 	}
 
-	if n != 1 {
-		return fmt.Errorf("%w: %v", ErrExpectedOneByteRead, n)
-	}
-
 	switch bValue[0] {
 	case 0x00:
 		o.Bool = nil
@@ -97,3 +103,27 @@ func (o *OptionBool) UnmarshalSCALE(r io.Reader) error {
 
 	return nil
 }
+
+func (o OptionBool) String() string {
+	if o.Bool == nil {
+		return "None"
+	}
+	return "Some(" + o.Bool.String() + ")"
+}
+
+func (o OptionBool) MarshalJSON() ([]byte, error) {
+	if o.Bool == nil {
+		return []byte("null"), nil
+	}
+	return o.Bool.MarshalJSON()
+}
+
+func (o *OptionBool) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.Bool = nil
+		return nil
+	}
+
+	o.Bool = new(Bool)
+	return o.Bool.UnmarshalJSON(data)
+}
@@ -0,0 +1,199 @@
+package scale_codec
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"unicode/utf8"
+)
+
+var ErrInvalidUTF8 = errors.New("invalid utf-8 string")
+
+// Bytes is a `Vec<u8>`, the bytes prefixed by their compact encoded length
+type Bytes struct {
+	Value []byte
+}
+
+func BytesFromRawBytes(reader io.Reader) (*Bytes, error) {
+	scaleBytes := new(Bytes)
+	if err := scaleBytes.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+
+	return scaleBytes, nil
+}
+
+func (b Bytes) MarshalSCALE() ([]byte, error) {
+	encodedLength, err := encodeCompactLength(len(b.Value))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.Join([][]byte{encodedLength, b.Value}, nil), nil
+}
+
+func (b *Bytes) UnmarshalSCALE(reader io.Reader) error {
+	value, err := readPrefixedBytes(reader)
+	if err != nil {
+		return err
+	}
+
+	b.Value = value
+	return nil
+}
+
+func (b Bytes) String() string {
+	return "0x" + hex.EncodeToString(b.Value)
+}
+
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	return marshalJSONHex(b.Value)
+}
+
+func (b *Bytes) UnmarshalJSON(data []byte) (err error) {
+	b.Value, err = unmarshalJSONHex(data)
+	return err
+}
+
+// String is encoded as its utf-8 bytes prefixed by their compact encoded length
+type String struct {
+	Value string
+}
+
+func StringFromRawBytes(reader io.Reader) (*String, error) {
+	scaleString := new(String)
+	if err := scaleString.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+
+	return scaleString, nil
+}
+
+func (s String) MarshalSCALE() ([]byte, error) {
+	return Bytes{Value: []byte(s.Value)}.MarshalSCALE()
+}
+
+func (s *String) UnmarshalSCALE(reader io.Reader) error {
+	value, err := readPrefixedBytes(reader)
+	if err != nil {
+		return err
+	}
+
+	if !utf8.Valid(value) {
+		return ErrInvalidUTF8
+	}
+
+	s.Value = string(value)
+	return nil
+}
+
+func (s String) String() string {
+	return strconv.Quote(s.Value)
+}
+
+func (s String) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Value)
+}
+
+func (s *String) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &s.Value)
+}
+
+func readPrefixedBytes(reader io.Reader) ([]byte, error) {
+	length, err := decodeCompactLength(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := new(bytes.Buffer)
+	if err := copyBytes(buffer, reader, length); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// copyBytes copies length bytes, as decoded by decodeCompactLength, from
+// reader to writer, they are copied rather than preallocated in case the
+// length is corrupted
+func copyBytes(writer io.Writer, reader io.Reader, length uint64) error {
+	n, err := io.CopyN(writer, reader, int64(length))
+	if err != nil {
+		return fmt.Errorf("%w: want: %v, got: %v", ErrUnexpectedReadBytes, length, n)
+	}
+
+	return nil
+}
+
+// ByteArray is a `[u8; N]`, encoded as is, the amount of bytes
+// to decode is given by its length
+type ByteArray struct {
+	Value []byte
+}
+
+func NewByteArray(length int) *ByteArray {
+	return &ByteArray{Value: make([]byte, length)}
+}
+
+func UnmarshalByteArrayFromRawBytes(length int) func(reader io.Reader) (*ByteArray, error) {
+	return func(reader io.Reader) (*ByteArray, error) {
+		array := NewByteArray(length)
+		if err := array.UnmarshalSCALE(reader); err != nil {
+			return nil, err
+		}
+
+		return array, nil
+	}
+}
+
+func (b ByteArray) MarshalSCALE() ([]byte, error) {
+	return bytes.Clone(b.Value), nil
+}
+
+func (b *ByteArray) UnmarshalSCALE(reader io.Reader) error {
+	n, err := io.ReadFull(reader, b.Value)
+	if err != nil {
+		return fmt.Errorf("%w: want: %v, got: %v", ErrUnexpectedReadBytes, len(b.Value), n)
+	}
+
+	return nil
+}
+
+func UnmarshalByteArrayFromJSON(length int) func(data []byte) (*ByteArray, error) {
+	return func(data []byte) (*ByteArray, error) {
+		array := NewByteArray(length)
+		if err := array.UnmarshalJSON(data); err != nil {
+			return nil, err
+		}
+
+		return array, nil
+	}
+}
+
+func (b ByteArray) String() string {
+	return "0x" + hex.EncodeToString(b.Value)
+}
+
+func (b ByteArray) MarshalJSON() ([]byte, error) {
+	return marshalJSONHex(b.Value)
+}
+
+// UnmarshalJSON expects as many bytes as the array
+// length, unless the array was not allocated
+func (b *ByteArray) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalJSONHex(data)
+	if err != nil {
+		return err
+	}
+
+	if b.Value != nil && len(value) != len(b.Value) {
+		return fmt.Errorf("%w: want: %v bytes, got: %v", ErrUnexpectedLength, len(b.Value), len(value))
+	}
+
+	b.Value = value
+	return nil
+}
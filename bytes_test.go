@@ -0,0 +1,136 @@
+package scale_codec_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+// hugeLengthPrefix is the compact 2^64 - 1, a length above math.MaxInt64
+var hugeLengthPrefix = []byte{0x13, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+func TestBytes(t *testing.T) {
+	cases := []struct {
+		value   []byte
+		encoded []byte
+	}{
+		{
+			value:   []byte{},
+			encoded: []byte{0},
+		},
+		{
+			value:   []byte{1, 2, 3},
+			encoded: []byte{12, 1, 2, 3},
+		},
+		{
+			value:   bytes.Repeat([]byte{7}, 64),
+			encoded: append([]byte{1, 1}, bytes.Repeat([]byte{7}, 64)...),
+		},
+	}
+
+	for _, tt := range cases {
+		output, err := scale_codec.Bytes{Value: tt.value}.MarshalSCALE()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !bytes.Equal(tt.encoded, output) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.encoded, output)
+		}
+
+		decoded, err := scale_codec.BytesFromRawBytes(bytes.NewReader(tt.encoded))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !bytes.Equal(tt.value, decoded.Value) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.value, decoded.Value)
+		}
+	}
+
+	_, err := scale_codec.BytesFromRawBytes(bytes.NewReader([]byte{12, 1}))
+	if !errors.Is(err, scale_codec.ErrUnexpectedReadBytes) {
+		t.Fatalf("\nexpected: %v\ngot: %v", scale_codec.ErrUnexpectedReadBytes, err)
+	}
+
+	// a 2^64 - 1 length prefix does not fit in an int64
+	_, err = scale_codec.BytesFromRawBytes(bytes.NewReader(hugeLengthPrefix))
+	if !errors.Is(err, scale_codec.ErrUnexpectedLength) {
+		t.Fatalf("\nexpected: %v\ngot: %v", scale_codec.ErrUnexpectedLength, err)
+	}
+}
+
+func TestString(t *testing.T) {
+	cases := []struct {
+		value   string
+		encoded []byte
+	}{
+		{
+			value:   "",
+			encoded: []byte{0},
+		},
+		{
+			value:   "scale",
+			encoded: []byte{20, 's', 'c', 'a', 'l', 'e'},
+		},
+		{
+			value:   strings.Repeat("a", 100),
+			encoded: append([]byte{145, 1}, strings.Repeat("a", 100)...),
+		},
+	}
+
+	for _, tt := range cases {
+		output, err := scale_codec.String{Value: tt.value}.MarshalSCALE()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !bytes.Equal(tt.encoded, output) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.encoded, output)
+		}
+
+		decoded, err := scale_codec.StringFromRawBytes(bytes.NewReader(tt.encoded))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if tt.value != decoded.Value {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.value, decoded.Value)
+		}
+	}
+
+	_, err := scale_codec.StringFromRawBytes(bytes.NewReader([]byte{4, 0xff}))
+	if !errors.Is(err, scale_codec.ErrInvalidUTF8) {
+		t.Fatalf("\nexpected: %v\ngot: %v", scale_codec.ErrInvalidUTF8, err)
+	}
+
+	_, err = scale_codec.StringFromRawBytes(bytes.NewReader(hugeLengthPrefix))
+	if !errors.Is(err, scale_codec.ErrUnexpectedLength) {
+		t.Fatalf("\nexpected: %v\ngot: %v", scale_codec.ErrUnexpectedLength, err)
+	}
+}
+
+func TestByteArray(t *testing.T) {
+	encoded := []byte{1, 2, 3, 4}
+	decoded, err := scale_codec.UnmarshalByteArrayFromRawBytes(4)(bytes.NewReader(encoded))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output, err := decoded.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(encoded, output) {
+		t.Fatalf("\nexpected: %v\ngot: %v", encoded, output)
+	}
+
+	_, err = scale_codec.UnmarshalByteArrayFromRawBytes(8)(bytes.NewReader(encoded))
+	if !errors.Is(err, scale_codec.ErrUnexpectedReadBytes) {
+		t.Fatalf("\nexpected: %v\ngot: %v", scale_codec.ErrUnexpectedReadBytes, err)
+	}
+}
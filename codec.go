@@ -2,6 +2,7 @@ package scale_codec
 
 import (
 	"errors"
+	"fmt"
 	"io"
 )
 
@@ -10,6 +11,7 @@ var ErrUnexpectedResultTag = errors.New("unexpected result tag")
 var ErrCannotEncodeEmptyResult = errors.New("cannot encode empty result")
 var ErrCannotEncodeEmptyOption = errors.New("cannot encode empty option")
 var ErrUnexpectedReadBytes = errors.New("unexpected read bytes")
+var ErrUnexpectedLength = errors.New("unexpected length")
 
 type Marshaler interface {
 	MarshalSCALE() ([]byte, error)
@@ -23,3 +25,15 @@ type Encodable interface {
 	Marshaler
 	Unmarshaler
 }
+
+// readFull reads len(p) bytes from reader however many each Read returns,
+// it returns io.EOF when no byte is left and ErrUnexpectedReadBytes when
+// only some of them are
+func readFull(reader io.Reader, p []byte) error {
+	n, err := io.ReadFull(reader, p)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("%w: want: %v, got: %v", ErrUnexpectedReadBytes, len(p), n)
+	}
+
+	return err
+}
@@ -2,8 +2,10 @@ package scale_codec
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"math/big"
 	"math/bits"
 
@@ -17,6 +19,10 @@ const (
 	BigIntegerModeMask uint8 = 0b11000000
 )
 
+// ErrNonCanonicalCompact is returned when a compact integer is not encoded
+// in the shortest mode holding it, as parity-scale-codec rejects them too
+var ErrNonCanonicalCompact = errors.New("non canonical compact")
+
 type CompactMode uint8
 
 const (
@@ -196,7 +202,7 @@ func checkCompactMode(b uint8) CompactMode {
 
 func (c *Compact) UnmarshalSCALE(reader io.Reader) error {
 	fstByte := make([]byte, 1)
-	_, err := reader.Read(fstByte)
+	err := readFull(reader, fstByte)
 	if err != nil {
 		return fmt.Errorf("reading first compact byte: %w", err)
 	}
@@ -213,7 +219,7 @@ func (c *Compact) UnmarshalSCALE(reader io.Reader) error {
 	case TwoByteMode:
 		integer := &Integer[uint16]{}
 		nextByte := make([]byte, 1)
-		_, err := reader.Read(nextByte)
+		err := readFull(reader, nextByte)
 		if err != nil {
 			return err
 		}
@@ -229,11 +235,15 @@ func (c *Compact) UnmarshalSCALE(reader io.Reader) error {
 			return err
 		}
 
+		if integer.Value <= 0b0011_1111 {
+			return fmt.Errorf("%w: %v in two byte mode", ErrNonCanonicalCompact, integer.Value)
+		}
+
 		c.Value = &CompactInteger[uint16]{integer.Value}
 	case FourByteMode:
 		integer := &Integer[uint32]{}
 		nextBytes := make([]byte, 3)
-		_, err := reader.Read(nextBytes)
+		err := readFull(reader, nextBytes)
 		if err != nil {
 			return err
 		}
@@ -251,27 +261,42 @@ func (c *Compact) UnmarshalSCALE(reader io.Reader) error {
 			return err
 		}
 
+		if integer.Value <= 0b0011_1111_1111_1111 {
+			return fmt.Errorf("%w: %v in four byte mode", ErrNonCanonicalCompact, integer.Value)
+		}
+
 		c.Value = &CompactInteger[uint32]{integer.Value}
 	case BigIntegerMode:
-		amountOfNextBytes := (fstByte[0] >> 2) + 4
+		amountOfNextBytes := int(fstByte[0]>>2) + 4
 		nextBytes := make([]byte, amountOfNextBytes)
-		_, err := reader.Read(nextBytes)
+		err := readFull(reader, nextBytes)
 		if err != nil {
 			return err
 		}
 
+		// the value bytes are little endian, the last one is not zero when
+		// there are more than 4 and 4 bytes hold values above 2^30 - 1
+		if nextBytes[amountOfNextBytes-1] == 0 && amountOfNextBytes > 4 {
+			return fmt.Errorf("%w: %d bytes in big integer mode", ErrNonCanonicalCompact, amountOfNextBytes)
+		}
+
+		// they are padded with zeroes up to the size of the integer that holds them
 		switch {
-		case amountOfNextBytes < 8:
+		case amountOfNextBytes == 4:
 			integer := &Integer[uint32]{}
 			err = integer.UnmarshalSCALE(bytes.NewReader(nextBytes))
 			if err != nil {
 				return err
 			}
+			if integer.Value <= 0b0011_1111_1111_1111_1111_1111_1111_1111 {
+				return fmt.Errorf("%w: %v in big integer mode", ErrNonCanonicalCompact, integer.Value)
+			}
+
 			c.Value = &CompactInteger[uint32]{integer.Value}
 			return nil
-		case amountOfNextBytes == 8:
+		case amountOfNextBytes <= 8:
 			integer := &Integer[uint64]{}
-			err = integer.UnmarshalSCALE(bytes.NewReader(nextBytes))
+			err = integer.UnmarshalSCALE(bytes.NewReader(padCompactBytes(nextBytes, 8)))
 			if err != nil {
 				return err
 			}
@@ -279,7 +304,7 @@ func (c *Compact) UnmarshalSCALE(reader io.Reader) error {
 			return nil
 		default:
 			u128Value := new(U128)
-			err = u128Value.UnmarshalSCALE(bytes.NewReader(nextBytes))
+			err = u128Value.UnmarshalSCALE(bytes.NewReader(padCompactBytes(nextBytes, 16)))
 			if err != nil {
 				return err
 			}
@@ -293,3 +318,95 @@ func (c *Compact) UnmarshalSCALE(reader io.Reader) error {
 
 	return nil
 }
+
+func CompactFromRawBytes(reader io.Reader) (*Compact, error) {
+	compact := new(Compact)
+	if err := compact.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+
+	return compact, nil
+}
+
+func (c Compact) String() string {
+	return c.toBigInt().String()
+}
+
+func (c Compact) MarshalJSON() ([]byte, error) {
+	return marshalJSONInteger(c.toBigInt(), 16)
+}
+
+// UnmarshalJSON stores the value as UnmarshalSCALE would
+// decode it, in the smallest integer that holds it
+func (c *Compact) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalJSONInteger(data, 16, false)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := Compact{Value: &CompactBigInt{Value: value}}.MarshalSCALE()
+	if err != nil {
+		return err
+	}
+
+	return c.UnmarshalSCALE(bytes.NewReader(encoded))
+}
+
+// padCompactBytes appends zeroes to the little endian value bytes up to size
+func padCompactBytes(value []byte, size int) []byte {
+	if len(value) >= size {
+		return value
+	}
+
+	return append(value, make([]byte, size-len(value))...)
+}
+
+func (c Compact) toBigInt() *big.Int {
+	switch value := c.Value.(type) {
+	case *CompactInteger[uint8]:
+		return new(big.Int).SetUint64(uint64(value.Value))
+	case *CompactInteger[uint16]:
+		return new(big.Int).SetUint64(uint64(value.Value))
+	case *CompactInteger[uint32]:
+		return new(big.Int).SetUint64(uint64(value.Value))
+	case *CompactInteger[uint64]:
+		return new(big.Int).SetUint64(value.Value)
+	case *CompactBigInt:
+		return new(big.Int).Set(value.Value)
+	default:
+		return new(big.Int)
+	}
+}
+
+// encodeCompactLength encodes the length prefix of sequences, strings and maps
+func encodeCompactLength(length int) ([]byte, error) {
+	return Compact{Value: &CompactInteger[uint64]{Value: uint64(length)}}.MarshalSCALE()
+}
+
+// decodeCompactLength decodes the length prefix of sequences, strings and
+// maps. The length is not trusted, it is never used to preallocate, bytes
+// are copied by copyBytes and items appended one by one, so a corrupted
+// prefix fails on the missing bytes rather than on a huge allocation, and
+// it is at most math.MaxInt64 so it converts to an int64 without wrapping
+func decodeCompactLength(reader io.Reader) (uint64, error) {
+	compact := new(Compact)
+	if err := compact.UnmarshalSCALE(reader); err != nil {
+		return 0, err
+	}
+
+	switch value := compact.Value.(type) {
+	case *CompactInteger[uint8]:
+		return uint64(value.Value), nil
+	case *CompactInteger[uint16]:
+		return uint64(value.Value), nil
+	case *CompactInteger[uint32]:
+		return uint64(value.Value), nil
+	case *CompactInteger[uint64]:
+		if value.Value > math.MaxInt64 {
+			return 0, fmt.Errorf("%w: length %v is too large", ErrUnexpectedLength, value.Value)
+		}
+		return value.Value, nil
+	default:
+		return 0, fmt.Errorf("%w: length does not fit in 64 bits", ErrUnexpectedLength)
+	}
+}
@@ -2,6 +2,7 @@ package scale_codec_test
 
 import (
 	"bytes"
+	"errors"
 	"math/big"
 	"reflect"
 	"testing"
@@ -264,12 +265,24 @@ func TestCompactUnmarshal(t *testing.T) {
 				Value: 1073741824,
 			},
 		},
+		{
+			inputBytes: []byte{7, 0, 0, 0, 0, 1},
+			expected: &scale_codec.CompactInteger[uint64]{
+				Value: 1 << 32,
+			},
+		},
 		{
 			inputBytes: []byte{19, 255, 255, 255, 255, 255, 255, 255, 255},
 			expected: &scale_codec.CompactInteger[uint64]{
 				Value: ^uint64(0),
 			},
 		},
+		{
+			inputBytes: []byte{23, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+			expected: &scale_codec.CompactBigInt{
+				Value: new(big.Int).Lsh(big.NewInt(1), 64),
+			},
+		},
 		{
 			inputBytes: []byte{51, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
 			expected: &scale_codec.CompactBigInt{
@@ -290,3 +303,26 @@ func TestCompactUnmarshal(t *testing.T) {
 		}
 	}
 }
+
+func TestCompactUnmarshalNonCanonical(t *testing.T) {
+	cases := [][]byte{
+		// 12 in two byte mode
+		{0x31, 0x00},
+		// 12 in four byte mode
+		{0x32, 0x00, 0x00, 0x00},
+		// 16383 in four byte mode
+		{0xfe, 0xff, 0x00, 0x00},
+		// 1 in big integer mode
+		{0x03, 0x01, 0x00, 0x00, 0x00},
+		// 2^32 with a trailing zero byte
+		{0x0b, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00},
+	}
+
+	for _, input := range cases {
+		compact := &scale_codec.Compact{}
+		err := compact.UnmarshalSCALE(bytes.NewReader(input))
+		if !errors.Is(err, scale_codec.ErrNonCanonicalCompact) {
+			t.Fatalf("\nexpected: %v\ngot: %v\nfor input: %v", scale_codec.ErrNonCanonicalCompact, err, input)
+		}
+	}
+}
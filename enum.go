@@ -4,7 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 	"text/scanner"
+	"unicode/utf8"
 )
 
 //go:generate goyacc -l -o enum_parser.go enum_parser.y
@@ -22,71 +25,396 @@ func (*SimpleVariant) UnmarshalSCALE(_ io.Reader) error {
 }
 
 type yySymType struct {
-	unmarshalScale            string
-	tupleValuesTypes          []string
-	tupleValuesUnmarshalScale []string
-	ttype                     string
-	sval                      string
-	fromRawBytesFunc          string
-	enum                      Enum
-	enumField                 EnumField
-	enumFields                []EnumField
-	yys                       int
-}
-
-func ParseEnum(filename string, src io.Reader) int {
+	sval       string
+	pos        scanner.Position
+	doc        string
+	enum       *EnumDecl
+	variant    *VariantDecl
+	variants   []*VariantDecl
+	typeExpr   *TypeExpr
+	typeExprs  []*TypeExpr
+	structDecl *StructDecl
+	field      *FieldDecl
+	fields     []*FieldDecl
+	index      *explicitIndex
+	params     []*TypeParam
+	imp        *ImportDecl
+	alias      *AliasDecl
+	newtype    *NewtypeDecl
+	yys        int
+}
+
+// explicitIndex is a variant index set with `@index(N)` or `= N`
+type explicitIndex struct {
+	pos   scanner.Position
+	value int
+}
+
+func setVariantIndex(variant *VariantDecl, index *explicitIndex) {
+	if index == nil {
+		return
+	}
+
+	variant.Index = index.value
+	variant.IndexPos = index.pos
+	variant.ExplicitIndex = true
+}
+
+func init() {
+	// the verbose messages carry the expected tokens which
+	// are used to build the syntax errors reported to the user
+	yyErrorVerbose = true
+}
+
+// ParseSchema parses a .scale file and the files it imports, every syntax
+// error found is reported in the returned SchemaErrors, semantic errors are
+// only checked once the files are syntactically valid
+func ParseSchema(filename string, src io.Reader) (*Schema, error) {
+	return newSchemaLoader().parse(filename, src)
+}
+
+// ParseSchemaSyntax parses a single .scale file without loading its imports
+// nor checking its declarations, only syntax errors are reported
+func ParseSchemaSyntax(filename string, src io.Reader) (*Schema, error) {
 	lexer := newLexer(filename, src)
-	return yyParse(lexer)
+	yyParse(lexer)
+	return lexer.schema, lexer.errs.err()
+}
+
+// ParseEnum parses a .scale file filling Enums, Structs, Newtypes, GenericTuple,
+// Imports and ImportedSchemas with the definitions used to generate the Go code
+func ParseEnum(filename string, src io.Reader) error {
+	schema, err := ParseSchema(filename, src)
+	if err != nil {
+		return err
+	}
+
+	resolver, err := newGoTypeResolver(schema)
+	if err != nil {
+		return err
+	}
+
+	Enums = resolver.lowerEnums(schema)
+	Structs = resolver.lowerStructs(schema)
+	Newtypes = resolver.lowerNewtypes(schema)
+	GenericTuple = resolver.tuples
+	Imports = resolver.usedImports
+	ImportedSchemas = resolver.lowerImportedSchemas()
+	return nil
+}
+
+// unknownToken is returned for scanned tokens the grammar has no use for,
+// the parser then reports it as unexpected
+const unknownToken = utf8.RuneError
+
+type lexContext struct {
+	closer      rune
+	description string
 }
 
 type lexer struct {
-	s   scanner.Scanner
-	err error
+	s      scanner.Scanner
+	schema *Schema
+	errs   SchemaErrors
+
+	// position, text and kind of the last token sent to the parser,
+	// the kind of the token preceding it and the keyword of the
+	// declaration being parsed
+	pos   scanner.Position
+	text  string
+	token int
+	prev  int
+	decl  int
+
+	// contexts is the stack of open delimiters, it describes
+	// what construct a missing closing delimiter belongs to
+	contexts []lexContext
+
+	// typeParams are the type parameters of the declaration being parsed
+	typeParams []*TypeParam
+
+	// doc holds the lines of the `///` comments read since the last token,
+	// they document the declaration, variant or field starting with the next one
+	doc []string
+
+	// start is the token sent before the source, TYPEEXPR when the source
+	// is a type expression, which is then parsed into typeExpr
+	start    int
+	typeExpr *TypeExpr
 }
 
 func newLexer(filename string, src io.Reader) *lexer {
-	var s scanner.Scanner
-	s.Init(src)
-	s.Filename = filename
+	l := &lexer{schema: &Schema{Filename: filename}}
+	l.s.Init(src)
+	l.s.Filename = filename
+	// comments are kept in the schema for the formatter
+	l.s.Mode &^= scanner.SkipComments
+	l.s.Error = func(s *scanner.Scanner, msg string) {
+		l.errs.add(s.Pos(), "%s", msg)
+	}
 
-	return &lexer{s: s}
+	return l
 }
 
 func (l *lexer) Error(msg string) {
-	l.err = errors.New(msg)
+	l.errs.add(l.pos, "%s", l.syntaxError(msg))
+}
+
+// syntaxError rewrites a goyacc message such as
+// `syntax error: unexpected ",", expecting ">"` into
+// `expected '>' after Option type, found ','`
+func (l *lexer) syntaxError(msg string) string {
+	found := "end of file"
+	if l.token > 0 {
+		found = fmt.Sprintf("'%s'", l.text)
+	}
+
+	_, expecting, ok := strings.Cut(msg, ", expecting ")
+	if !ok {
+		return "unexpected " + found
+	}
+
+	tokens := strings.Split(expecting, " or ")
+	closesContext := false
+	for idx, token := range tokens {
+		tokens[idx] = describeToken(token)
+		if len(l.contexts) > 0 &&
+			tokens[idx] == fmt.Sprintf("'%c'", l.contexts[len(l.contexts)-1].closer) {
+			closesContext = true
+		}
+	}
+
+	expected := strings.Join(tokens, " or ")
+	if closesContext {
+		expected += " after " + l.contexts[len(l.contexts)-1].description
+	}
+
+	return fmt.Sprintf("expected %s, found %s", expected, found)
+}
+
+func describeToken(name string) string {
+	switch name {
+	case "$end":
+		return "end of file"
+	case "ENUM":
+		return "'enum'"
+	case "STRUCT":
+		return "'struct'"
+	case "TYPEALIAS":
+		return "'type'"
+	case "NEWTYPE":
+		return "'newtype'"
+	case "IMPORT":
+		return "'import'"
+	case "AS":
+		return "'as'"
+	case "STRING":
+		return "string"
+	case "OPTION":
+		return "'Option'"
+	case "RESULT":
+		return "'Result'"
+	case "VEC":
+		return "'Vec'"
+	case "COMPACT":
+		return "'Compact'"
+	case "BTREEMAP":
+		return "'BTreeMap'"
+	case "BTREESET":
+		return "'BTreeSet'"
+	case "IDENTIFIER":
+		return "identifier"
+	case "TYPE":
+		return "type"
+	case "INTEGER":
+		return "integer"
+	default:
+		return "'" + strings.Trim(name, `"`) + "'"
+	}
 }
 
 func (l *lexer) Lex(lval *yySymType) int {
+	// the context is only updated once the parser asks for the next
+	// token, so a syntax error reports the delimiters open before it
+	l.trackContext()
+
+	if start := l.start; start != 0 {
+		l.start = 0
+		return start
+	}
+
 	token := l.s.Scan()
+	for token == scanner.Comment {
+		text := l.s.TokenText()
+		l.schema.Comments = append(l.schema.Comments, &Comment{Pos: l.s.Position, Text: text})
+		if line, ok := docLine(text); ok {
+			l.doc = append(l.doc, line)
+		}
+		token = l.s.Scan()
+	}
+
+	lval.pos = l.s.Position
+	lval.doc, l.doc = strings.Join(l.doc, "\n"), nil
 	if token == scanner.EOF {
+		l.pos, l.text, l.token, l.prev = l.s.Pos(), "", -1, l.token
 		return -1
 	}
 
 	lexeme := l.s.TokenText()
+	next := l.classify(token, lexeme, lval)
+
+	l.pos, l.text, l.token, l.prev = l.s.Position, lexeme, next, l.token
+	return next
+}
+
+// docLine returns the text of a `/// doc` comment, without the slashes
+// and the space following them. `////` starts a regular comment as in Rust
+func docLine(comment string) (string, bool) {
+	if !strings.HasPrefix(comment, "///") || strings.HasPrefix(comment, "////") {
+		return "", false
+	}
+
+	return strings.TrimPrefix(comment[3:], " "), true
+}
 
+func (l *lexer) classify(token rune, lexeme string, lval *yySymType) int {
 	switch lexeme {
 	case "enum":
+		l.decl = ENUM
 		return ENUM
-	case "{", "}", "(", ")", "<", ">", ",":
+	case "struct":
+		l.decl = STRUCT
+		return STRUCT
+	case "type":
+		l.decl = TYPEALIAS
+		return TYPEALIAS
+	case "newtype":
+		l.decl = NEWTYPE
+		return NEWTYPE
+	case "import":
+		return IMPORT
+	case "as":
+		return AS
+	case "{", "}", "(", ")", "<", ">", "[", "]", ",", ".", ":", ";", "@", "=":
 		return int(rune(lexeme[0]))
-	case "int8", "uint8", "int16", "uint16",
-		"int32", "uint32", "int64", "uint64":
-		lval.sval = fmt.Sprintf("scale_codec.Integer[%s]", lexeme)
-		lval.fromRawBytesFunc = fmt.Sprintf(
-			"scale_codec.IntegerFromRawBytes[%s]", lexeme)
-		return TYPE
-	case "bool":
-		lval.sval = "scale_codec.Bool"
-		lval.fromRawBytesFunc = "scale_codec.BoolFromRawBytes"
+	case "int8", "uint8", "int16", "uint16", "int32", "uint32",
+		"int64", "uint64", "int128", "uint128",
+		"i8", "u8", "i16", "u16", "i32", "u32", "i64", "u64", "i128", "u128",
+		"bool", "String", "Bytes":
+		lval.sval = lexeme
 		return TYPE
+	case "Vec":
+		lval.sval = lexeme
+		return VEC
+	case "Compact":
+		lval.sval = lexeme
+		return COMPACT
+	case "BTreeMap":
+		lval.sval = lexeme
+		return BTREEMAP
+	case "BTreeSet":
+		lval.sval = lexeme
+		return BTREESET
 	case "Option":
 		lval.sval = lexeme
 		return OPTION
 	case "Result":
 		lval.sval = lexeme
 		return RESULT
-	default:
+	}
+
+	switch {
+	case token == scanner.Ident:
 		lval.sval = lexeme
 		return IDENTIFIER
+	case token == scanner.Int:
+		lval.sval = lexeme
+		return INTEGER
+	case token == scanner.String:
+		lval.sval = lexeme
+		return STRING
+	case token < 0:
+		return unknownToken
+	default:
+		return int(token)
+	}
+}
+
+func (l *lexer) parseInt(pos scanner.Position, lexeme string) int {
+	value, err := strconv.ParseInt(lexeme, 0, 64)
+	if err != nil {
+		l.errs.add(pos, "invalid integer %s", lexeme)
+	}
+
+	return int(value)
+}
+
+func (l *lexer) unquote(pos scanner.Position, lexeme string) string {
+	value, err := strconv.Unquote(lexeme)
+	if err != nil {
+		l.errs.add(pos, "invalid string %s", lexeme)
+	}
+
+	return value
+}
+
+func (l *lexer) isTypeParam(name string) bool {
+	for _, param := range l.typeParams {
+		if param.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (l *lexer) trackContext() {
+	switch token := l.token; token {
+	case '{':
+		description := "enum variants"
+		switch {
+		case l.decl == STRUCT:
+			description = "struct fields"
+		case len(l.contexts) > 0:
+			description = "variant fields"
+		}
+		l.contexts = append(l.contexts, lexContext{'}', description})
+	case '<':
+		description := "Option type"
+		switch l.prev {
+		case RESULT:
+			description = "Result types"
+		case VEC:
+			description = "Vec type"
+		case COMPACT:
+			description = "Compact type"
+		case BTREEMAP:
+			description = "BTreeMap types"
+		case BTREESET:
+			description = "BTreeSet type"
+		case IDENTIFIER:
+			description = "type arguments"
+			if len(l.contexts) == 0 {
+				description = "type parameters"
+			}
+		}
+		l.contexts = append(l.contexts, lexContext{'>', description})
+	case '[':
+		l.contexts = append(l.contexts, lexContext{']', "array type"})
+	case '(':
+		description := "tuple elements"
+		switch {
+		case l.prev == IDENTIFIER && len(l.contexts) == 0 && l.decl == NEWTYPE:
+			description = "newtype"
+		case l.prev == IDENTIFIER && len(l.contexts) == 0:
+			description = "tuple struct fields"
+		case l.prev == IDENTIFIER:
+			description = "variant payload"
+		}
+		l.contexts = append(l.contexts, lexContext{')', description})
+	case '}', '>', ')', ']':
+		if len(l.contexts) > 0 && l.contexts[len(l.contexts)-1].closer == rune(token) {
+			l.contexts = l.contexts[:len(l.contexts)-1]
+		}
 	}
 }
@@ -0,0 +1,651 @@
+package scale_codec
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// goType holds the Go expressions used by the generated code to declare,
+// instantiate and decode a schema type
+type goType struct {
+	typ          string
+	constructor  string
+	fromRawBytes string
+
+	// fromJSON is the decoder of the type JSON, `func([]byte) (T, error)`
+	// like fromRawBytes, through scale_codec.FromJSON for pointer types
+	fromJSON string
+
+	// unmarshalFuncs are the decoders expected by the UnmarshalSCALE
+	// method of generic containers such as OptionG, ResultG and tuples
+	unmarshalFuncs []string
+
+	// packedArgs is set for the tuples and the options of unnamed types,
+	// whose UnmarshalSCALE call lists the decoders without spaces
+	packedArgs bool
+
+	// byValue is set for enum interfaces and type parameters, they have
+	// no UnmarshalSCALE method so the value is replaced by the decoded one
+	byValue bool
+
+	// unitEnum is set for the enums generated as an uint8
+	unitEnum bool
+
+	// random builds random values of the type in the generated tests,
+	// it is a scale_codec.RandomFunc or a generator function of the tests
+	random string
+}
+
+type goTypeResolver struct {
+	// map of tuple name and tuple qty of values, it is shared with the
+	// resolvers of the imported schemas as every package declares its tuples
+	tuples map[string]int
+
+	// structs, newtypes and unit-only enums are referenced through
+	// pointers while the other enums are referenced through their interface
+	structs   map[string]bool
+	unitEnums map[string]bool
+
+	schema *Schema
+
+	// qualifier and goPath are the import name and the Go package of an
+	// imported schema, both are empty for the schema being generated
+	qualifier string
+	goPath    string
+
+	// imports resolves the types qualified by an import name, usedImports
+	// is shared by all the resolvers and collects the Go packages referenced
+	imports     map[string]*goTypeResolver
+	usedImports map[string]string
+
+	// tuplePackage prefixes the generators of the tuples in the generated
+	// tests, it is set when the tuples are declared by an imported package
+	tuplePackage string
+
+	// finite holds the variants the generated tests build at the maximum
+	// depth, it is shared by all the resolvers
+	finite map[*VariantDecl]bool
+}
+
+func newGoTypeResolver(schema *Schema) (*goTypeResolver, error) {
+	r, err := newImportResolver(schema, "", "", make(map[string]int), make(map[string]string))
+	if err != nil {
+		return nil, err
+	}
+
+	r.setFinite(finiteVariants(schema))
+	return r, nil
+}
+
+func (r *goTypeResolver) setFinite(finite map[*VariantDecl]bool) {
+	r.finite = finite
+	for _, imported := range r.imports {
+		imported.setFinite(finite)
+	}
+}
+
+func newImportResolver(schema *Schema, qualifier, goPath string,
+	tuples map[string]int, usedImports map[string]string) (*goTypeResolver, error) {
+	structs := make(map[string]bool, len(schema.Structs)+len(schema.Newtypes)+len(schema.Enums))
+	for _, structDecl := range schema.Structs {
+		structs[structDecl.Name] = true
+	}
+
+	for _, newtype := range schema.Newtypes {
+		structs[newtype.Name] = true
+	}
+
+	unitEnums := make(map[string]bool)
+	for _, enum := range schema.Enums {
+		if enum.unitOnly() {
+			structs[enum.Name] = true
+			unitEnums[enum.Name] = true
+		}
+	}
+
+	r := &goTypeResolver{
+		tuples:      tuples,
+		structs:     structs,
+		unitEnums:   unitEnums,
+		schema:      schema,
+		qualifier:   qualifier,
+		goPath:      goPath,
+		imports:     make(map[string]*goTypeResolver, len(schema.Imports)),
+		usedImports: usedImports,
+	}
+
+	for _, imp := range schema.Imports {
+		importPath, err := goImportPath(imp.Schema.Filename)
+		if err != nil {
+			return nil, SchemaErrors{{Pos: imp.Pos, Msg: err.Error()}}
+		}
+
+		r.imports[imp.Name], err = newImportResolver(imp.Schema, imp.Name, importPath, tuples, usedImports)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// qualified returns the Go name of an identifier declared by the resolver
+// schema, prefixed by its package when the schema is imported
+func (r *goTypeResolver) qualified(name string) string {
+	if r.qualifier == "" {
+		return name
+	}
+
+	r.usedImports[r.qualifier] = r.goPath
+	return r.qualifier + "." + name
+}
+
+func (r *goTypeResolver) resolve(t *TypeExpr) goType {
+	switch t.Kind {
+	case PrimitiveType:
+		return primitiveGoType(t.Name)
+	case NamedType:
+		owner := r
+		if t.Package != "" {
+			owner = r.imports[t.Package]
+		}
+
+		if len(t.Args) > 0 {
+			return r.resolveGeneric(owner, t)
+		}
+
+		if alias := owner.schema.alias(t.Name); alias != nil {
+			return owner.resolve(alias.Type)
+		}
+
+		name := owner.qualified(t.Name)
+		if owner.structs[t.Name] {
+			return goType{
+				typ:          "*" + name,
+				constructor:  "new(" + name + ")",
+				fromRawBytes: owner.qualified("Unmarshal" + t.Name),
+				fromJSON:     fromJSON(name),
+				unitEnum:     owner.unitEnums[t.Name],
+				random:       owner.randomName(t.Name),
+			}
+		}
+
+		return goType{
+			typ:          name,
+			constructor:  "nil",
+			fromRawBytes: owner.qualified("Unmarshal" + t.Name),
+			fromJSON:     owner.qualified("Unmarshal" + t.Name + "JSON"),
+			byValue:      true,
+			random:       owner.randomName(t.Name),
+		}
+	case ParamType:
+		// the decoder of a type parameter T is the funcT
+		// argument of the generic type UnmarshalSCALE
+		return goType{
+			typ:          t.Name,
+			constructor:  "*new(" + t.Name + ")",
+			fromRawBytes: "func" + t.Name,
+			fromJSON:     "json" + t.Name,
+			byValue:      true,
+			random:       "random" + t.Name,
+		}
+	case OptionType:
+		inner := r.resolve(t.Args[0])
+		option := "scale_codec.OptionG[" + inner.typ + "]"
+		return goType{
+			typ:         "*" + option,
+			constructor: "new(" + option + ")",
+			fromRawBytes: "scale_codec.UnmarshalOptionFromRawBytes[" + inner.typ + "](" +
+				inner.fromRawBytes + ")",
+			fromJSON: "scale_codec.UnmarshalOptionFromJSON[" + inner.typ + "](" +
+				inner.fromJSON + ")",
+			unmarshalFuncs: []string{inner.fromRawBytes},
+			packedArgs:     t.Args[0].Kind != NamedType,
+			random:         "scale_codec.RandomOption(" + inner.random + ")",
+		}
+	case ResultType:
+		ok, err := r.resolve(t.Args[0]), r.resolve(t.Args[1])
+		result := "scale_codec.ResultG[" + ok.typ + "," + err.typ + "]"
+		return goType{
+			typ:         "*" + result,
+			constructor: "new(" + result + ")",
+			fromRawBytes: "scale_codec.UnmarshalResultFromRawBytes[" + ok.typ + "," + err.typ + "](" +
+				ok.fromRawBytes + "," + err.fromRawBytes + ")",
+			fromJSON: "scale_codec.UnmarshalResultFromJSON[" + ok.typ + "," + err.typ + "](" +
+				ok.fromJSON + "," + err.fromJSON + ")",
+			unmarshalFuncs: []string{ok.fromRawBytes, err.fromRawBytes},
+			random:         "scale_codec.RandomResult(" + ok.random + ", " + err.random + ")",
+		}
+	case TupleType:
+		types := make([]string, len(t.Args))
+		funcs := make([]string, len(t.Args))
+		jsonFuncs := make([]string, len(t.Args))
+		randoms := make([]string, len(t.Args))
+		for idx, arg := range t.Args {
+			item := r.resolve(arg)
+			types[idx] = item.typ
+			funcs[idx] = item.fromRawBytes
+			jsonFuncs[idx] = item.fromJSON
+			randoms[idx] = item.random
+		}
+
+		name := fmt.Sprintf("T%d", len(t.Args))
+		r.tuples[name] = len(t.Args)
+
+		tuple := name + "[" + strings.Join(types, ",") + "]"
+		return goType{
+			typ:         "*" + tuple,
+			constructor: "new(" + tuple + ")",
+			fromRawBytes: "Unmarshal" + name + "FromRawBytes[" + strings.Join(types, ",") + "](" +
+				strings.Join(funcs, ",") + ")",
+			fromJSON: "Unmarshal" + name + "FromJSON[" + strings.Join(types, ",") + "](" +
+				strings.Join(jsonFuncs, ",") + ")",
+			unmarshalFuncs: funcs,
+			packedArgs:     true,
+			random:         "random" + r.tuplePackage + name + "(" + strings.Join(randoms, ", ") + ")",
+		}
+	case UnitType:
+		return goType{
+			typ:          "*scale_codec.Unit",
+			constructor:  "new(scale_codec.Unit)",
+			fromRawBytes: "scale_codec.UnitFromRawBytes",
+			fromJSON:     fromJSON("scale_codec.Unit"),
+			random:       "scale_codec.RandomUnit",
+		}
+	case VecType, SetType:
+		if t.Kind == VecType && isByte(r.schema.expandAlias(t.Args[0])) {
+			return primitiveGoType("Bytes")
+		}
+
+		// a BTreeSet is a SetG, which sorts its items
+		generic := "Vec"
+		if t.Kind == SetType {
+			generic = "Set"
+		}
+
+		inner := r.resolve(t.Args[0])
+		vec := "scale_codec." + generic + "G[" + inner.typ + "]"
+		return goType{
+			typ:         "*" + vec,
+			constructor: "new(" + vec + ")",
+			fromRawBytes: "scale_codec.Unmarshal" + generic + "FromRawBytes[" + inner.typ + "](" +
+				inner.fromRawBytes + ")",
+			fromJSON: "scale_codec.Unmarshal" + generic + "FromJSON[" + inner.typ + "](" +
+				inner.fromJSON + ")",
+			unmarshalFuncs: []string{inner.fromRawBytes},
+			random:         "scale_codec.Random" + generic + "(" + inner.random + ")",
+		}
+	case ArrayType:
+		if isByte(r.schema.expandAlias(t.Args[0])) {
+			return goType{
+				typ:          "*scale_codec.ByteArray",
+				constructor:  fmt.Sprintf("scale_codec.NewByteArray(%d)", t.Len),
+				fromRawBytes: fmt.Sprintf("scale_codec.UnmarshalByteArrayFromRawBytes(%d)", t.Len),
+				fromJSON:     fmt.Sprintf("scale_codec.UnmarshalByteArrayFromJSON(%d)", t.Len),
+				random:       fmt.Sprintf("scale_codec.RandomByteArray(%d)", t.Len),
+			}
+		}
+
+		inner := r.resolve(t.Args[0])
+		return goType{
+			typ:         "*scale_codec.ArrayG[" + inner.typ + "]",
+			constructor: fmt.Sprintf("scale_codec.NewArrayG[%s](%d)", inner.typ, t.Len),
+			fromRawBytes: fmt.Sprintf("scale_codec.UnmarshalArrayFromRawBytes[%s](%d, %s)",
+				inner.typ, t.Len, inner.fromRawBytes),
+			fromJSON: fmt.Sprintf("scale_codec.UnmarshalArrayFromJSON[%s](%d, %s)",
+				inner.typ, t.Len, inner.fromJSON),
+			unmarshalFuncs: []string{inner.fromRawBytes},
+			random:         fmt.Sprintf("scale_codec.RandomArray(%d, %s)", t.Len, inner.random),
+		}
+	case CompactType:
+		return goType{
+			typ:          "*scale_codec.Compact",
+			constructor:  "new(scale_codec.Compact)",
+			fromRawBytes: "scale_codec.CompactFromRawBytes",
+			fromJSON:     fromJSON("scale_codec.Compact"),
+			random:       "scale_codec.RandomCompact",
+		}
+	case MapType:
+		key, value := r.resolve(t.Args[0]), r.resolve(t.Args[1])
+		m := "scale_codec.MapG[" + key.typ + "," + value.typ + "]"
+		return goType{
+			typ:         "*" + m,
+			constructor: "new(" + m + ")",
+			fromRawBytes: "scale_codec.UnmarshalMapFromRawBytes[" + key.typ + "," + value.typ + "](" +
+				key.fromRawBytes + "," + value.fromRawBytes + ")",
+			fromJSON: "scale_codec.UnmarshalMapFromJSON[" + key.typ + "," + value.typ + "](" +
+				key.fromJSON + "," + value.fromJSON + ")",
+			unmarshalFuncs: []string{key.fromRawBytes, value.fromRawBytes},
+			random:         "scale_codec.RandomMap(" + key.random + ", " + value.random + ")",
+		}
+	default:
+		panic(fmt.Sprintf("unexpected type kind: %d", t.Kind))
+	}
+}
+
+// primitiveCodecs are the library types of the primitives
+// that are not encoded through the generic Integer
+var primitiveCodecs = map[string]string{
+	"bool":    "Bool",
+	"String":  "String",
+	"Bytes":   "Bytes",
+	"uint128": "U128",
+	"int128":  "I128",
+}
+
+// randomName is the generator function of the generated tests building
+// values of the type declared with name, `randomCall` for the types of the
+// schema and `randomCommonOrigin` for the types of an imported schema
+func (r *goTypeResolver) randomName(name string) string {
+	return "random" + goFieldName(r.qualifier) + name
+}
+
+// resolveGeneric instantiates a generic enum or struct declared in the owner
+// schema, its decoder is built by a factory taking the decoders of the type
+// arguments, which are resolved in the scope of the reference
+func (r *goTypeResolver) resolveGeneric(owner *goTypeResolver, t *TypeExpr) goType {
+	types := make([]string, len(t.Args))
+	funcs := make([]string, len(t.Args))
+	jsonFuncs := make([]string, len(t.Args))
+	randoms := make([]string, len(t.Args))
+	for idx, arg := range t.Args {
+		item := r.resolve(arg)
+		types[idx] = item.typ
+		funcs[idx] = item.fromRawBytes
+		jsonFuncs[idx] = item.fromJSON
+		randoms[idx] = item.random
+	}
+
+	random := owner.randomName(t.Name) + "(" + strings.Join(randoms, ", ") + ")"
+	instance := owner.qualified(t.Name) + "[" + strings.Join(types, ",") + "]"
+	fromRawBytes := owner.qualified("Unmarshal"+t.Name+"FromRawBytes") +
+		"[" + strings.Join(types, ",") + "](" + strings.Join(funcs, ",") + ")"
+	fromJSON := owner.qualified("Unmarshal"+t.Name+"FromJSON") +
+		"[" + strings.Join(types, ",") + "](" + strings.Join(jsonFuncs, ",") + ")"
+
+	if owner.structs[t.Name] {
+		return goType{
+			typ:            "*" + instance,
+			constructor:    "new(" + instance + ")",
+			fromRawBytes:   fromRawBytes,
+			fromJSON:       fromJSON,
+			unmarshalFuncs: funcs,
+			random:         random,
+		}
+	}
+
+	return goType{
+		typ:          instance,
+		constructor:  "nil",
+		fromRawBytes: fromRawBytes,
+		fromJSON:     fromJSON,
+		byValue:      true,
+		random:       random,
+	}
+}
+
+func primitiveGoType(name string) goType {
+	name = primitiveName(name)
+	if codec, ok := primitiveCodecs[name]; ok {
+		return goType{
+			typ:          "*scale_codec." + codec,
+			constructor:  "new(scale_codec." + codec + ")",
+			fromRawBytes: "scale_codec." + codec + "FromRawBytes",
+			fromJSON:     fromJSON("scale_codec." + codec),
+			random:       "scale_codec.Random" + codec,
+		}
+	}
+
+	return goType{
+		typ:          fmt.Sprintf("*scale_codec.Integer[%s]", name),
+		constructor:  fmt.Sprintf("new(scale_codec.Integer[%s])", name),
+		fromRawBytes: fmt.Sprintf("scale_codec.IntegerFromRawBytes[%s]", name),
+		fromJSON:     fmt.Sprintf("scale_codec.FromJSON[scale_codec.Integer[%s]]", name),
+		random:       fmt.Sprintf("scale_codec.RandomInteger[%s]", name),
+	}
+}
+
+// fromJSON is the JSON decoder of the types referenced through a pointer
+func fromJSON(name string) string {
+	return "scale_codec.FromJSON[" + name + "]"
+}
+
+// primitiveName maps the Rust integer aliases to their Go names, `u8` is `uint8`
+func primitiveName(name string) string {
+	if len(name) > 1 && (name[0] == 'u' || name[0] == 'i') && name[1] >= '0' && name[1] <= '9' {
+		if name[0] == 'u' {
+			return "uint" + name[1:]
+		}
+		return "int" + name[1:]
+	}
+
+	return name
+}
+
+// isByte reports whether t is `u8`, `Vec<u8>` and `[u8; N]` are
+// decoded as raw bytes rather than as a sequence of integers
+func isByte(t *TypeExpr) bool {
+	return t.Kind == PrimitiveType && primitiveName(t.Name) == "uint8"
+}
+
+// lowerEnums converts the schema enums into the definitions used by
+// enum_script to generate the Go code
+func (r *goTypeResolver) lowerEnums(schema *Schema) []Enum {
+	enums := make([]Enum, len(schema.Enums))
+	for idx, enum := range schema.Enums {
+		variants := make([]EnumField, len(enum.Variants))
+		for vIdx, variant := range enum.Variants {
+			variants[vIdx] = r.lowerVariant(variant)
+		}
+
+		enums[idx] = Enum{
+			Name:       enum.Name,
+			TypeParams: typeParamNames(enum.TypeParams),
+			Variants:   variants,
+			Unit:       enum.unitOnly(),
+			Doc:        enum.Doc,
+		}
+	}
+
+	return enums
+}
+
+func (r *goTypeResolver) lowerVariant(variant *VariantDecl) EnumField {
+	field := r.lowerVariantData(variant)
+	field.Doc = variant.Doc
+	return field
+}
+
+func (r *goTypeResolver) lowerVariantData(variant *VariantDecl) EnumField {
+	if len(variant.Fields) > 0 {
+		return EnumField{
+			Name:    variant.Name,
+			Fields:  r.lowerFields(variant.Fields, !variant.Named),
+			Index:   variant.Index,
+			Tuple:   !variant.Named,
+			Helpers: r.variantHelpers(variant),
+			Finite:  r.finite[variant],
+		}
+	}
+
+	if variant.Payload == nil {
+		return EnumField{
+			Name:            variant.Name,
+			Type:            "*scale_codec.SimpleVariant",
+			TypeConstructor: "new(scale_codec.SimpleVariant)",
+			Index:           variant.Index,
+			Unit:            true,
+			Finite:          true,
+		}
+	}
+
+	payload := r.resolve(variant.Payload)
+	field := EnumField{
+		Name:            variant.Name,
+		Type:            payload.typ,
+		TypeConstructor: payload.constructor,
+		FromJSON:        payload.fromJSON,
+		Index:           variant.Index,
+		Helpers:         r.variantHelpers(variant),
+		Random:          payload.random,
+		Finite:          r.finite[variant],
+	}
+
+	switch {
+	case payload.byValue:
+		field.UnmarshalScale = "var err error\n\ti.Inner, err = " +
+			payload.fromRawBytes + "(reader)\n\treturn err"
+	case len(payload.unmarshalFuncs) > 0:
+		separator := ", "
+		if payload.packedArgs {
+			separator = ","
+		}
+
+		field.UnmarshalScale = "return i.Inner.UnmarshalSCALE(reader" + separator +
+			strings.Join(payload.unmarshalFuncs, separator) + ")"
+	}
+
+	return field
+}
+
+// lowerStructs converts the schema structs into the definitions used by
+// enum_script to generate the Go code
+func (r *goTypeResolver) lowerStructs(schema *Schema) []Struct {
+	structs := make([]Struct, len(schema.Structs))
+	for idx, structDecl := range schema.Structs {
+		structs[idx] = Struct{
+			Name:       structDecl.Name,
+			TypeParams: typeParamNames(structDecl.TypeParams),
+			Fields:     r.lowerFields(structDecl.Fields, structDecl.Tuple),
+			Tuple:      structDecl.Tuple,
+			Doc:        structDecl.Doc,
+		}
+	}
+
+	return structs
+}
+
+// lowerNewtypes converts the schema newtypes into the definitions used by
+// enum_script to generate the Go code
+func (r *goTypeResolver) lowerNewtypes(schema *Schema) []Newtype {
+	newtypes := make([]Newtype, len(schema.Newtypes))
+	for idx, newtype := range schema.Newtypes {
+		inner := r.resolve(newtype.Type)
+		newtypes[idx] = Newtype{
+			Name:            newtype.Name,
+			Type:            inner.typ,
+			TypeConstructor: inner.constructor,
+			FromRawBytes:    inner.fromRawBytes,
+			FromJSON:        inner.fromJSON,
+			Random:          inner.random,
+			Doc:             newtype.Doc,
+		}
+	}
+
+	return newtypes
+}
+
+// lowerFields resolves struct and variant fields, unnamed fields
+// are named after their position as in the generic tuples
+func (r *goTypeResolver) lowerFields(fields []*FieldDecl, tuple bool) []StructField {
+	lowered := make([]StructField, len(fields))
+	for idx, field := range fields {
+		fieldType := r.resolve(field.Type)
+
+		lowered[idx] = StructField{
+			Name:            fmt.Sprintf("F%d", idx),
+			Type:            fieldType.typ,
+			TypeConstructor: fieldType.constructor,
+			FromRawBytes:    fieldType.fromRawBytes,
+			FromJSON:        fieldType.fromJSON,
+			Random:          fieldType.random,
+			Doc:             field.Doc,
+		}
+
+		if !tuple {
+			lowered[idx].Name = goFieldName(field.Name)
+			lowered[idx].SchemaName = field.Name
+			lowered[idx].JSONName = jsonFieldName(field.Name)
+		}
+	}
+
+	return lowered
+}
+
+// lowerImportedSchemas converts the declarations of the schemas imported,
+// directly or not, by the resolver schema. They are resolved by separate
+// resolvers so their tuples and packages are not added to the ones of the
+// generated schema
+func (r *goTypeResolver) lowerImportedSchemas() []ImportedSchema {
+	var imported []ImportedSchema
+	seen := make(map[string]bool)
+
+	var lower func(r *goTypeResolver)
+	lower = func(r *goTypeResolver) {
+		names := make([]string, 0, len(r.imports))
+		for name := range r.imports {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			imp := r.imports[name]
+			if seen[imp.qualifier] {
+				continue
+			}
+			seen[imp.qualifier] = true
+
+			// the imported resolvers were already built from these
+			// schemas, so building them again cannot fail
+			owner, _ := newImportResolver(imp.schema, imp.qualifier, imp.goPath,
+				make(map[string]int), make(map[string]string))
+			owner.setFinite(r.finite)
+			owner.setTuplePackage(goFieldName(imp.qualifier))
+
+			imported = append(imported, ImportedSchema{
+				Name:     imp.qualifier,
+				Prefix:   goFieldName(imp.qualifier),
+				Enums:    owner.lowerEnums(imp.schema),
+				Structs:  owner.lowerStructs(imp.schema),
+				Newtypes: owner.lowerNewtypes(imp.schema),
+				Tuples:   owner.tuples,
+				Imports:  owner.usedImports,
+			})
+			lower(imp)
+		}
+	}
+
+	lower(r)
+	return imported
+}
+
+func (r *goTypeResolver) setTuplePackage(name string) {
+	r.tuplePackage = name
+	for _, imported := range r.imports {
+		imported.setTuplePackage(name)
+	}
+}
+
+// goFieldName exports a snake_case schema field, `account_id` becomes `AccountId`
+func goFieldName(name string) string {
+	words := strings.Split(name, "_")
+	for idx, word := range words {
+		if word != "" {
+			words[idx] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+
+	return strings.Join(words, "")
+}
+
+// jsonFieldName is the camelCase JSON key of a snake_case schema field,
+// `account_id` becomes `accountId` as in the polkadot.js JSON
+func jsonFieldName(name string) string {
+	goName := goFieldName(name)
+	if goName == "" {
+		return name
+	}
+
+	return strings.ToLower(goName[:1]) + goName[1:]
+}
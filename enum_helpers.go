@@ -0,0 +1,245 @@
+package scale_codec
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+)
+
+// nativeType is the Go type taken by the variant constructors for a schema
+// type, toCodec and fromCodec convert the %s value to and from the codec
+type nativeType struct {
+	typ       string
+	toCodec   string
+	fromCodec string
+}
+
+// native returns the native Go type of the primitives and unit-only enums,
+// the other types are taken as is and converted is false
+func (r *goTypeResolver) native(t *TypeExpr) (native nativeType, converted bool) {
+	codec := r.resolve(t)
+	if codec.unitEnum {
+		return nativeType{strings.TrimPrefix(codec.typ, "*"), "%s.Ptr()", "*%s"}, true
+	}
+
+	switch codec.typ {
+	case "*scale_codec.Bool":
+		return nativeType{"bool", "&scale_codec.Bool{Value: %s}", "%s.Value"}, true
+	case "*scale_codec.String":
+		return nativeType{"string", "&scale_codec.String{Value: %s}", "%s.Value"}, true
+	case "*scale_codec.Bytes":
+		return nativeType{"[]byte", "&scale_codec.Bytes{Value: %s}", "%s.Value"}, true
+	case "*scale_codec.ByteArray":
+		return nativeType{"[]byte", "&scale_codec.ByteArray{Value: %s}", "%s.Value"}, true
+	case "*scale_codec.U128":
+		return nativeType{"*big.Int", "scale_codec.U128FromBigInt(%s)", "%s.ToBigInt()"}, true
+	case "*scale_codec.I128":
+		return nativeType{"*big.Int", "scale_codec.I128FromBigInt(%s)", "%s.ToBigInt()"}, true
+	}
+
+	if integer, ok := strings.CutPrefix(codec.typ, "*scale_codec.Integer["); ok {
+		integer = strings.TrimSuffix(integer, "]")
+		return nativeType{integer, "&scale_codec.Integer[" + integer + "]{Value: %s}", "%s.Value"}, true
+	}
+
+	return nativeType{codec.typ, "%s", "%s"}, false
+}
+
+// expandAlias follows the aliases of t, the returned resolver is the one
+// of the schema declaring the expanded type
+func (r *goTypeResolver) expandAlias(t *TypeExpr) (*goTypeResolver, *TypeExpr) {
+	for t.Kind == NamedType && len(t.Args) == 0 {
+		owner := r
+		if t.Package != "" {
+			owner = r.imports[t.Package]
+		}
+
+		alias := owner.schema.alias(t.Name)
+		if alias == nil {
+			break
+		}
+		r, t = owner, alias.Type
+	}
+
+	return r, t
+}
+
+// payload is a variant payload flattened into native params, the
+// items of the tuples, even nested, are given as separate params
+type payload struct {
+	params    []HelperParam
+	converted bool
+	names     func(idx int) string
+}
+
+// flatten adds the params of t and returns the expression building t from
+// them and a func returning the expressions reading them back from root
+func (p *payload) flatten(r *goTypeResolver, t *TypeExpr) (string, func(root string) []string) {
+	r, t = r.expandAlias(t)
+	switch t.Kind {
+	case UnitType:
+		p.converted = true
+		return "new(scale_codec.Unit)", func(string) []string { return nil }
+	case TupleType:
+		p.converted = true
+		inits := make([]string, len(t.Args))
+		readers := make([]func(string) []string, len(t.Args))
+		for idx, arg := range t.Args {
+			var build string
+			build, readers[idx] = p.flatten(r, arg)
+			inits[idx] = fmt.Sprintf("F%d: %s", idx, build)
+		}
+
+		build := "&" + strings.TrimPrefix(r.resolve(t).typ, "*") + "{" + strings.Join(inits, ", ") + "}"
+		return build, func(root string) []string {
+			var values []string
+			for idx, read := range readers {
+				values = append(values, read(fmt.Sprintf("%s.F%d", root, idx))...)
+			}
+			return values
+		}
+	default:
+		native, converted := r.native(t)
+		name := p.names(len(p.params))
+		p.params = append(p.params, HelperParam{Name: name, Type: native.typ})
+		p.converted = p.converted || converted
+		return fmt.Sprintf(native.toCodec, name), func(root string) []string {
+			return []string{fmt.Sprintf(native.fromCodec, root)}
+		}
+	}
+}
+
+// flattenPayload flattens t naming its params v when there is a
+// single one and a, b, c... otherwise
+func (r *goTypeResolver) flattenPayload(t *TypeExpr) (*payload, string, func(string) []string) {
+	p := &payload{names: letterName}
+	if count := r.countParams(t); count == 1 {
+		p.names = func(int) string { return "v" }
+	}
+
+	build, values := p.flatten(r, t)
+	return p, build, values
+}
+
+func (r *goTypeResolver) countParams(t *TypeExpr) int {
+	r, t = r.expandAlias(t)
+	switch t.Kind {
+	case UnitType:
+		return 0
+	case TupleType:
+		count := 0
+		for _, arg := range t.Args {
+			count += r.countParams(arg)
+		}
+		return count
+	default:
+		return 1
+	}
+}
+
+func letterName(idx int) string {
+	if idx < len("abcdefghijklmnopqrstuvwxyz") {
+		return string(rune('a' + idx))
+	}
+	return fmt.Sprintf("v%d", idx)
+}
+
+// variantHelpers returns the typed constructors of a variant, an Option
+// payload gets the None and Some constructors, a Result the Ok and Err
+// ones and the other payloads, like the variant fields, a From one when
+// at least one native value is converted to its codec
+func (r *goTypeResolver) variantHelpers(variant *VariantDecl) []VariantHelper {
+	if len(variant.Fields) > 0 {
+		return r.fieldsHelpers(variant)
+	}
+
+	if variant.Payload == nil {
+		return nil
+	}
+
+	owner, t := r.expandAlias(variant.Payload)
+	switch t.Kind {
+	case OptionType:
+		inner := owner.resolve(t.Args[0])
+		p, build, values := owner.flattenPayload(t.Args[0])
+		return []VariantHelper{
+			{
+				Suffix: "None",
+				Inits:  []string{"Inner: scale_codec.NoneG[" + inner.typ + "]()"},
+			},
+			{
+				Suffix: "Some",
+				Params: p.params,
+				Inits:  []string{"Inner: scale_codec.SomeG[" + inner.typ + "](" + build + ")"},
+				Getter: "Some",
+				Unwrap: "Unwrap",
+				Values: values("inner"),
+			},
+		}
+	case ResultType:
+		ok, err := owner.resolve(t.Args[0]), owner.resolve(t.Args[1])
+		typeArgs := "[" + ok.typ + ", " + err.typ + "]"
+		okPayload, okBuild, okValues := owner.flattenPayload(t.Args[0])
+		errPayload, errBuild, errValues := owner.flattenPayload(t.Args[1])
+		return []VariantHelper{
+			{
+				Suffix: "Ok",
+				Params: okPayload.params,
+				Inits:  []string{"Inner: scale_codec.OkG" + typeArgs + "(" + okBuild + ")"},
+				Getter: "Ok",
+				Unwrap: "Ok",
+				Values: okValues("inner"),
+			},
+			{
+				Suffix: "Err",
+				Params: errPayload.params,
+				Inits:  []string{"Inner: scale_codec.ErrG" + typeArgs + "(" + errBuild + ")"},
+				Getter: "Err",
+				Unwrap: "Err",
+				Values: errValues("inner"),
+			},
+		}
+	case UnitType:
+		return nil
+	}
+
+	p, build, values := owner.flattenPayload(t)
+	if !p.converted {
+		return nil
+	}
+
+	return []VariantHelper{{
+		Suffix: "From",
+		Params: p.params,
+		Inits:  []string{"Inner: " + build},
+		Getter: "Get",
+		Values: values("variant.Inner"),
+	}}
+}
+
+// fieldsHelpers returns the From constructor of a variant with fields,
+// taking a native value per field named after the field
+func (r *goTypeResolver) fieldsHelpers(variant *VariantDecl) []VariantHelper {
+	helper := VariantHelper{Suffix: "From"}
+	converted := false
+	for idx, field := range variant.Fields {
+		native, ok := r.native(field.Type)
+		converted = converted || ok
+
+		name, goName := letterName(idx), fmt.Sprintf("F%d", idx)
+		if variant.Named {
+			name, goName = jsonFieldName(field.Name), goFieldName(field.Name)
+			if token.IsKeyword(name) {
+				name += "_"
+			}
+		}
+
+		helper.Params = append(helper.Params, HelperParam{Name: name, Type: native.typ})
+		helper.Inits = append(helper.Inits, goName+": "+fmt.Sprintf(native.toCodec, name))
+	}
+
+	if !converted {
+		return nil
+	}
+	return []VariantHelper{helper}
+}
@@ -3,14 +3,16 @@ package scale_codec
 
 import __yyfmt__ "fmt"
 
-import (
-	"fmt"
-	"strings"
-)
-
 type Enum struct {
-	Name     string
-	Variants []EnumField
+	Name       string
+	TypeParams []string
+	Variants   []EnumField
+	// Unit is set when every variant is a unit variant,
+	// the enum is then generated as an uint8
+	Unit bool
+	// Doc is the text of the schema doc comments, it is
+	// written as the Go doc comment of the generated code
+	Doc string
 }
 
 type EnumField struct {
@@ -18,13 +20,106 @@ type EnumField struct {
 	Type            string
 	TypeConstructor string
 	UnmarshalScale  string
+	FromJSON        string
+	Fields          []StructField
+	Index           int
+	// Unit is set for variants without data and Tuple for
+	// variants with several unnamed fields like `Pair(u32, bool)`
+	Unit  bool
+	Tuple bool
+	// Helpers are the typed constructors and getters of the variant
+	Helpers []VariantHelper
+	// Random builds random payloads in the generated tests and Finite
+	// is set when the variant does not need to nest its enum in itself
+	Random string
+	Finite bool
+	Doc    string
+}
+
+// VariantHelper is a constructor of a variant taking native Go values,
+// like NewJOk(a uint64, b bool), and its getter, like J.Ok()
+type VariantHelper struct {
+	// Suffix completes the constructor name: From, None, Some, Ok or Err
+	Suffix string
+	Params []HelperParam
+	// Inits are the field initializers of the variant built from Params
+	Inits []string
+	// Getter names the method returning the Params values, which are read
+	// by the Values expressions from the value returned by the Unwrap
+	// method of the payload, or from the payload when Unwrap is empty
+	Getter string
+	Unwrap string
+	Values []string
+}
+
+type HelperParam struct {
+	Name string
+	Type string
+}
+
+type Struct struct {
+	Name       string
+	TypeParams []string
+	Fields     []StructField
+	Tuple      bool
+	Doc        string
+}
+
+type StructField struct {
+	Name            string
+	Type            string
+	TypeConstructor string
+	FromRawBytes    string
+	FromJSON        string
+	// SchemaName is the field name in the schema, empty for unnamed
+	// fields, and JSONName is its camelCase form used as JSON key
+	SchemaName string
+	JSONName   string
+	Random     string
+	Doc        string
+}
+
+type Newtype struct {
+	Name            string
+	Type            string
+	TypeConstructor string
+	FromRawBytes    string
+	FromJSON        string
+	Random          string
+	Doc             string
+}
+
+// ImportedSchema holds the declarations of a schema imported, directly or
+// not, by the generated one. The tests generated by enum_script -tests
+// build random values of them, Name is the import name qualifying them
+type ImportedSchema struct {
+	Name string
+	// Prefix capitalizes Name in the generators, like randomCommonOrigin
+	Prefix   string
+	Enums    []Enum
+	Structs  []Struct
+	Newtypes []Newtype
+
+	// Tuples are the generic tuples used by the declarations, they
+	// are declared by the package generated for the imported schema
+	Tuples map[string]int
+	// Imports are the Go packages referenced by the declarations
+	Imports map[string]string
 }
 
 var (
-	Enums []Enum
+	Enums    []Enum
+	Structs  []Struct
+	Newtypes []Newtype
 
 	// map of tuple name and tuple qty of values
 	GenericTuple map[string]int = make(map[string]int)
+
+	// map of import name and Go import path of the imported schemas
+	Imports map[string]string = make(map[string]string)
+
+	// schemas imported by the generated one, directly or not
+	ImportedSchemas []ImportedSchema
 )
 
 const ENUM = 57346
@@ -32,6 +127,18 @@ const IDENTIFIER = 57347
 const TYPE = 57348
 const RESULT = 57349
 const OPTION = 57350
+const STRUCT = 57351
+const INTEGER = 57352
+const VEC = 57353
+const COMPACT = 57354
+const BTREEMAP = 57355
+const BTREESET = 57356
+const TYPEALIAS = 57357
+const NEWTYPE = 57358
+const IMPORT = 57359
+const AS = 57360
+const STRING = 57361
+const TYPEEXPR = 57362
 
 var yyToknames = [...]string{
 	"$end",
@@ -42,13 +149,32 @@ var yyToknames = [...]string{
 	"TYPE",
 	"RESULT",
 	"OPTION",
-	"\"{\"",
+	"STRUCT",
+	"INTEGER",
+	"VEC",
+	"COMPACT",
+	"BTREEMAP",
+	"BTREESET",
+	"TYPEALIAS",
+	"NEWTYPE",
+	"IMPORT",
+	"AS",
+	"STRING",
+	"TYPEEXPR",
 	"\"}\"",
-	"\"(\"",
-	"\")\"",
-	"\",\"",
+	"\"{\"",
 	"\"<\"",
 	"\">\"",
+	"\",\"",
+	"\"@\"",
+	"\"(\"",
+	"\")\"",
+	"\"=\"",
+	"\";\"",
+	"\":\"",
+	"\".\"",
+	"\"[\"",
+	"\"]\"",
 }
 
 var yyStatenames = [...]string{}
@@ -57,74 +183,120 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-func hasGenericArgs(args []string) bool {
-	for _, input := range args {
-		if !strings.HasPrefix(input, "*") {
-			return true
-		}
-	}
-
-	return false
-}
-
 var yyExca = [...]int8{
 	-1, 1,
 	1, -1,
 	-2, 0,
+	-1, 2,
+	1, 1,
+	-2, 0,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 68
-
-var yyAct = [...]int8{
-	11, 44, 43, 42, 41, 40, 12, 18, 17, 34,
-	33, 16, 38, 12, 18, 17, 24, 22, 16, 23,
-	31, 12, 18, 17, 27, 29, 16, 32, 30, 12,
-	18, 17, 25, 26, 16, 36, 37, 39, 28, 12,
-	18, 17, 35, 19, 16, 21, 12, 18, 17, 10,
-	9, 16, 12, 18, 17, 7, 5, 16, 4, 3,
-	20, 15, 14, 13, 8, 6, 2, 1,
+const yyLast = 165
+
+var yyAct = [...]uint8{
+	134, 71, 57, 55, 66, 95, 17, 68, 116, 132,
+	99, 92, 53, 73, 72, 76, 19, 18, 30, 29,
+	86, 54, 31, 33, 34, 35, 73, 82, 117, 82,
+	145, 82, 100, 148, 81, 61, 74, 127, 28, 56,
+	121, 38, 139, 70, 32, 120, 39, 19, 18, 30,
+	29, 94, 75, 31, 33, 34, 35, 79, 94, 142,
+	82, 83, 84, 85, 114, 87, 88, 89, 78, 28,
+	110, 144, 96, 98, 90, 32, 143, 102, 103, 96,
+	128, 129, 106, 82, 115, 108, 113, 111, 109, 107,
+	77, 64, 63, 62, 60, 59, 58, 37, 146, 119,
+	97, 36, 124, 125, 126, 118, 123, 9, 3, 15,
+	40, 130, 135, 131, 16, 141, 112, 133, 69, 122,
+	13, 14, 12, 105, 137, 101, 138, 136, 41, 140,
+	42, 43, 45, 44, 80, 27, 46, 47, 48, 49,
+	147, 26, 25, 50, 51, 52, 24, 23, 22, 21,
+	20, 67, 11, 93, 91, 104, 65, 10, 8, 7,
+	6, 5, 4, 2, 1,
 }
 
 var yyPact = [...]int16{
-	-1000, 55, -1000, 53, 47, -1000, 45, -1000, -1000, 38,
-	46, 31, -1000, -1000, -1000, -1000, 40, 5, 2, -1000,
-	20, -1000, -1000, 33, 23, -1000, 15, -5, -6, 29,
-	22, -1000, -1000, -1000, -1000, 7, 0, -11, -12, -13,
-	-14, -1000, -1000, -1000, -1000,
+	88, -32768, 105, 42, -32768, -32768, -32768, -32768, -32768, 80,
+	75, 19, 91, 125, 125, 125, 125, -32768, -32768, -11,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 11, 73,
+	72, 71, 42, 70, 69, 68, -32768, -32768, 113, 42,
+	-4, 7, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	25, 67, 67, 42, 129, 6, -32768, -32768, 42, 42,
+	42, -10, 42, 42, 42, 53, 79, 48, -32768, -21,
+	4, -32768, 120, -32768, 42, 42, -32768, 118, -32768, 58,
+	66, -32768, 42, 64, 45, 63, 106, 62, 39, 60,
+	-32768, -32768, -1, 46, 18, -32768, 114, -32768, 113, 42,
+	-32768, -17, -17, 9, 56, -32768, -32768, 42, -32768, -32768,
+	42, -32768, -25, -32768, 42, -32768, -32768, 102, -1, -32768,
+	42, 113, 15, -32768, -32768, -32768, -32768, -17, -32768, 110,
+	35, 52, -32768, 47, -32768, -32768, -32768, 2, 77, 102,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, 5, -32768,
 }
 
-var yyPgo = [...]int8{
-	0, 67, 66, 65, 64, 0, 63, 62, 61, 60,
+var yyPgo = [...]uint8{
+	0, 164, 163, 2, 162, 161, 160, 159, 158, 157,
+	156, 128, 15, 155, 154, 11, 8, 153, 5, 0,
+	3, 4, 152, 1, 151, 7, 150, 149, 148, 147,
+	146, 142, 141, 135,
 }
 
 var yyR1 = [...]int8{
-	0, 1, 1, 2, 3, 3, 4, 4, 5, 5,
-	5, 5, 6, 9, 9, 9, 9, 7, 7, 8,
-	8, 8, 8,
+	0, 1, 1, 2, 2, 2, 2, 2, 2, 2,
+	4, 9, 11, 11, 11, 11, 11, 11, 11, 11,
+	12, 12, 13, 13, 10, 10, 14, 14, 17, 17,
+	18, 16, 16, 19, 15, 15, 15, 5, 5, 22,
+	6, 6, 7, 8, 23, 23, 21, 21, 21, 24,
+	24, 25, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 26, 26, 20, 20, 27,
+	28, 29, 30, 31, 32, 33,
 }
 
 var yyR2 = [...]int8{
-	0, 0, 2, 5, 0, 2, 1, 4, 1, 1,
-	1, 1, 3, 1, 1, 3, 3, 4, 4, 6,
-	6, 6, 6,
+	0, 1, 2, 0, 2, 2, 2, 2, 2, 3,
+	4, 3, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 3, 1, 3, 0, 2, 2, 3, 1, 2,
+	5, 0, 2, 1, 1, 4, 4, 4, 4, 3,
+	3, 5, 5, 6, 0, 1, 0, 1, 2, 1,
+	3, 3, 1, 1, 4, 3, 6, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 2, 1, 3, 4,
+	6, 4, 5, 4, 6, 4,
 }
 
 var yyChk = [...]int16{
-	-1000, -1, -2, 4, 5, 9, -3, 10, -4, 5,
-	11, -5, 6, -6, -7, -8, 11, 8, 7, 12,
-	-9, 5, -5, 14, 14, 12, 13, -5, 5, -5,
-	5, 5, -5, 15, 15, 13, 13, -5, 5, -5,
-	5, 15, 15, 15, 15,
+	-32768, -1, -2, 20, -4, -5, -6, -7, -8, 2,
+	-9, -22, 17, 15, 16, 4, 9, -3, 6, 5,
+	-26, -27, -28, -29, -30, -31, -32, -33, 27, 8,
+	7, 11, 33, 12, 13, 14, 21, 22, 22, 27,
+	19, -11, 5, 6, 8, 7, 11, 12, 13, 14,
+	-11, -11, -11, 23, 32, -20, 28, -3, 23, 23,
+	23, -3, 23, 23, 23, -10, -21, -24, -25, 5,
+	-20, -23, 18, 30, 29, 27, -12, 23, -12, -20,
+	5, 28, 25, -3, -3, -3, 30, -3, -3, -3,
+	21, -14, -15, -17, 5, -18, 26, 21, 25, 31,
+	28, 5, -3, -3, -13, 5, 24, 23, -3, 24,
+	25, 24, 10, 24, 25, 24, -16, 29, -15, -18,
+	27, 22, 5, -25, -3, -23, -23, 28, 24, 25,
+	-20, -3, 34, -3, -19, 10, -16, -20, -21, 27,
+	-23, 5, 24, 24, 24, 28, 21, -19, 28,
 }
 
 var yyDef = [...]int8{
-	1, -2, 2, 0, 0, 4, 0, 3, 5, 6,
-	0, 0, 8, 9, 10, 11, 0, 0, 0, 7,
-	0, 13, 14, 0, 0, 12, 0, 0, 0, 0,
-	0, 15, 16, 17, 18, 0, 0, 0, 0, 0,
-	0, 19, 21, 20, 22,
+	3, -2, -2, 0, 4, 5, 6, 7, 8, 0,
+	0, 0, 0, 0, 0, 0, 0, 2, 52, 53,
+	57, 58, 59, 60, 61, 62, 63, 64, 0, 0,
+	0, 0, 0, 0, 0, 0, 9, 24, 46, 0,
+	44, 0, 12, 13, 14, 15, 16, 17, 18, 19,
+	0, 20, 20, 0, 0, 0, 66, 67, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 47, 49, 0,
+	0, 40, 0, 45, 0, 0, 11, 0, 39, 0,
+	55, 65, 0, 0, 0, 0, 0, 0, 0, 0,
+	10, 25, 31, 0, 34, 28, 0, 37, 48, 0,
+	38, 44, 44, 0, 0, 22, 54, 0, 68, 69,
+	0, 71, 0, 73, 0, 75, 26, 0, 31, 29,
+	0, 46, 0, 50, 51, 41, 42, 44, 21, 0,
+	0, 0, 72, 0, 32, 33, 27, 0, 0, 0,
+	43, 23, 56, 70, 74, 35, 36, 0, 30,
 }
 
 var yyTok1 = [...]int8{
@@ -132,19 +304,20 @@ var yyTok1 = [...]int8{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	11, 12, 3, 3, 13, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	14, 3, 15, 3, 3, 3, 3, 3, 3, 3,
+	27, 28, 3, 3, 25, 3, 32, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 31, 30,
+	23, 29, 24, 3, 26, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 33, 3, 34, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 9, 3, 10,
+	3, 3, 3, 22, 3, 21,
 }
 
 var yyTok2 = [...]int8{
-	2, 3, 4, 5, 6, 7, 8,
+	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20,
 }
 
 var yyTok3 = [...]int8{
@@ -188,7 +361,7 @@ func yyNewParser() yyParser {
 	return &yyParserImpl{}
 }
 
-const yyFlag = -1000
+const yyFlag = -32768
 
 func yyTokname(c int) string {
 	if c >= 1 && c-1 < len(yyToknames) {
@@ -486,146 +659,312 @@ yydefault:
 	// dummy call; replaced with literal code
 	switch yynt {
 
-	case 1:
+	case 2:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yylex.(*lexer).typeExpr = yyDollar[2].typeExpr
+		}
+	case 4:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yylex.(*lexer).schema.Enums = append(yylex.(*lexer).schema.Enums, yyDollar[2].enum)
+		}
+	case 5:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yylex.(*lexer).schema.Structs = append(yylex.(*lexer).schema.Structs, yyDollar[2].structDecl)
+		}
+	case 6:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yylex.(*lexer).schema.Imports = append(yylex.(*lexer).schema.Imports, yyDollar[2].imp)
+		}
+	case 7:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yylex.(*lexer).schema.Aliases = append(yylex.(*lexer).schema.Aliases, yyDollar[2].alias)
+		}
+	case 8:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yylex.(*lexer).schema.Newtypes = append(yylex.(*lexer).schema.Newtypes, yyDollar[2].newtype)
+		}
+	case 9:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yylex.(*lexer).contexts = nil
+			yylex.(*lexer).typeParams = nil
+		}
+	case 10:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.enum = yyDollar[1].enum
+			yyVAL.enum.Variants = yyDollar[3].variants
+			yyVAL.enum.End = yyDollar[4].pos
+			yylex.(*lexer).typeParams = nil
+			for idx, variant := range yyVAL.enum.Variants {
+				if !variant.ExplicitIndex {
+					variant.Index = idx
+				}
+			}
+		}
+	case 11:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.enum = &EnumDecl{Pos: yyDollar[2].pos, Name: yyDollar[2].sval, TypeParams: yyDollar[3].params, Doc: yyDollar[1].doc}
+			yylex.(*lexer).typeParams = yyDollar[3].params
+		}
+	case 20:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
-			Enums = make([]Enum, 0)
+			yyVAL.params = nil
 		}
-	case 3:
+	case 21:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.params = yyDollar[2].params
+		}
+	case 22:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.params = []*TypeParam{{Pos: yyDollar[1].pos, Name: yyDollar[1].sval}}
+		}
+	case 23:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.params = append(yyDollar[1].params, &TypeParam{Pos: yyDollar[3].pos, Name: yyDollar[3].sval})
+		}
+	case 24:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.variants = nil
+		}
+	case 25:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.variants = append(yyDollar[1].variants, yyDollar[2].variant)
+		}
+	case 26:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.variant = yyDollar[1].variant
+			setVariantIndex(yyVAL.variant, yyDollar[2].index)
+		}
+	case 27:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.variant = yyDollar[2].variant
+			setVariantIndex(yyVAL.variant, yyDollar[1].index)
+			// the doc comments are written before the attributes or after them
+			if yyVAL.variant.Doc == "" {
+				yyVAL.variant.Doc = yyDollar[1].doc
+			}
+			if yyDollar[3].index != nil {
+				yylex.(*lexer).errs.add(yyDollar[3].index.pos,
+					"variant %s has both an @index attribute and a discriminant", yyDollar[2].variant.Name)
+			}
+		}
+	case 29:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.index = yyDollar[2].index
+			yyVAL.doc = yyDollar[1].doc
+		}
+	case 30:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		{
-			yyVAL.enum = Enum{Name: yyDollar[2].sval, Variants: yyDollar[4].enumFields}
-			Enums = append(Enums, yyVAL.enum)
-			fmt.Printf("Parsed enum: %s with fields: %+v\n", yyDollar[2].sval, Enums)
+			yyVAL.index = yyDollar[4].index
+			yyVAL.doc = yyDollar[1].doc
+			if yyDollar[2].sval != "index" {
+				yylex.(*lexer).errs.add(yyDollar[2].pos, "unknown attribute @%s", yyDollar[2].sval)
+			}
 		}
-	case 4:
+	case 31:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
-			yyVAL.enumFields = nil // Initialize as an empty slice
+			yyVAL.index = nil
 		}
-	case 5:
+	case 32:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.enumFields = append(yyDollar[1].enumFields, yyDollar[2].enumField)
+			yyVAL.index = yyDollar[2].index
 		}
-	case 6:
+	case 33:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.enumField = EnumField{
-				Name:            yyDollar[1].sval,
-				Type:            "*scale_codec.SimpleVariant",
-				TypeConstructor: "new(scale_codec.SimpleVariant)",
-				UnmarshalScale:  "",
-			}
+			yyVAL.index = &explicitIndex{pos: yyDollar[1].pos, value: yylex.(*lexer).parseInt(yyDollar[1].pos, yyDollar[1].sval)}
 		}
-	case 7:
+	case 34:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.variant = &VariantDecl{Pos: yyDollar[1].pos, Name: yyDollar[1].sval, Doc: yyDollar[1].doc}
+		}
+	case 35:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.enumField = EnumField{
-				Name:            yyDollar[1].sval,
-				Type:            yyDollar[3].ttype,
-				TypeConstructor: yyDollar[3].sval,
-				UnmarshalScale:  yyDollar[3].unmarshalScale,
+			yyVAL.variant = &VariantDecl{Pos: yyDollar[1].pos, Name: yyDollar[1].sval, End: yyDollar[4].pos, Doc: yyDollar[1].doc}
+			if len(yyDollar[3].typeExprs) == 1 {
+				yyVAL.variant.Payload = yyDollar[3].typeExprs[0]
+			} else {
+				yyVAL.variant.Fields = tupleFields(yyDollar[3].typeExprs)
 			}
 		}
-	case 8:
+	case 36:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.variant = &VariantDecl{Pos: yyDollar[1].pos, Name: yyDollar[1].sval, Fields: yyDollar[3].fields, Named: true, End: yyDollar[4].pos, Doc: yyDollar[1].doc}
+		}
+	case 37:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.structDecl = yyDollar[1].structDecl
+			yyVAL.structDecl.Fields = yyDollar[3].fields
+			yyVAL.structDecl.End = yyDollar[4].pos
+			yylex.(*lexer).typeParams = nil
+		}
+	case 38:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.structDecl = yyDollar[1].structDecl
+			yyVAL.structDecl.Tuple = true
+			yyVAL.structDecl.Fields = tupleFields(yyDollar[3].typeExprs)
+			yyVAL.structDecl.End = yyDollar[4].pos
+			yylex.(*lexer).typeParams = nil
+		}
+	case 39:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.structDecl = &StructDecl{Pos: yyDollar[2].pos, Name: yyDollar[2].sval, TypeParams: yyDollar[3].params, Doc: yyDollar[1].doc}
+			yylex.(*lexer).typeParams = yyDollar[3].params
+		}
+	case 40:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			path := yylex.(*lexer).unquote(yyDollar[2].pos, yyDollar[2].sval)
+			yyVAL.imp = &ImportDecl{Pos: yyDollar[2].pos, Path: path, Name: importName(path)}
+		}
+	case 41:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			path := yylex.(*lexer).unquote(yyDollar[2].pos, yyDollar[2].sval)
+			yyVAL.imp = &ImportDecl{Pos: yyDollar[2].pos, Path: path, Name: yyDollar[4].sval}
+		}
+	case 42:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.alias = &AliasDecl{Pos: yyDollar[2].pos, Name: yyDollar[2].sval, Type: yyDollar[4].typeExpr}
+		}
+	case 43:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.newtype = &NewtypeDecl{Pos: yyDollar[2].pos, Name: yyDollar[2].sval, Type: yyDollar[4].typeExpr, Doc: yyDollar[1].doc}
+		}
+	case 46:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.fields = nil
+		}
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.sval = "new(" + yyDollar[1].sval + ")"
-			yyVAL.ttype = "*" + yyDollar[1].sval
-			yyVAL.fromRawBytesFunc = yyDollar[1].fromRawBytesFunc
+			yyVAL.fields = []*FieldDecl{yyDollar[1].field}
 		}
-	case 12:
+	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			GenericTuple["T"+fmt.Sprint(len(yyDollar[2].tupleValuesTypes))] = len(yyDollar[2].tupleValuesTypes)
-			genericTuple := "T" + fmt.Sprint(len(yyDollar[2].tupleValuesTypes)) + "[" + strings.Join(yyDollar[2].tupleValuesTypes, ",") + "]"
-			yyVAL.ttype = "*" + genericTuple
-			yyVAL.sval = "new(" + genericTuple + ")"
-			yyVAL.fromRawBytesFunc = "UnmarshalT" + fmt.Sprint(len(yyDollar[2].tupleValuesTypes)) +
-				"FromRawBytes[" + strings.Join(yyDollar[2].tupleValuesTypes, ",") +
-				"](" + strings.Join(yyDollar[2].tupleValuesUnmarshalScale, ",") + ")"
-			yyVAL.unmarshalScale = "return i.Inner.UnmarshalSCALE(reader," + strings.Join(yyDollar[2].tupleValuesUnmarshalScale, ",") + ")"
+			yyVAL.fields = append(yyDollar[1].fields, yyDollar[3].field)
 		}
-	case 13:
+	case 51:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.field = &FieldDecl{Pos: yyDollar[1].pos, Name: yyDollar[1].sval, Type: yyDollar[3].typeExpr, Doc: yyDollar[1].doc}
+		}
+	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.sval = yyDollar[1].sval
-			yyVAL.tupleValuesTypes = append(yyVAL.tupleValuesTypes, yyDollar[1].sval)
-			yyVAL.tupleValuesUnmarshalScale = append(yyVAL.tupleValuesUnmarshalScale, "Unmarshal"+yyDollar[1].sval)
+			yyVAL.typeExpr = &TypeExpr{Pos: yyDollar[1].pos, Kind: PrimitiveType, Name: yyDollar[1].sval}
 		}
-	case 14:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.sval = yyDollar[1].sval
-			yyVAL.tupleValuesTypes = append(yyVAL.tupleValuesTypes, yyDollar[1].ttype)
-			yyVAL.tupleValuesUnmarshalScale = append(yyVAL.tupleValuesUnmarshalScale, yyDollar[1].fromRawBytesFunc)
+			yyVAL.typeExpr = &TypeExpr{Pos: yyDollar[1].pos, Kind: NamedType, Name: yyDollar[1].sval}
+			if yylex.(*lexer).isTypeParam(yyDollar[1].sval) {
+				yyVAL.typeExpr.Kind = ParamType
+			}
+		}
+	case 54:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.typeExpr = &TypeExpr{Pos: yyDollar[1].pos, Kind: NamedType, Name: yyDollar[1].sval, Args: yyDollar[3].typeExprs}
+			if yylex.(*lexer).isTypeParam(yyDollar[1].sval) {
+				yylex.(*lexer).errs.add(yyDollar[1].pos, "type parameter %s cannot have type arguments", yyDollar[1].sval)
+			}
+		}
+	case 55:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.typeExpr = &TypeExpr{Pos: yyDollar[1].pos, Kind: NamedType, Package: yyDollar[1].sval, Name: yyDollar[3].sval}
 		}
-	case 15:
+	case 56:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.typeExpr = &TypeExpr{Pos: yyDollar[1].pos, Kind: NamedType, Package: yyDollar[1].sval, Name: yyDollar[3].sval, Args: yyDollar[5].typeExprs}
+		}
+	case 65:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.sval += "," + yyDollar[3].sval
-			yyVAL.tupleValuesTypes = append(yyVAL.tupleValuesTypes, yyDollar[3].sval)
-			yyVAL.tupleValuesUnmarshalScale = append(yyVAL.tupleValuesUnmarshalScale, "Unmarshal"+yyDollar[3].sval)
+			yyVAL.typeExpr = &TypeExpr{Pos: yyDollar[1].pos, Kind: TupleType, Args: yyDollar[2].typeExprs}
+		}
+	case 66:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.typeExpr = &TypeExpr{Pos: yyDollar[1].pos, Kind: UnitType}
 		}
-	case 16:
+	case 67:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.typeExprs = []*TypeExpr{yyDollar[1].typeExpr}
+		}
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.sval += "," + yyDollar[3].sval
-			yyVAL.tupleValuesTypes = append(yyVAL.tupleValuesTypes, yyDollar[3].ttype)
-			yyVAL.tupleValuesUnmarshalScale = append(yyVAL.tupleValuesUnmarshalScale, yyDollar[3].fromRawBytesFunc)
+			yyVAL.typeExprs = append(yyDollar[1].typeExprs, yyDollar[3].typeExpr)
 		}
-	case 17:
+	case 69:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.sval = "new(scale_codec.OptionG[" + yyDollar[3].ttype + "])"
-			yyVAL.ttype = "*scale_codec.OptionG[" + yyDollar[3].ttype + "]"
-			yyVAL.fromRawBytesFunc = "scale_codec.UnmarshalOptionFromRawBytes[" + yyDollar[3].ttype + "](" + yyDollar[3].fromRawBytesFunc + ")"
-			yyVAL.unmarshalScale = "return i.Inner.UnmarshalSCALE(reader," + yyDollar[3].fromRawBytesFunc + ")"
+			yyVAL.typeExpr = &TypeExpr{Pos: yyDollar[1].pos, Kind: OptionType, Args: []*TypeExpr{yyDollar[3].typeExpr}}
 		}
-	case 18:
+	case 70:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.typeExpr = &TypeExpr{Pos: yyDollar[1].pos, Kind: ResultType, Args: []*TypeExpr{yyDollar[3].typeExpr, yyDollar[5].typeExpr}}
+		}
+	case 71:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.sval = "new(scale_codec.OptionG[" + yyDollar[3].sval + "])"
-			yyVAL.ttype = "*scale_codec.OptionG[" + yyDollar[3].sval + "]"
-			yyVAL.fromRawBytesFunc = "scale_codec.UnmarshalOptionFromRawBytes[" + yyDollar[3].sval + "](Unmarshal" + yyDollar[3].sval + ")"
-			yyVAL.unmarshalScale = "return i.Inner.UnmarshalSCALE(reader, Unmarshal" + yyDollar[3].sval + ")"
+			yyVAL.typeExpr = &TypeExpr{Pos: yyDollar[1].pos, Kind: VecType, Args: []*TypeExpr{yyDollar[3].typeExpr}}
 		}
-	case 19:
-		yyDollar = yyS[yypt-6 : yypt+1]
+	case 72:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
-			yyVAL.sval = "new(scale_codec.ResultG[" + yyDollar[3].ttype + "," + yyDollar[5].ttype + "])"
-			yyVAL.ttype = "*scale_codec.ResultG[" + yyDollar[3].ttype + "," + yyDollar[5].ttype + "]"
-			yyVAL.fromRawBytesFunc = "scale_codec.UnmarshalResultFromRawBytes[" +
-				yyDollar[3].ttype + "," + yyDollar[5].ttype + "](" + yyDollar[3].fromRawBytesFunc + "," + yyDollar[5].fromRawBytesFunc + ")"
-			yyVAL.unmarshalScale = "return i.Inner.UnmarshalSCALE(reader, " + yyDollar[3].fromRawBytesFunc + ", " + yyDollar[5].fromRawBytesFunc + ")"
+			yyVAL.typeExpr = &TypeExpr{Pos: yyDollar[1].pos, Kind: ArrayType, Args: []*TypeExpr{yyDollar[2].typeExpr},
+				Len: yylex.(*lexer).parseInt(yyDollar[4].pos, yyDollar[4].sval)}
 		}
-	case 20:
-		yyDollar = yyS[yypt-6 : yypt+1]
+	case 73:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.sval = "new(scale_codec.ResultG[" + yyDollar[3].sval + "," + yyDollar[5].ttype + "])"
-			yyVAL.ttype = "*scale_codec.ResultG[" + yyDollar[3].sval + "," + yyDollar[5].ttype + "]"
-			yyVAL.fromRawBytesFunc = "scale_codec.UnmarshalResultFromRawBytes[" +
-				yyDollar[3].sval + "," + yyDollar[5].ttype + "](Unmarshal" + yyDollar[3].sval + "," + yyDollar[5].fromRawBytesFunc + ")"
-			yyVAL.unmarshalScale = "return i.Inner.UnmarshalSCALE(reader, Unmarshal" + yyDollar[3].sval + ", " + yyDollar[5].fromRawBytesFunc + ")"
+			yyVAL.typeExpr = &TypeExpr{Pos: yyDollar[1].pos, Kind: CompactType, Args: []*TypeExpr{yyDollar[3].typeExpr}}
 		}
-	case 21:
+	case 74:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.sval = "new(scale_codec.ResultG[" + yyDollar[3].ttype + "," + yyDollar[5].sval + "])"
-			yyVAL.ttype = "*scale_codec.ResultG[" + yyDollar[3].ttype + "," + yyDollar[5].sval + "]"
-			yyVAL.fromRawBytesFunc = "scale_codec.UnmarshalResultFromRawBytes[" +
-				yyDollar[3].ttype + "," + yyDollar[5].sval + "](" + yyDollar[3].fromRawBytesFunc + ",Unmarshal" + yyDollar[5].sval + ")"
-			yyVAL.unmarshalScale = "return i.Inner.UnmarshalSCALE(reader, " + yyDollar[3].fromRawBytesFunc + ", Unmarshal" + yyDollar[5].sval + ")"
+			yyVAL.typeExpr = &TypeExpr{Pos: yyDollar[1].pos, Kind: MapType, Args: []*TypeExpr{yyDollar[3].typeExpr, yyDollar[5].typeExpr}}
 		}
-	case 22:
-		yyDollar = yyS[yypt-6 : yypt+1]
+	case 75:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.sval = "new(scale_codec.ResultG[" + yyDollar[3].sval + "," + yyDollar[5].sval + "])"
-			yyVAL.ttype = "*scale_codec.ResultG[" + yyDollar[3].sval + "," + yyDollar[5].sval + "]"
-			yyVAL.fromRawBytesFunc = "scale_codec.UnmarshalResultFromRawBytes[" +
-				yyDollar[3].sval + "," + yyDollar[5].sval + "](Unmarshal" + yyDollar[3].sval + ",Unmarshal" + yyDollar[5].sval + ")"
-			yyVAL.unmarshalScale = "return i.Inner.UnmarshalSCALE(reader, Unmarshal" + yyDollar[3].sval + ", Unmarshal" + yyDollar[5].sval + ")"
+			yyVAL.typeExpr = &TypeExpr{Pos: yyDollar[1].pos, Kind: SetType, Args: []*TypeExpr{yyDollar[3].typeExpr}}
 		}
 	}
 	goto yystack /* stack new state and value */
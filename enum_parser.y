@@ -1,14 +1,16 @@
 %{
 package scale_codec
 
-import (
-    "fmt"
-    "strings"
-)
-
 type Enum struct {
     Name string
+    TypeParams []string
     Variants []EnumField
+    // Unit is set when every variant is a unit variant,
+    // the enum is then generated as an uint8
+    Unit bool
+    // Doc is the text of the schema doc comments, it is
+    // written as the Go doc comment of the generated code
+    Doc string
 }
 
 type EnumField struct {
@@ -16,13 +18,106 @@ type EnumField struct {
     Type            string
     TypeConstructor string
 	UnmarshalScale  string
+    FromJSON        string
+    Fields          []StructField
+    Index           int
+    // Unit is set for variants without data and Tuple for
+    // variants with several unnamed fields like `Pair(u32, bool)`
+    Unit            bool
+    Tuple           bool
+    // Helpers are the typed constructors and getters of the variant
+    Helpers         []VariantHelper
+    // Random builds random payloads in the generated tests and Finite
+    // is set when the variant does not need to nest its enum in itself
+    Random          string
+    Finite          bool
+    Doc             string
+}
+
+// VariantHelper is a constructor of a variant taking native Go values,
+// like NewJOk(a uint64, b bool), and its getter, like J.Ok()
+type VariantHelper struct {
+    // Suffix completes the constructor name: From, None, Some, Ok or Err
+    Suffix string
+    Params []HelperParam
+    // Inits are the field initializers of the variant built from Params
+    Inits  []string
+    // Getter names the method returning the Params values, which are read
+    // by the Values expressions from the value returned by the Unwrap
+    // method of the payload, or from the payload when Unwrap is empty
+    Getter string
+    Unwrap string
+    Values []string
+}
+
+type HelperParam struct {
+    Name string
+    Type string
+}
+
+type Struct struct {
+    Name       string
+    TypeParams []string
+    Fields     []StructField
+    Tuple      bool
+    Doc        string
+}
+
+type StructField struct {
+    Name            string
+    Type            string
+    TypeConstructor string
+    FromRawBytes    string
+    FromJSON        string
+    // SchemaName is the field name in the schema, empty for unnamed
+    // fields, and JSONName is its camelCase form used as JSON key
+    SchemaName      string
+    JSONName        string
+    Random          string
+    Doc             string
+}
+
+type Newtype struct {
+    Name            string
+    Type            string
+    TypeConstructor string
+    FromRawBytes    string
+    FromJSON        string
+    Random          string
+    Doc             string
+}
+
+// ImportedSchema holds the declarations of a schema imported, directly or
+// not, by the generated one. The tests generated by enum_script -tests
+// build random values of them, Name is the import name qualifying them
+type ImportedSchema struct {
+    Name     string
+    // Prefix capitalizes Name in the generators, like randomCommonOrigin
+    Prefix   string
+    Enums    []Enum
+    Structs  []Struct
+    Newtypes []Newtype
+
+    // Tuples are the generic tuples used by the declarations, they
+    // are declared by the package generated for the imported schema
+    Tuples   map[string]int
+    // Imports are the Go packages referenced by the declarations
+    Imports  map[string]string
 }
 
 var (
     Enums []Enum
+    Structs []Struct
+    Newtypes []Newtype
 
     // map of tuple name and tuple qty of values
     GenericTuple map[string]int = make(map[string]int)
+
+    // map of import name and Go import path of the imported schemas
+    Imports map[string]string = make(map[string]string)
+
+    // schemas imported by the generated one, directly or not
+    ImportedSchemas []ImportedSchema
 )
 
 %}
@@ -32,115 +127,242 @@ var (
 %token TYPE
 %token RESULT
 %token OPTION
+%token STRUCT
+%token INTEGER
+%token VEC
+%token COMPACT
+%token BTREEMAP
+%token BTREESET
+%token TYPEALIAS
+%token NEWTYPE
+%token IMPORT
+%token AS
+%token STRING
+%token TYPEEXPR
 
 %%
 
-Enums: /* empty */ {
-    Enums = make([]Enum, 0)
-} | Enums Enum
+/* TYPEEXPR is sent first by the lexer of ParseTypeExpr to parse a single type */
+Start: Schema | TYPEEXPR ComplexType {
+    yylex.(*lexer).typeExpr = $2.typeExpr
+};
+
+Schema: /* empty */
+    | Schema Enum {
+    yylex.(*lexer).schema.Enums = append(yylex.(*lexer).schema.Enums, $2.enum)
+} | Schema Struct {
+    yylex.(*lexer).schema.Structs = append(yylex.(*lexer).schema.Structs, $2.structDecl)
+} | Schema Import {
+    yylex.(*lexer).schema.Imports = append(yylex.(*lexer).schema.Imports, $2.imp)
+} | Schema Alias {
+    yylex.(*lexer).schema.Aliases = append(yylex.(*lexer).schema.Aliases, $2.alias)
+} | Schema Newtype {
+    yylex.(*lexer).schema.Newtypes = append(yylex.(*lexer).schema.Newtypes, $2.newtype)
+} | Schema error "}" {
+    yylex.(*lexer).contexts = nil
+    yylex.(*lexer).typeParams = nil
+};
+
+Enum: EnumHead "{" EnumFields "}" {
+    $$.enum = $1.enum
+    $$.enum.Variants = $3.variants
+    $$.enum.End = $4.pos
+    yylex.(*lexer).typeParams = nil
+    for idx, variant := range $$.enum.Variants {
+        if !variant.ExplicitIndex {
+            variant.Index = idx
+        }
+    }
+};
+
+// the declaration head is reduced before its body is parsed, so the
+// type parameters are known when the body types are resolved
+EnumHead: ENUM DeclName TypeParams {
+    $$.enum = &EnumDecl{Pos: $2.pos, Name: $2.sval, TypeParams: $3.params, Doc: $1.doc}
+    yylex.(*lexer).typeParams = $3.params
+};
+
+// declarations may be named after a built-in type, the name is then
+// only reachable through the declaration, which the linter reports
+DeclName: IDENTIFIER | TYPE | OPTION | RESULT | VEC | COMPACT | BTREEMAP | BTREESET ;
+
+TypeParams: /* empty */ {
+    $$.params = nil
+} | "<" TypeParamList ">" {
+    $$.params = $2.params
+};
 
-Enum: ENUM IDENTIFIER "{" EnumFields "}" {
-    $$.enum = Enum{Name: $2.sval, Variants: $4.enumFields}
-    Enums = append(Enums, $$.enum)
-    fmt.Printf("Parsed enum: %s with fields: %+v\n", $2.sval, Enums)
+TypeParamList: IDENTIFIER {
+    $$.params = []*TypeParam{{Pos: $1.pos, Name: $1.sval}}
+} | TypeParamList "," IDENTIFIER {
+    $$.params = append($1.params, &TypeParam{Pos: $3.pos, Name: $3.sval})
 };
 
 EnumFields: /* empty */ {
-    $$.enumFields = nil // Initialize as an empty slice
+    $$.variants = nil
 } | EnumFields EnumField {
-    $$.enumFields = append($1.enumFields, $2.enumField)
+    $$.variants = append($1.variants, $2.variant)
 };
 
-EnumField: IDENTIFIER {
-    $$.enumField = EnumField{
-        Name: $1.sval, 
-        Type: "*scale_codec.SimpleVariant", 
-        TypeConstructor: "new(scale_codec.SimpleVariant)",
-        UnmarshalScale: "",
+EnumField: Variant VariantDiscriminant {
+    $$.variant = $1.variant
+    setVariantIndex($$.variant, $2.index)
+} | VariantAttributes Variant VariantDiscriminant {
+    $$.variant = $2.variant
+    setVariantIndex($$.variant, $1.index)
+    // the doc comments are written before the attributes or after them
+    if $$.variant.Doc == "" {
+        $$.variant.Doc = $1.doc
     }
-} | IDENTIFIER "(" ComplexType ")" {
-    $$.enumField = EnumField{
-        Name: $1.sval, 
-        Type: $3.ttype, 
-        TypeConstructor: $3.sval,
-        UnmarshalScale: $3.unmarshalScale,
+    if $3.index != nil {
+        yylex.(*lexer).errs.add($3.index.pos,
+            "variant %s has both an @index attribute and a discriminant", $2.variant.Name)
     }
 };
 
+VariantAttributes: VariantAttribute | VariantAttributes VariantAttribute {
+    $$.index = $2.index
+    $$.doc = $1.doc
+};
 
-ComplexType: TYPE {
-    $$.sval = "new(" + $1.sval + ")"
-    $$.ttype = "*" + $1.sval
-    $$.fromRawBytesFunc = $1.fromRawBytesFunc
-} | Tuple | Option | Result ;
+VariantAttribute: "@" IDENTIFIER "(" Integer ")" {
+    $$.index = $4.index
+    $$.doc = $1.doc
+    if $2.sval != "index" {
+        yylex.(*lexer).errs.add($2.pos, "unknown attribute @%s", $2.sval)
+    }
+};
+
+VariantDiscriminant: /* empty */ {
+    $$.index = nil
+} | "=" Integer {
+    $$.index = $2.index
+};
+
+Integer: INTEGER {
+    $$.index = &explicitIndex{pos: $1.pos, value: yylex.(*lexer).parseInt($1.pos, $1.sval)}
+};
+
+Variant: IDENTIFIER {
+    $$.variant = &VariantDecl{Pos: $1.pos, Name: $1.sval, Doc: $1.doc}
+} | IDENTIFIER "(" TypeList ")" {
+    $$.variant = &VariantDecl{Pos: $1.pos, Name: $1.sval, End: $4.pos, Doc: $1.doc}
+    if len($3.typeExprs) == 1 {
+        $$.variant.Payload = $3.typeExprs[0]
+    } else {
+        $$.variant.Fields = tupleFields($3.typeExprs)
+    }
+} | IDENTIFIER "{" StructFields "}" {
+    $$.variant = &VariantDecl{Pos: $1.pos, Name: $1.sval, Fields: $3.fields, Named: true, End: $4.pos, Doc: $1.doc}
+};
+
+Struct: StructHead "{" StructFields "}" {
+    $$.structDecl = $1.structDecl
+    $$.structDecl.Fields = $3.fields
+    $$.structDecl.End = $4.pos
+    yylex.(*lexer).typeParams = nil
+} | StructHead "(" TypeList ")" {
+    $$.structDecl = $1.structDecl
+    $$.structDecl.Tuple = true
+    $$.structDecl.Fields = tupleFields($3.typeExprs)
+    $$.structDecl.End = $4.pos
+    yylex.(*lexer).typeParams = nil
+};
+
+StructHead: STRUCT DeclName TypeParams {
+    $$.structDecl = &StructDecl{Pos: $2.pos, Name: $2.sval, TypeParams: $3.params, Doc: $1.doc}
+    yylex.(*lexer).typeParams = $3.params
+};
+
+Import: IMPORT STRING OptionalSemicolon {
+    path := yylex.(*lexer).unquote($2.pos, $2.sval)
+    $$.imp = &ImportDecl{Pos: $2.pos, Path: path, Name: importName(path)}
+} | IMPORT STRING AS IDENTIFIER OptionalSemicolon {
+    path := yylex.(*lexer).unquote($2.pos, $2.sval)
+    $$.imp = &ImportDecl{Pos: $2.pos, Path: path, Name: $4.sval}
+};
+
+Alias: TYPEALIAS DeclName "=" ComplexType OptionalSemicolon {
+    $$.alias = &AliasDecl{Pos: $2.pos, Name: $2.sval, Type: $4.typeExpr}
+};
+
+Newtype: NEWTYPE DeclName "(" ComplexType ")" OptionalSemicolon {
+    $$.newtype = &NewtypeDecl{Pos: $2.pos, Name: $2.sval, Type: $4.typeExpr, Doc: $1.doc}
+};
+
+OptionalSemicolon: /* empty */ | ";" ;
+
+StructFields: /* empty */ {
+    $$.fields = nil
+} | FieldList | FieldList "," ;
+
+FieldList: Field {
+    $$.fields = []*FieldDecl{$1.field}
+} | FieldList "," Field {
+    $$.fields = append($1.fields, $3.field)
+};
 
+Field: IDENTIFIER ":" ComplexType {
+    $$.field = &FieldDecl{Pos: $1.pos, Name: $1.sval, Type: $3.typeExpr, Doc: $1.doc}
+};
+
+ComplexType: TYPE {
+    $$.typeExpr = &TypeExpr{Pos: $1.pos, Kind: PrimitiveType, Name: $1.sval}
+} | IDENTIFIER {
+    $$.typeExpr = &TypeExpr{Pos: $1.pos, Kind: NamedType, Name: $1.sval}
+    if yylex.(*lexer).isTypeParam($1.sval) {
+        $$.typeExpr.Kind = ParamType
+    }
+} | IDENTIFIER "<" TypeList ">" {
+    $$.typeExpr = &TypeExpr{Pos: $1.pos, Kind: NamedType, Name: $1.sval, Args: $3.typeExprs}
+    if yylex.(*lexer).isTypeParam($1.sval) {
+        yylex.(*lexer).errs.add($1.pos, "type parameter %s cannot have type arguments", $1.sval)
+    }
+} | IDENTIFIER "." IDENTIFIER {
+    $$.typeExpr = &TypeExpr{Pos: $1.pos, Kind: NamedType, Package: $1.sval, Name: $3.sval}
+} | IDENTIFIER "." IDENTIFIER "<" TypeList ">" {
+    $$.typeExpr = &TypeExpr{Pos: $1.pos, Kind: NamedType, Package: $1.sval, Name: $3.sval, Args: $5.typeExprs}
+} | Tuple | Option | Result | Vec | Array | Compact | Map | Set ;
 
 Tuple: "(" TypeList ")" {
-    GenericTuple["T" + fmt.Sprint(len($2.tupleValuesTypes))] = len($2.tupleValuesTypes)
-    genericTuple := "T" + fmt.Sprint(len($2.tupleValuesTypes)) + "[" + strings.Join($2.tupleValuesTypes, ",") + "]"
-    $$.ttype = "*" + genericTuple
-    $$.sval = "new(" + genericTuple + ")"
-    $$.fromRawBytesFunc = "UnmarshalT"+ fmt.Sprint(len($2.tupleValuesTypes)) + 
-        "FromRawBytes[" + strings.Join($2.tupleValuesTypes, ",") + 
-        "](" + strings.Join($2.tupleValuesUnmarshalScale, ",") + ")"
-    $$.unmarshalScale = "return i.Inner.UnmarshalSCALE(reader," + strings.Join($2.tupleValuesUnmarshalScale, ",") + ")"
-};
-
-TypeList: IDENTIFIER {
-    $$.sval = $1.sval
-    $$.tupleValuesTypes = append($$.tupleValuesTypes, $1.sval)
-    $$.tupleValuesUnmarshalScale = append($$.tupleValuesUnmarshalScale, "Unmarshal"+$1.sval)
-} | ComplexType {
-    $$.sval = $1.sval
-    $$.tupleValuesTypes = append($$.tupleValuesTypes, $1.ttype)
-    $$.tupleValuesUnmarshalScale = append($$.tupleValuesUnmarshalScale, $1.fromRawBytesFunc)
-} | TypeList "," IDENTIFIER {
-    $$.sval += "," + $3.sval
-    $$.tupleValuesTypes = append($$.tupleValuesTypes, $3.sval)
-    $$.tupleValuesUnmarshalScale = append($$.tupleValuesUnmarshalScale, "Unmarshal"+$3.sval)
+    $$.typeExpr = &TypeExpr{Pos: $1.pos, Kind: TupleType, Args: $2.typeExprs}
+} | "(" ")" {
+    $$.typeExpr = &TypeExpr{Pos: $1.pos, Kind: UnitType}
+};
+
+TypeList: ComplexType {
+    $$.typeExprs = []*TypeExpr{$1.typeExpr}
 } | TypeList "," ComplexType {
-    $$.sval += "," + $3.sval
-    $$.tupleValuesTypes = append($$.tupleValuesTypes, $3.ttype)
-    $$.tupleValuesUnmarshalScale = append($$.tupleValuesUnmarshalScale, $3.fromRawBytesFunc)
+    $$.typeExprs = append($1.typeExprs, $3.typeExpr)
 };
 
 Option: OPTION "<" ComplexType ">" {
-    $$.sval = "new(scale_codec.OptionG[" + $3.ttype + "])"
-    $$.ttype = "*scale_codec.OptionG[" + $3.ttype + "]"
-    $$.fromRawBytesFunc = "scale_codec.UnmarshalOptionFromRawBytes[" + $3.ttype + "](" + $3.fromRawBytesFunc + ")"
-    $$.unmarshalScale  = "return i.Inner.UnmarshalSCALE(reader," + $3.fromRawBytesFunc + ")"
-} | OPTION "<" IDENTIFIER ">" {
-    $$.sval = "new(scale_codec.OptionG[" + $3.sval + "])"
-    $$.ttype = "*scale_codec.OptionG[" + $3.sval + "]"
-    $$.fromRawBytesFunc = "scale_codec.UnmarshalOptionFromRawBytes[" + $3.sval + "](Unmarshal"+ $3.sval +")"
-    $$.unmarshalScale  = "return i.Inner.UnmarshalSCALE(reader, Unmarshal" + $3.sval + ")"
-} ;
+    $$.typeExpr = &TypeExpr{Pos: $1.pos, Kind: OptionType, Args: []*TypeExpr{$3.typeExpr}}
+};
 
 Result: RESULT "<" ComplexType "," ComplexType ">" {
-    $$.sval = "new(scale_codec.ResultG[" + $3.ttype + "," + $5.ttype + "])"
-    $$.ttype = "*scale_codec.ResultG[" + $3.ttype + "," + $5.ttype +"]"
-    $$.fromRawBytesFunc = "scale_codec.UnmarshalResultFromRawBytes[" + 
-        $3.ttype + ","+ $5.ttype +"]("+ $3.fromRawBytesFunc +","+ $5.fromRawBytesFunc +")"
-    $$.unmarshalScale  = "return i.Inner.UnmarshalSCALE(reader, " + $3.fromRawBytesFunc + ", " + $5.fromRawBytesFunc + ")"
-} | RESULT "<" IDENTIFIER "," ComplexType ">" {
-    $$.sval = "new(scale_codec.ResultG[" + $3.sval + "," + $5.ttype + "])"
-    $$.ttype = "*scale_codec.ResultG[" + $3.sval + "," + $5.ttype +"]"
-    $$.fromRawBytesFunc = "scale_codec.UnmarshalResultFromRawBytes[" + 
-        $3.sval + ","+ $5.ttype +"](Unmarshal"+ $3.sval +","+ $5.fromRawBytesFunc +")"
-    $$.unmarshalScale  = "return i.Inner.UnmarshalSCALE(reader, Unmarshal" + $3.sval + ", " + $5.fromRawBytesFunc + ")"
-} | RESULT "<" ComplexType "," IDENTIFIER ">" {
-    $$.sval = "new(scale_codec.ResultG[" + $3.ttype + "," + $5.sval + "])"
-    $$.ttype = "*scale_codec.ResultG[" + $3.ttype + "," + $5.sval +"]"
-    $$.fromRawBytesFunc = "scale_codec.UnmarshalResultFromRawBytes[" + 
-        $3.ttype + ","+ $5.sval +"]("+ $3.fromRawBytesFunc +",Unmarshal"+ $5.sval +")"
-    $$.unmarshalScale  = "return i.Inner.UnmarshalSCALE(reader, " + $3.fromRawBytesFunc + ", Unmarshal" + $5.sval + ")"
-} | RESULT "<" IDENTIFIER "," IDENTIFIER ">" {
-    $$.sval = "new(scale_codec.ResultG[" + $3.sval + "," + $5.sval + "])"
-    $$.ttype = "*scale_codec.ResultG[" + $3.sval + "," + $5.sval +"]"
-    $$.fromRawBytesFunc = "scale_codec.UnmarshalResultFromRawBytes[" + 
-        $3.sval + ","+ $5.sval +"](Unmarshal"+ $3.sval +",Unmarshal"+ $5.sval +")"
-    $$.unmarshalScale  = "return i.Inner.UnmarshalSCALE(reader, Unmarshal" + $3.sval + ", Unmarshal" + $5.sval + ")"
-} ;
+    $$.typeExpr = &TypeExpr{Pos: $1.pos, Kind: ResultType, Args: []*TypeExpr{$3.typeExpr, $5.typeExpr}}
+};
 
-%%
+Vec: VEC "<" ComplexType ">" {
+    $$.typeExpr = &TypeExpr{Pos: $1.pos, Kind: VecType, Args: []*TypeExpr{$3.typeExpr}}
+};
+
+Array: "[" ComplexType ";" INTEGER "]" {
+    $$.typeExpr = &TypeExpr{Pos: $1.pos, Kind: ArrayType, Args: []*TypeExpr{$2.typeExpr},
+        Len: yylex.(*lexer).parseInt($4.pos, $4.sval)}
+};
+
+Compact: COMPACT "<" ComplexType ">" {
+    $$.typeExpr = &TypeExpr{Pos: $1.pos, Kind: CompactType, Args: []*TypeExpr{$3.typeExpr}}
+};
 
+Map: BTREEMAP "<" ComplexType "," ComplexType ">" {
+    $$.typeExpr = &TypeExpr{Pos: $1.pos, Kind: MapType, Args: []*TypeExpr{$3.typeExpr, $5.typeExpr}}
+};
+
+Set: BTREESET "<" ComplexType ">" {
+    $$.typeExpr = &TypeExpr{Pos: $1.pos, Kind: SetType, Args: []*TypeExpr{$3.typeExpr}}
+};
+
+%%
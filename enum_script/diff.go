@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+const diffUsage = "Usage: enum_script diff [-q] <old.scale> <new.scale>\n"
+
+// runDiff implements `enum_script diff old.scale new.scale`, it prints the
+// breaking and compatible changes between both schemas and returns 1 when
+// any change is breaking and 2 when the schemas cannot be compared
+func runDiff(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	quiet := flags.Bool("q", false, "quiet mode, only the breaking changes are reported")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, diffUsage+"\nFlags:\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return 2
+	}
+
+	previous, err := parseSchemaFile(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	current, err := parseSchemaFile(flags.Arg(1))
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	var breaking, compatible []*scale_codec.SchemaChange
+	for _, change := range scale_codec.DiffSchemas(previous, current) {
+		if change.Breaking {
+			breaking = append(breaking, change)
+		} else {
+			compatible = append(compatible, change)
+		}
+	}
+
+	printChanges(stdout, "breaking changes", breaking)
+	if !*quiet {
+		printChanges(stdout, "compatible changes", compatible)
+		if len(breaking) == 0 && len(compatible) == 0 {
+			fmt.Fprintln(stdout, "no changes")
+		}
+	}
+
+	if len(breaking) > 0 {
+		return 1
+	}
+	return 0
+}
+
+func printChanges(w io.Writer, title string, changes []*scale_codec.SchemaChange) {
+	if len(changes) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "%s:\n", title)
+	for _, change := range changes {
+		fmt.Fprintf(w, "\t%s\n", change)
+	}
+}
+
+func parseSchemaFile(file string) (*scale_codec.Schema, error) {
+	contents, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return scale_codec.ParseSchema(file, bytes.NewReader(contents))
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunDiff(t *testing.T) {
+	dir := t.TempDir()
+	schemas := map[string]string{
+		"v1.scale": "enum Call {\n\tRemark\n\tSend(u64)\n}\n",
+		"v2.scale": "enum Call {\n\tRemark\n\tSend(u64)\n\tBurn\n}\n",
+		"v3.scale": "enum Call {\n\tSend(u64)\n\tBurn\n}\n",
+	}
+	for name, contents := range schemas {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := []struct {
+		args     []string
+		code     int
+		expected []string
+	}{
+		{
+			args:     []string{"v1.scale", "v1.scale"},
+			expected: []string{"no changes"},
+		},
+		{
+			args:     []string{"v1.scale", "v2.scale"},
+			expected: []string{"compatible changes:", "v2.scale:4:2: variant Call::Burn added with index 2"},
+		},
+		{
+			args: []string{"v2.scale", "v3.scale"},
+			code: 1,
+			expected: []string{
+				"breaking changes:",
+				"v3.scale:2:2: variant Call::Send index changed from 1 to 0",
+				"v2.scale:2:2: variant Call::Remark with index 0 removed",
+			},
+		},
+		{
+			args: []string{"-q", "v1.scale", "v2.scale"},
+		},
+		{
+			args: []string{"v1.scale", "missing.scale"},
+			code: 2,
+		},
+		{
+			args: []string{"v1.scale"},
+			code: 2,
+		},
+	}
+
+	for _, tt := range cases {
+		args := make([]string, len(tt.args))
+		for idx, arg := range tt.args {
+			if strings.HasSuffix(arg, ".scale") {
+				arg = filepath.Join(dir, arg)
+			}
+			args[idx] = arg
+		}
+
+		var stdout, stderr bytes.Buffer
+		if code := runDiff(args, &stdout, &stderr); code != tt.code {
+			t.Fatalf("%v\nexpected: exit code %d\ngot: exit code %d\n%s", tt.args, tt.code, code, stderr.String())
+		}
+
+		output := strings.ReplaceAll(stdout.String(), dir+string(filepath.Separator), "")
+		if len(tt.expected) == 0 && tt.code == 0 && output != "" {
+			t.Fatalf("%v\nexpected: no output\ngot: %s", tt.args, output)
+		}
+
+		for _, line := range tt.expected {
+			if !strings.Contains(output, line) {
+				t.Fatalf("%v\nexpected: %v\ngot: %v", tt.args, line, output)
+			}
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+type options struct {
+	inputs stringsFlag
+	outDir string
+	pkg    string
+	check  bool
+	quiet  bool
+	naming namingStrategy
+	lang   language
+	tests  bool
+}
+
+// stringsFlag is a flag that can be given several times
+type stringsFlag []string
+
+func (s *stringsFlag) String() string {
+	return fmt.Sprint(*s)
+}
+
+func (s *stringsFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+type generatedFile struct {
+	path     string
+	contents []byte
+}
+
+// inputFiles expands the -in values, each one is either a .scale file,
+// a directory whose .scale files are generated or a glob pattern
+func inputFiles(inputs []string) ([]string, error) {
+	seen := make(map[string]bool)
+	files := make([]string, 0, len(inputs))
+	add := func(file string) {
+		file = filepath.Clean(file)
+		if !seen[file] {
+			seen[file] = true
+			files = append(files, file)
+		}
+	}
+
+	for _, input := range inputs {
+		finfo, err := os.Stat(input)
+		switch {
+		case err == nil && finfo.IsDir():
+			matches, err := filepath.Glob(filepath.Join(input, "*"+inputExt))
+			if err != nil {
+				return nil, err
+			}
+
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("no %s files in %s", inputExt, input)
+			}
+
+			for _, match := range matches {
+				add(match)
+			}
+		case err == nil:
+			if !isScaleFile(input) {
+				return nil, fmt.Errorf("expected a %s file: %s", inputExt, input)
+			}
+			add(input)
+		default:
+			matches, globErr := filepath.Glob(input)
+			if globErr != nil {
+				return nil, globErr
+			}
+
+			scaleMatches := 0
+			for _, match := range matches {
+				if isScaleFile(match) {
+					add(match)
+					scaleMatches++
+				}
+			}
+
+			if scaleMatches == 0 {
+				return nil, fmt.Errorf("no %s files match %s: %w", inputExt, input, err)
+			}
+		}
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+// generateFiles generates the Go code of every schema, the generic tuples
+// shared by several schemas of the same output directory, which make up
+// a single package, are only declared by the first file that uses them.
+// With opts.tests the tests of every schema are generated next to its code
+func generateFiles(files []string, opts options) ([]generatedFile, error) {
+	if opts.lang == rustLanguage {
+		return generateRustFiles(files, opts)
+	}
+
+	declaredTuples := make(map[string]bool)
+	declaredTests := make(map[string]map[string]bool)
+	generated := make([]generatedFile, 0, len(files))
+	for _, file := range files {
+		contents, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		if !opts.quiet {
+			fmt.Printf("Parsing %v file\n", file)
+		}
+
+		err = scale_codec.ParseEnum(file, bytes.NewReader(contents))
+		if err != nil {
+			return nil, err
+		}
+
+		outDir := opts.outDir
+		if outDir == "" {
+			outDir = filepath.Dir(file)
+		}
+
+		tuples := make(map[string]int, len(scale_codec.GenericTuple))
+		for name, arity := range scale_codec.GenericTuple {
+			key := filepath.Join(outDir, name)
+			if !declaredTuples[key] {
+				declaredTuples[key] = true
+				tuples[name] = arity
+			}
+		}
+
+		err = checkNames(opts.naming, scale_codec.GenericTuple, scale_codec.Enums, scale_codec.Structs, scale_codec.Newtypes)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+
+		output := parseEnumsDefinition(opts.pkg, opts.naming, tuples, scale_codec.Enums, scale_codec.Structs)
+		generated = append(generated, generatedFile{
+			path:     filepath.Join(outDir, removeExtension(filepath.Base(file))+outputExt),
+			contents: []byte(output),
+		})
+
+		if opts.tests {
+			if declaredTests[outDir] == nil {
+				declaredTests[outDir] = make(map[string]bool)
+			}
+
+			tests := parseTestsDefinition(opts.pkg, opts.naming, tuples, declaredTests[outDir])
+			generated = append(generated, generatedFile{
+				path:     filepath.Join(outDir, removeExtension(filepath.Base(file))+testsOutputExt),
+				contents: []byte(tests),
+			})
+		}
+	}
+
+	return generated, nil
+}
+
+// generateRustFiles generates a Rust module of every schema
+func generateRustFiles(files []string, opts options) ([]generatedFile, error) {
+	generated := make([]generatedFile, 0, len(files))
+	for _, file := range files {
+		contents, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		if !opts.quiet {
+			fmt.Printf("Parsing %v file\n", file)
+		}
+
+		schema, err := scale_codec.ParseSchema(file, bytes.NewReader(contents))
+		if err != nil {
+			return nil, err
+		}
+
+		output, err := generateRust(schema)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+
+		outDir := opts.outDir
+		if outDir == "" {
+			outDir = filepath.Dir(file)
+		}
+
+		generated = append(generated, generatedFile{
+			path:     filepath.Join(outDir, removeExtension(filepath.Base(file))+rustOutputExt),
+			contents: []byte(output),
+		})
+	}
+
+	return generated, nil
+}
+
+// staleFiles returns the generated files that are missing or whose
+// contents differ from the freshly generated code
+func staleFiles(generated []generatedFile) ([]string, error) {
+	var stale []string
+	for _, file := range generated {
+		current, err := os.ReadFile(file.path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		if err != nil || !bytes.Equal(current, file.contents) {
+			stale = append(stale, file.path)
+		}
+	}
+
+	return stale, nil
+}
+
+func writeFile(file generatedFile) error {
+	if err := os.MkdirAll(filepath.Dir(file.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(file.path, file.contents, 0o644)
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestInputFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"b.scale", "a.scale", "c.go", "sub/d.scale"} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := []struct {
+		inputs   []string
+		expected []string
+	}{
+		{
+			inputs:   []string{dir},
+			expected: []string{"a.scale", "b.scale"},
+		},
+		{
+			inputs:   []string{filepath.Join(dir, "b.scale"), filepath.Join(dir, "sub", "d.scale")},
+			expected: []string{"b.scale", "sub/d.scale"},
+		},
+		{
+			inputs:   []string{filepath.Join(dir, "*", "*.scale"), filepath.Join(dir, "a.scale"), dir},
+			expected: []string{"a.scale", "b.scale", "sub/d.scale"},
+		},
+	}
+
+	for _, tt := range cases {
+		files, err := inputFiles(tt.inputs)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for idx, file := range files {
+			files[idx], _ = filepath.Rel(dir, file)
+			files[idx] = filepath.ToSlash(files[idx])
+		}
+
+		if !reflect.DeepEqual(files, tt.expected) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expected, files)
+		}
+	}
+
+	for _, input := range []string{filepath.Join(dir, "c.go"), filepath.Join(dir, "*.go"), filepath.Join(dir, "missing")} {
+		if _, err := inputFiles([]string{input}); err == nil {
+			t.Fatalf("expected an error for %s", input)
+		}
+	}
+}
+
+func TestStaleFiles(t *testing.T) {
+	dir := t.TempDir()
+	upToDate := filepath.Join(dir, "up_to_date.go")
+	changed := filepath.Join(dir, "changed.go")
+	missing := filepath.Join(dir, "missing.go")
+	if err := os.WriteFile(upToDate, []byte("package main\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(changed, []byte("package old\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	stale, err := staleFiles([]generatedFile{
+		{path: upToDate, contents: []byte("package main\n")},
+		{path: changed, contents: []byte("package main\n")},
+		{path: missing, contents: []byte("package main\n")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{changed, missing}
+	if !reflect.DeepEqual(stale, expected) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expected, stale)
+	}
+}
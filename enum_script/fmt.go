@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+const fmtUsage = "Usage: enum_script fmt [-l] [-w] <file|dir|glob>...\n"
+
+// runFmt implements `enum_script fmt`, it prints the formatted schemas,
+// rewrites them with -w or lists the ones that are not formatted with -l,
+// in which case it returns 1 when any file is listed. Errors return 2
+func runFmt(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("fmt", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	list := flags.Bool("l", false, "list the files whose formatting differs, exit with 1 when any does")
+	write := flags.Bool("w", false, "write the result to the file instead of stdout")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, fmtUsage+"\nFlags:\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	files, err := inputFiles(flags.Args())
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	code := 0
+	for _, file := range files {
+		contents, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+
+		formatted, err := scale_codec.FormatSchema(file, contents)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+
+		changed := !bytes.Equal(contents, formatted)
+		if *list && changed {
+			fmt.Fprintln(stdout, file)
+			code = 1
+		}
+
+		if *write && changed {
+			if err := os.WriteFile(file, formatted, 0o644); err != nil {
+				fmt.Fprintln(stderr, err)
+				return 2
+			}
+		}
+
+		if !*list && !*write {
+			stdout.Write(formatted)
+		}
+	}
+
+	return code
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunFmt(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "calls.scale")
+	if err := os.WriteFile(file, []byte("enum Call {\n    Remark\n\tSend(u64)\n}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := runFmt([]string{"-l", dir}, &stdout, &stderr); code != 1 {
+		t.Fatalf("\nexpected: exit code 1\ngot: exit code %d\n%s", code, stderr.String())
+	}
+
+	if stdout.String() != file+"\n" {
+		t.Fatalf("\nexpected: %v\ngot: %v", file, stdout.String())
+	}
+
+	stdout.Reset()
+	if code := runFmt([]string{"-w", file}, &stdout, &stderr); code != 0 {
+		t.Fatalf("\nexpected: exit code 0\ngot: exit code %d\n%s", code, stderr.String())
+	}
+
+	contents, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "enum Call {\n\tRemark\n\tSend(u64)\n}\n"
+	if string(contents) != expected {
+		t.Fatalf("\nexpected: %q\ngot: %q", expected, contents)
+	}
+
+	if code := runFmt([]string{"-l", file}, &stdout, &stderr); code != 0 || stdout.Len() != 0 {
+		t.Fatalf("\nexpected: no output\ngot: exit code %d\n%s", code, stdout.String())
+	}
+}
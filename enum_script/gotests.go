@@ -0,0 +1,406 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"text/template"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+// testsOutputExt is the suffix of the generated tests, it differs from the
+// `_test.go` suffix of the tests written next to the generated code
+const testsOutputExt = "_codec_test.go"
+
+// testsDeclaration is a function of the generated tests
+type testsDeclaration struct {
+	Name string
+	Code string
+}
+
+// testsGenerator builds the tests of a generated file: a function building
+// random values of every type, a round-trip test of every enum, struct and
+// newtype and a fuzz target decoding every enum. Type names are qualified
+// by pkg for the declarations of an imported schema, whose generators
+// are named after prefix
+type testsGenerator struct {
+	naming namingStrategy
+	pkg    string
+	prefix string
+}
+
+// parseTestsDefinition returns the tests of the file generated from the lowered
+// schema. The functions shared by the files of the package, which are the
+// generators of the imported types and assertRoundTrip, are only declared
+// when declared does not hold them yet, declared is then updated
+func parseTestsDefinition(pacakge string, naming namingStrategy, tuples map[string]int,
+	declared map[string]bool) string {
+	var decls []testsDeclaration
+	declare := func(decl testsDeclaration) {
+		if !declared[decl.Name] {
+			declared[decl.Name] = true
+			decls = append(decls, decl)
+		}
+	}
+
+	declare(testsDeclaration{Name: "assertRoundTrip", Code: assertRoundTripCode})
+
+	local := testsGenerator{naming: naming}
+	imports := make(map[string]string, len(scale_codec.Imports))
+	for name, path := range scale_codec.Imports {
+		imports[name] = path
+	}
+
+	var generators []testsDeclaration
+	generators = append(generators, local.tuples(tuples)...)
+	generators = append(generators, local.declarations(scale_codec.Enums, scale_codec.Structs, scale_codec.Newtypes)...)
+
+	for _, imported := range scale_codec.ImportedSchemas {
+		gen := testsGenerator{naming: naming, pkg: imported.Name, prefix: imported.Prefix}
+		for _, decl := range gen.tuples(imported.Tuples) {
+			declare(decl)
+		}
+
+		for _, decl := range gen.declarations(imported.Enums, imported.Structs, imported.Newtypes) {
+			declare(decl)
+		}
+
+		for name, path := range imported.Imports {
+			imports[name] = path
+		}
+	}
+
+	decls = append(decls, generators...)
+	decls = append(decls, local.tests(scale_codec.Enums, scale_codec.Structs, scale_codec.Newtypes)...)
+
+	code := make([]string, len(decls))
+	for idx, decl := range decls {
+		code[idx] = decl.Code
+	}
+
+	value := struct {
+		Package      string
+		StdImports   []string
+		Imports      []string
+		Declarations string
+	}{
+		Package:      pacakge,
+		Declarations: strings.Join(code, "\n\n"),
+	}
+
+	value.StdImports = usedPackages([]string{"bytes", "io", "reflect", "testing"}, value.Declarations)
+	for _, spec := range parseImports(imports) {
+		name := strings.Fields(spec)[0]
+		if len(usedPackages([]string{name}, value.Declarations)) > 0 {
+			value.Imports = append(value.Imports, spec)
+		}
+	}
+
+	t, err := template.New("tests_file_template").Parse(TestsFileTemplate)
+	if err != nil {
+		log.Fatalf("Parsing template error: %v", err)
+	}
+
+	output := new(strings.Builder)
+	if err := t.Execute(output, value); err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+
+	return output.String()
+}
+
+// qualified returns the Go name of a type declared by the schema of the generator
+func (g testsGenerator) qualified(name string) string {
+	if g.pkg == "" {
+		return name
+	}
+
+	return g.pkg + "." + name
+}
+
+// randomName is the generator of a declared type, like randomCall or randomCommonOrigin
+func (g testsGenerator) randomName(name string) string {
+	return "random" + g.prefix + name
+}
+
+// tuples returns the generators of the generic tuples
+func (g testsGenerator) tuples(tuples map[string]int) []testsDeclaration {
+	names := make([]string, 0, len(tuples))
+	for name := range tuples {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	decls := make([]testsDeclaration, len(names))
+	for idx, name := range names {
+		params := make([]string, tuples[name])
+		fields := make([]string, tuples[name])
+		for fIdx := range params {
+			params[fIdx] = strings.ToUpper(alphabet[fIdx : fIdx+1])
+			fields[fIdx] = fmt.Sprintf("F%d: random%s(r, depth+1)", fIdx, params[fIdx])
+		}
+
+		tuple := g.qualified(name) + "[" + strings.Join(params, ", ") + "]"
+		decls[idx] = g.generator(g.randomName(name), params, "*"+tuple,
+			"return &"+tuple+"{"+strings.Join(fields, ", ")+"}")
+	}
+
+	return decls
+}
+
+// declarations returns the generators of the enums, structs and newtypes
+func (g testsGenerator) declarations(enums []scale_codec.Enum, structs []scale_codec.Struct,
+	newtypes []scale_codec.Newtype) []testsDeclaration {
+	var decls []testsDeclaration
+	for _, enum := range enums {
+		decls = append(decls, g.enum(enum))
+	}
+
+	for _, structDef := range structs {
+		typeArgs := newGenericParams(structDef.TypeParams).TypeArgs
+		body := "return &" + g.qualified(structDef.Name) + typeArgs + "{}"
+		if len(structDef.Fields) > 0 {
+			body = "return &" + g.qualified(structDef.Name) + typeArgs + "{\n" +
+				fieldsInits(structDef.Fields, "\t") + "}"
+		}
+
+		decls = append(decls, g.generator(g.randomName(structDef.Name), structDef.TypeParams,
+			"*"+g.qualified(structDef.Name)+typeArgs, body))
+	}
+
+	for _, newtype := range newtypes {
+		decls = append(decls, g.generator(g.randomName(newtype.Name), nil, "*"+g.qualified(newtype.Name),
+			"return &"+g.qualified(newtype.Name)+"{Inner: "+newtype.Random+"(r, depth+1)}"))
+	}
+
+	return decls
+}
+
+// enum returns the generator of enum, once the values reach their maximum
+// depth it only picks the variants that do not nest the enum in itself
+func (g testsGenerator) enum(enum scale_codec.Enum) testsDeclaration {
+	if enum.Unit {
+		values := make([]string, len(enum.Variants))
+		for idx, variant := range enum.Variants {
+			values[idx] = g.qualified(g.naming.variantTypeName(enum.Name, variant.Name))
+		}
+
+		return g.generator(g.randomName(enum.Name), nil, "*"+g.qualified(enum.Name),
+			fmt.Sprintf("return []%s{%s}[r.Intn(%d)].Ptr()",
+				g.qualified(enum.Name), strings.Join(values, ", "), len(values)))
+	}
+
+	typeArgs := newGenericParams(enum.TypeParams).TypeArgs
+	values := make([]string, len(enum.Variants))
+	var all, finite []string
+	for idx, variant := range enum.Variants {
+		all = append(all, fmt.Sprint(idx))
+		if variant.Finite {
+			finite = append(finite, fmt.Sprint(idx))
+		}
+
+		name := g.naming.variantTypeName(enum.Name, variant.Name)
+		switch {
+		case variant.Unit:
+			values[idx] = g.qualified("New"+name) + typeArgs + "()"
+		case len(variant.Fields) > 0:
+			values[idx] = "&" + g.qualified(name) + typeArgs + "{\n" + fieldsInits(variant.Fields, "\t\t") + "\t}"
+		default:
+			values[idx] = "&" + g.qualified(name) + typeArgs + "{Inner: " + variant.Random + "(r, depth+1)}"
+		}
+	}
+
+	if len(values) == 1 {
+		return g.generator(g.randomName(enum.Name), enum.TypeParams, g.qualified(enum.Name)+typeArgs,
+			"return "+strings.ReplaceAll(values[0], "\n\t", "\n"))
+	}
+
+	body := new(strings.Builder)
+	if len(finite) == len(all) || len(finite) == 0 {
+		fmt.Fprintf(body, "switch r.Intn(%d) {\n", len(all))
+	} else {
+		fmt.Fprintf(body, "variants := []int{%s}\nif r.Deep(depth) {\n\tvariants = []int{%s}\n}\n\n",
+			strings.Join(all, ", "), strings.Join(finite, ", "))
+		body.WriteString("switch variants[r.Intn(len(variants))] {\n")
+	}
+
+	for idx, value := range values {
+		if idx == len(values)-1 {
+			body.WriteString("default:\n")
+		} else {
+			fmt.Fprintf(body, "case %d:\n", idx)
+		}
+		fmt.Fprintf(body, "\treturn %s\n", value)
+	}
+	body.WriteString("}")
+
+	return g.generator(g.randomName(enum.Name), enum.TypeParams, g.qualified(enum.Name)+typeArgs, body.String())
+}
+
+// generator declares a function building random values of typ with body,
+// the generator of a generic type is a factory taking the generators of
+// its type arguments, like randomT for T
+func (g testsGenerator) generator(name string, typeParams []string, typ, body string) testsDeclaration {
+	if len(typeParams) == 0 {
+		return testsDeclaration{
+			Name: name,
+			Code: fmt.Sprintf("func %s(r *scale_codec.Random, depth int) %s {\n%s\n}",
+				name, typ, indent(body, "\t")),
+		}
+	}
+
+	params := make([]string, len(typeParams))
+	for idx, param := range typeParams {
+		params[idx] = fmt.Sprintf("random%s scale_codec.RandomFunc[%s]", param, param)
+	}
+
+	return testsDeclaration{
+		Name: name,
+		Code: fmt.Sprintf("func %s%s(%s) scale_codec.RandomFunc[%s] {\n"+
+			"\treturn func(r *scale_codec.Random, depth int) %s {\n%s\n\t}\n}",
+			name, newGenericParams(typeParams).TypeParamsDecl, strings.Join(params, ", "),
+			typ, typ, indent(body, "\t\t")),
+	}
+}
+
+// tests returns the round-trip tests of the enums, structs and newtypes and
+// the fuzz targets of the enums, generic types are only tested through the
+// types instantiating them
+func (g testsGenerator) tests(enums []scale_codec.Enum, structs []scale_codec.Struct,
+	newtypes []scale_codec.Newtype) []testsDeclaration {
+	t, err := template.New("round_trip_tests").Parse(RoundTripTestsTemplate)
+	if err != nil {
+		log.Fatalf("Parsing template error: %v", err)
+	}
+
+	type roundTripTest struct {
+		Name string
+		Fuzz bool
+	}
+
+	var tests []roundTripTest
+	for _, enum := range enums {
+		if len(enum.TypeParams) == 0 {
+			tests = append(tests, roundTripTest{Name: enum.Name, Fuzz: true})
+		}
+	}
+
+	for _, structDef := range structs {
+		if len(structDef.TypeParams) == 0 {
+			tests = append(tests, roundTripTest{Name: structDef.Name})
+		}
+	}
+
+	for _, newtype := range newtypes {
+		tests = append(tests, roundTripTest{Name: newtype.Name})
+	}
+
+	decls := make([]testsDeclaration, len(tests))
+	for idx, test := range tests {
+		code := new(strings.Builder)
+		if err := t.Execute(code, test); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		decls[idx] = testsDeclaration{Name: "TestRoundTrip" + test.Name, Code: code.String()}
+	}
+
+	return decls
+}
+
+// fieldsInits initializes every field with a random value, one per line
+func fieldsInits(fields []scale_codec.StructField, prefix string) string {
+	inits := new(strings.Builder)
+	for _, field := range fields {
+		fmt.Fprintf(inits, "%s%s: %s(r, depth+1),\n", prefix, field.Name, field.Random)
+	}
+
+	return inits.String()
+}
+
+func indent(code, prefix string) string {
+	lines := strings.Split(code, "\n")
+	for idx, line := range lines {
+		if line != "" {
+			lines[idx] = prefix + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+const TestsFileTemplate = `// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package {{.Package}}
+
+import (
+{{- range .StdImports }}
+	"{{ . }}"
+{{- end }}
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+{{- range .Imports }}
+	{{ . }}
+{{- end }}
+)
+
+{{ .Declarations }}
+`
+
+// assertRoundTripCode is declared once by the tests of a package
+const assertRoundTripCode = `// assertRoundTrip checks that unmarshal decodes the encoding of value
+// back to a value deeply equal to it, without leaving bytes behind
+func assertRoundTrip[T scale_codec.Marshaler](t *testing.T, value T, unmarshal func(io.Reader) (T, error)) {
+	t.Helper()
+
+	encoded, err := value.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("encoding %v: %v", value, err)
+	}
+
+	reader := bytes.NewReader(encoded)
+	decoded, err := unmarshal(reader)
+	if err != nil {
+		t.Fatalf("decoding %v: %v", encoded, err)
+	}
+
+	if reader.Len() != 0 {
+		t.Fatalf("%d bytes left after decoding %v", reader.Len(), encoded)
+	}
+
+	if !reflect.DeepEqual(value, decoded) {
+		t.Fatalf("\nexpected: %v\ngot: %v", value, decoded)
+	}
+}`
+
+// RoundTripTestsTemplate checks the decoding of random values, the fuzz
+// target of an enum is seeded with the encoding of random values and
+// checks that whatever it decodes is encoded and decoded back
+const RoundTripTestsTemplate = `func TestRoundTrip{{ .Name }}(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, random{{ .Name }}(r, 0), Unmarshal{{ .Name }})
+	}
+}
+{{- if .Fuzz }}
+
+func FuzzUnmarshal{{ .Name }}(f *testing.F) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 16; idx++ {
+		encoded, err := random{{ .Name }}(r, 0).MarshalSCALE()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(encoded)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value, err := Unmarshal{{ .Name }}(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		assertRoundTrip(t, value, Unmarshal{{ .Name }})
+	})
+}
+{{- end }}`
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateTests(t *testing.T) {
+	dir := t.TempDir()
+	schemas := map[string]string{
+		"calls.scale": `
+enum Loop {
+	Again(Loop)
+	Stop(Call)
+}
+
+enum Call {
+	Remark
+	Batch(Vec<Call>)
+	Looped(Loop)
+	Pair(u32, (bool, String))
+}
+
+enum Status {
+	Active
+	Paused
+}`,
+		"events.scale": `
+newtype Nonce(Compact<u32>)
+
+struct Event {
+	nonce: Nonce,
+	flags: (u8, u8),
+}`,
+	}
+
+	var files []string
+	for name, src := range schemas {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, path)
+	}
+
+	files, err := inputFiles([]string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generated, err := generateFiles(files, options{pkg: "main", quiet: true, naming: variantNaming, tests: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(generated) != 4 {
+		t.Fatalf("\nexpected: 4 files\ngot: %d", len(generated))
+	}
+
+	calls, events := string(generated[1].contents), string(generated[3].contents)
+	if filepath.Base(generated[1].path) != "calls_codec_test.go" {
+		t.Fatalf("\nexpected: calls_codec_test.go\ngot: %s", generated[1].path)
+	}
+
+	expected := []string{
+		"func assertRoundTrip[T scale_codec.Marshaler](",
+		"func randomT2[A scale_codec.Marshaler, B scale_codec.Marshaler](randomA scale_codec.RandomFunc[A], randomB scale_codec.RandomFunc[B]) scale_codec.RandomFunc[*T2[A, B]] {",
+		"\tvariants := []int{0, 1}\n\tif r.Deep(depth) {\n\t\tvariants = []int{1}\n\t}",
+		"\tcase 1:\n\t\treturn &Batch{Inner: scale_codec.RandomVec(randomCall)(r, depth+1)}",
+		"\t\treturn &Pair{\n\t\t\tF0: scale_codec.RandomInteger[uint32](r, depth+1),\n" +
+			"\t\t\tF1: randomT2(scale_codec.RandomBool, scale_codec.RandomString)(r, depth+1),\n\t\t}",
+		"\treturn []Status{Active, Paused}[r.Intn(2)].Ptr()",
+		"func TestRoundTripCall(t *testing.T) {",
+		"func FuzzUnmarshalStatus(f *testing.F) {",
+	}
+
+	for _, snippet := range expected {
+		if !strings.Contains(calls, snippet) {
+			t.Fatalf("\nexpected: %v\ngot: %v", snippet, calls)
+		}
+	}
+
+	// the tuples and assertRoundTrip are declared by the first file
+	expected = []string{
+		"\treturn &Nonce{Inner: scale_codec.RandomCompact(r, depth+1)}",
+		"\t\tFlags: randomT2(scale_codec.RandomInteger[uint8], scale_codec.RandomInteger[uint8])(r, depth+1),",
+		"func TestRoundTripEvent(t *testing.T) {",
+	}
+
+	for _, snippet := range expected {
+		if !strings.Contains(events, snippet) {
+			t.Fatalf("\nexpected: %v\ngot: %v", snippet, events)
+		}
+	}
+
+	for _, snippet := range []string{"func assertRoundTrip", "func randomT2", "FuzzUnmarshal"} {
+		if strings.Contains(events, snippet) {
+			t.Fatalf("unexpected %s in %v", snippet, events)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+const lintUsage = "Usage: enum_script lint [-roots Call,Event] <file|dir|glob>...\n"
+
+// runLint implements `enum_script lint`, it prints the issues found in the
+// schemas and returns 1 when there is any, or 2 when a schema is invalid
+func runLint(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("lint", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	roots := flags.String("roots", "", "comma separated `enums` used by the application, they are never reported as unused")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, lintUsage+"\nFlags:\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	files, err := inputFiles(flags.Args())
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	schemas := make([]*scale_codec.Schema, len(files))
+	for idx, file := range files {
+		if schemas[idx], err = parseSchemaFile(file); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+	}
+
+	var rootNames []string
+	if *roots != "" {
+		rootNames = strings.Split(*roots, ",")
+	}
+
+	issues := scale_codec.LintSchemas(schemas, rootNames)
+	for _, issue := range issues {
+		fmt.Fprintln(stdout, issue)
+	}
+
+	if len(issues) > 0 {
+		return 1
+	}
+	return 0
+}
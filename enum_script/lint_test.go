@@ -0,0 +1,39 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunLint(t *testing.T) {
+	dir := t.TempDir()
+	schemas := map[string]string{
+		"common.scale":  "enum Origin {\n\tRoot\n\tSigned(u32)\n}\n\nenum Unused {\n\tnothing\n}\n",
+		"service.scale": "import \"common.scale\"\n\nenum Call {\n\tRemark(common.Origin)\n}\n",
+	}
+	for name, contents := range schemas {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := runLint([]string{"-roots", "Call", dir}, &stdout, &stderr); code != 1 {
+		t.Fatalf("\nexpected: exit code 1\ngot: exit code %d\n%s", code, stderr.String())
+	}
+
+	output := strings.ReplaceAll(stdout.String(), dir+string(filepath.Separator), "")
+	expected := "common.scale:6:6: enum Unused is not used by any declaration\n" +
+		"common.scale:7:2: variant Unused::nothing should be PascalCase, like Nothing\n"
+	if output != expected {
+		t.Fatalf("\nexpected: %v\ngot: %v", expected, output)
+	}
+
+	stdout.Reset()
+	if code := runLint([]string{"-roots", "Call,Unused", filepath.Join(dir, "service.scale")}, &stdout, &stderr); code != 0 {
+		t.Fatalf("\nexpected: exit code 0\ngot: exit code %d\n%s", code, stdout.String())
+	}
+}
@@ -1,11 +1,13 @@
 package main
 
 import (
-	"bytes"
+	"flag"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -35,71 +37,245 @@ func parseMap(list string, f func(int, string) string) []string {
 	return output
 }
 
+// genericParams holds the snippets declaring and using the type parameters
+// of a generic enum or struct, they are empty for non generic declarations
+type genericParams struct {
+	// TypeParamsDecl is `[T scale_codec.Marshaler]`, TypeArgs is `[T]`
+	// and AnyTypeArgs instantiates the type as `[scale_codec.Marshaler]`
+	TypeParamsDecl string
+	TypeArgs       string
+	AnyTypeArgs    string
+
+	// FuncSignatures and FuncNames are the decoders of the type parameters,
+	// `funcT func(io.Reader) (T, error)` and `funcT`, FuncParams and FuncArgs
+	// are the same prefixed by a comma to follow the reader argument
+	FuncSignatures string
+	FuncNames      string
+	FuncParams     string
+	FuncArgs       string
+
+	// JSONFuncSignatures... are the JSON decoders of the type parameters,
+	// `jsonT func([]byte) (T, error)`, given after the data argument
+	JSONFuncSignatures string
+	JSONFuncNames      string
+	JSONFuncParams     string
+	JSONFuncArgs       string
+}
+
+func newGenericParams(params []string) genericParams {
+	if len(params) == 0 {
+		return genericParams{}
+	}
+
+	decls := make([]string, len(params))
+	anys := make([]string, len(params))
+	signatures := make([]string, len(params))
+	names := make([]string, len(params))
+	jsonSignatures := make([]string, len(params))
+	jsonNames := make([]string, len(params))
+	for idx, param := range params {
+		decls[idx] = param + " scale_codec.Marshaler"
+		anys[idx] = "scale_codec.Marshaler"
+		signatures[idx] = fmt.Sprintf("func%s func(io.Reader) (%s, error)", param, param)
+		names[idx] = "func" + param
+		jsonSignatures[idx] = fmt.Sprintf("json%s func([]byte) (%s, error)", param, param)
+		jsonNames[idx] = "json" + param
+	}
+
+	return genericParams{
+		TypeParamsDecl: "[" + strings.Join(decls, ", ") + "]",
+		TypeArgs:       "[" + strings.Join(params, ", ") + "]",
+		AnyTypeArgs:    "[" + strings.Join(anys, ", ") + "]",
+		FuncSignatures: strings.Join(signatures, ", "),
+		FuncNames:      strings.Join(names, ", "),
+		FuncParams:     ", " + strings.Join(signatures, ", "),
+		FuncArgs:       ", " + strings.Join(names, ", "),
+
+		JSONFuncSignatures: strings.Join(jsonSignatures, ", "),
+		JSONFuncNames:      strings.Join(jsonNames, ", "),
+		JSONFuncParams:     ", " + strings.Join(jsonSignatures, ", "),
+		JSONFuncArgs:       ", " + strings.Join(jsonNames, ", "),
+	}
+}
+
 func main() {
-	if len(os.Args) != 3 {
-		log.Fatalf("Error: expected only two argument: scale file and package")
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "diff":
+			os.Exit(runDiff(os.Args[2:], os.Stdout, os.Stderr))
+		case "fmt":
+			os.Exit(runFmt(os.Args[2:], os.Stdout, os.Stderr))
+		case "lint":
+			os.Exit(runLint(os.Args[2:], os.Stdout, os.Stderr))
+		}
 	}
 
-	outputPackage := defaultPackage
-	cliProvidedPackage := strings.TrimSpace(os.Args[2])
-	if cliProvidedPackage != "" {
-		outputPackage = cliProvidedPackage
+	var opts options
+	flag.Var(&opts.inputs, "in", "`.scale` file, directory or glob pattern to generate, it can be repeated")
+	flag.StringVar(&opts.outDir, "out", "", "output `directory`, by default files are generated next to their schema")
+	flag.StringVar(&opts.pkg, "pkg", "", "generated `package` name, $GOPACKAGE under go generate or main otherwise")
+	flag.BoolVar(&opts.check, "check", false, "fail if the generated files are missing or stale instead of writing them")
+	flag.BoolVar(&opts.quiet, "q", false, "quiet mode, only errors are reported")
+	opts.naming = variantNaming
+	flag.Var(&opts.naming, "naming", "`strategy` naming the variant types, \"variant\" (Transfer) or \"enum\" (CallTransfer)")
+	opts.lang = goLanguage
+	flag.Var(&opts.lang, "lang", "`language` of the generated code, \"go\" or \"rust\" (Rust types deriving parity-scale-codec)")
+	flag.BoolVar(&opts.tests, "tests", false, "also generate round-trip tests and fuzz targets of the Go types in <schema>"+testsOutputExt)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(),
+			"Usage: enum_script [flags] -in <file|dir|glob>\n       enum_script [flags] <file> [package]\n       enum_script diff [-q] <old.scale> <new.scale>\n"+
+				"       enum_script fmt [-l] [-w] <file|dir|glob>...\n"+
+				"       enum_script lint [-roots Call,Event] <file|dir|glob>...\n\nFlags:\n")
+		flag.PrintDefaults()
 	}
+	flag.Parse()
 
-	finfo, err := os.Stat(os.Args[1])
-	if err != nil {
-		log.Fatalf("Error: %v", err)
+	// the positional `enum_script file.scale package` form is still accepted
+	args := flag.Args()
+	if len(opts.inputs) == 0 && len(args) > 0 {
+		opts.inputs, args = append(opts.inputs, args[0]), args[1:]
 	}
 
-	if finfo.IsDir() {
-		log.Fatalf("Error: directories are not currently supported")
+	if len(args) > 0 && opts.pkg == "" {
+		opts.pkg, args = strings.TrimSpace(args[0]), args[1:]
 	}
 
-	if !isScaleFile(finfo.Name()) {
-		log.Fatalf("Error: expected a .scale file")
+	if len(opts.inputs) == 0 || len(args) > 0 {
+		flag.Usage()
+		os.Exit(2)
 	}
 
-	contents, err := os.ReadFile(finfo.Name())
-	if err != nil {
-		log.Fatalf("Error: %v", err)
+	if opts.tests && opts.lang != goLanguage {
+		log.Fatalf("Error: -tests is only supported by the Go backend")
+	}
+
+	if opts.pkg == "" {
+		opts.pkg = os.Getenv("GOPACKAGE")
 	}
 
-	fmt.Printf("Parsing %v file\n", finfo.Name())
-	result := scale_codec.ParseEnum(finfo.Name(), bytes.NewReader(contents))
-	if result != 0 {
-		log.Fatalf("Error: failed to parse %s file", finfo.Name())
+	if opts.pkg == "" {
+		opts.pkg = defaultPackage
 	}
 
-	generatedEnums := parseEnumsDefinition(outputPackage, scale_codec.Enums)
-	outputFile := strings.Join([]string{removeExtension(finfo.Name()), outputExt}, "")
-	err = os.WriteFile(outputFile, []byte(generatedEnums), os.ModePerm)
+	files, err := inputFiles(opts.inputs)
 	if err != nil {
 		log.Fatalf("Error: %v", err)
 	}
-	log.Printf("file generated: %s\n", outputFile)
+
+	generated, err := generateFiles(files, opts)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if opts.check {
+		stale, err := staleFiles(generated)
+		if err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+
+		for _, path := range stale {
+			fmt.Fprintf(os.Stderr, "%s is out of date, run enum_script to regenerate it\n", path)
+		}
+
+		if len(stale) > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+
+	for _, file := range generated {
+		if err := writeFile(file); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+
+		if !opts.quiet {
+			log.Printf("file generated: %s\n", file.path)
+		}
+	}
 }
 
-func parseEnumsDefinition(pacakge string, enums []scale_codec.Enum) string {
+func parseEnumsDefinition(pacakge string, naming namingStrategy, tuples map[string]int,
+	enums []scale_codec.Enum, structs []scale_codec.Struct) string {
+	// enumVariant is a variant type name and its name in the
+	// schema, which is the one used by String and the JSON
+	type enumVariant struct {
+		Name       string
+		SchemaName string
+		Index      int
+		Doc        string
+	}
+
 	type enumDefinition struct {
+		genericParams
 		EnumName string
-		Variants []string
+		Variants []enumVariant
+		Doc      string
+		// MatchParamsDecl and MatchArgs add the Result type of
+		// the Match helper to the type parameters of the enum
+		Result          string
+		MatchParamsDecl string
+		MatchArgs       string
+	}
+
+	enumTemplate, err := template.New("enums_definitions").Funcs(templateFuncs).Parse(EnumDefinitionTemplate)
+	if err != nil {
+		log.Fatalf("Parsing template error: %v", err)
 	}
 
-	enumTemplate, err := template.New("enums_definitions").Parse(EnumDefinitionTemplate)
+	unitEnumTemplate, err := template.New("unit_enums_definitions").Funcs(templateFuncs).Parse(UnitEnumDefinitionTemplate)
 	if err != nil {
 		log.Fatalf("Parsing template error: %v", err)
 	}
 
+	type unitEnumDefinition struct {
+		EnumName string
+		Variants []enumVariant
+		Doc      string
+	}
+
 	enumsDefinitions := new(strings.Builder)
 	for _, enum := range enums {
-		variantsName := make([]string, len(enum.Variants))
+		if enum.Unit {
+			value := unitEnumDefinition{EnumName: enum.Name, Doc: enum.Doc}
+			for _, variant := range enum.Variants {
+				value.Variants = append(value.Variants, enumVariant{
+					Name:       naming.variantTypeName(enum.Name, variant.Name),
+					SchemaName: variant.Name,
+					Index:      variant.Index,
+					Doc:        variant.Doc,
+				})
+			}
+
+			err := unitEnumTemplate.Execute(enumsDefinitions, value)
+			if err != nil {
+				log.Fatalf("Error: %v", err)
+			}
+			enumsDefinitions.WriteRune('\n')
+			continue
+		}
+
+		variants := make([]enumVariant, len(enum.Variants))
 		for idx, variant := range enum.Variants {
-			variantsName[idx] = variant.Name
+			variants[idx] = enumVariant{
+				Name:       naming.variantTypeName(enum.Name, variant.Name),
+				SchemaName: variant.Name,
+				Index:      variant.Index,
+			}
 		}
 
 		value := enumDefinition{
-			EnumName: enum.Name,
-			Variants: variantsName,
+			genericParams: newGenericParams(enum.TypeParams),
+			EnumName:      enum.Name,
+			Variants:      variants,
+			Doc:           enum.Doc,
+		}
+		value.Result = matchResultParam(naming, enum)
+		value.MatchParamsDecl = "[" + value.Result + " any]"
+		value.MatchArgs = "[" + value.Result + "]"
+		if value.TypeArgs != "" {
+			value.MatchParamsDecl = strings.TrimSuffix(value.TypeParamsDecl, "]") + ", " + value.Result + " any]"
+			value.MatchArgs = strings.TrimSuffix(value.TypeArgs, "]") + ", " + value.Result + "]"
 		}
 
 		err := enumTemplate.Execute(enumsDefinitions, value)
@@ -116,18 +292,28 @@ func parseEnumsDefinition(pacakge string, enums []scale_codec.Enum) string {
 
 	type fileTemplateValue struct {
 		Package                 string
+		StdImports              []string
+		Imports                 []string
 		GenericTupleDefinitions string
 		EnumsDefinitions        string
 		VariantsDefinitions     string
+		StructsDefinitions      string
+		NewtypesDefinitions     string
 	}
 
 	value := fileTemplateValue{
 		Package:                 pacakge,
-		GenericTupleDefinitions: parseGenericTupleDefinitions(scale_codec.GenericTuple),
+		Imports:                 parseImports(scale_codec.Imports),
+		GenericTupleDefinitions: parseGenericTupleDefinitions(tuples),
 		EnumsDefinitions:        enumsDefinitions.String(),
-		VariantsDefinitions:     parseVariantsDefinitions(enums),
+		VariantsDefinitions:     parseVariantsDefinitions(naming, enums),
+		StructsDefinitions:      parseStructsDefinitions(structs),
+		NewtypesDefinitions:     parseNewtypesDefinitions(scale_codec.Newtypes),
 	}
 
+	value.StdImports = stdImports(value.GenericTupleDefinitions, value.EnumsDefinitions,
+		value.VariantsDefinitions, value.StructsDefinitions, value.NewtypesDefinitions)
+
 	fileBuffer := new(strings.Builder)
 	err = fileTemplate.Execute(fileBuffer, value)
 	if err != nil {
@@ -137,10 +323,72 @@ func parseEnumsDefinition(pacakge string, enums []scale_codec.Enum) string {
 	return fileBuffer.String()
 }
 
+// matchResultParam names the result type parameter of the Match helper,
+// R unless a variant or a type parameter of the enum is already named R
+func matchResultParam(naming namingStrategy, enum scale_codec.Enum) string {
+	taken := map[string]bool{enum.Name: true}
+	for _, param := range enum.TypeParams {
+		taken[param] = true
+	}
+
+	for _, variant := range enum.Variants {
+		taken[naming.variantTypeName(enum.Name, variant.Name)] = true
+	}
+
+	name := "R"
+	for taken[name] {
+		name += "R"
+	}
+	return name
+}
+
+// templateFuncs are the functions of the templates generating the Go code
+var templateFuncs = template.FuncMap{"doc": goDoc}
+
+// goDoc writes the doc comments of a schema declaration as Go comment lines,
+// each one followed by indent so the commented code can follow them
+func goDoc(doc, indent string) string {
+	if doc == "" {
+		return ""
+	}
+
+	lines := strings.Split(doc, "\n")
+	for idx, line := range lines {
+		lines[idx] = strings.TrimRight("// "+line, " ") + "\n" + indent
+	}
+
+	return strings.Join(lines, "")
+}
+
+// stdImports returns the standard packages used by the generated code
+func stdImports(definitions ...string) []string {
+	return usedPackages([]string{"bytes", "encoding/json", "fmt", "io", "math/big"}, definitions...)
+}
+
+// usedPackages returns the packages of paths referenced by the definitions
+func usedPackages(paths []string, definitions ...string) []string {
+	code := strings.Join(definitions, "\n")
+	var imports []string
+	for _, path := range paths {
+		name := filepath.Base(path)
+		if regexp.MustCompile(`\b` + name + `\.`).MatchString(code) {
+			imports = append(imports, path)
+		}
+	}
+	return imports
+}
+
 func parseGenericTupleDefinitions(genericTuples map[string]int) string {
 	builder := &strings.Builder{}
 
-	for structName, arity := range genericTuples {
+	names := make([]string, 0, len(genericTuples))
+	for structName := range genericTuples {
+		names = append(names, structName)
+	}
+	sort.Strings(names)
+
+	for _, structName := range names {
+		arity := genericTuples[structName]
 		t, err := template.New("generic_tuple_definitions").Parse(GenericTupleStructTemplate)
 		if err != nil {
 			log.Fatalf("Parsing template error: %v", err)
@@ -154,6 +402,8 @@ func parseGenericTupleDefinitions(genericTuples map[string]int) string {
 			Fields                  []string
 			UnmarshalFuncSignatures string
 			FuncsAndFields          map[string]string
+			JSONFuncSignatures      string
+			JSONFuncs               []string
 		}
 
 		generics := alphabet[0:arity]
@@ -180,6 +430,16 @@ func parseGenericTupleDefinitions(genericTuples map[string]int) string {
 			return fmt.Sprintf("func%s", strings.ToUpper(string(s)))
 		})
 
+		jsonFuncSignatures := parseMap(generics, func(i int, s string) string {
+			return fmt.Sprintf("json%s func([]byte) (%s, error)",
+				strings.ToUpper(string(s)),
+				strings.ToUpper(string(s)))
+		})
+
+		jsonFuncsNames := parseMap(generics, func(i int, s string) string {
+			return fmt.Sprintf("json%s", strings.ToUpper(string(s)))
+		})
+
 		fieldsNames := make([]string, arity)
 		for idx := range fieldsNames {
 			fieldsNames[idx] = fmt.Sprintf("F%d", idx)
@@ -198,6 +458,8 @@ func parseGenericTupleDefinitions(genericTuples map[string]int) string {
 			Fields:                  fieldsNames,
 			UnmarshalFuncSignatures: strings.Join(unmarshalFuncSignatures, ","),
 			FuncsAndFields:          funcsAndFields,
+			JSONFuncSignatures:      strings.Join(jsonFuncSignatures, ","),
+			JSONFuncs:               jsonFuncsNames,
 		}
 
 		err = t.Execute(builder, value)
@@ -210,38 +472,72 @@ func parseGenericTupleDefinitions(genericTuples map[string]int) string {
 	return builder.String()
 }
 
-func parseVariantsDefinitions(parsedEnums []scale_codec.Enum) string {
-	t, err := template.New("variants_definitions").Parse(EnumVariantDefinitionTempate)
+func parseVariantsDefinitions(naming namingStrategy, parsedEnums []scale_codec.Enum) string {
+	t, err := template.New("variants_definitions").Funcs(templateFuncs).Parse(EnumVariantDefinitionTempate)
+	if err != nil {
+		log.Fatalf("Parsing template error: %v", err)
+	}
+
+	fieldsTemplate, err := template.New("fields_variants_definitions").
+		Funcs(templateFuncs).Parse(EnumFieldsVariantDefinitionTemplate)
 	if err != nil {
 		log.Fatalf("Parsing template error: %v", err)
 	}
 
 	type variant struct {
+		genericParams
 		EnumName        string
 		Name            string
+		SchemaName      string
 		Type            string
 		TypeConstructor string
 		UnmarshalSCALE  string
+		FromJSON        string
 		Index           int
+		Position        int
+		Fields          []scale_codec.StructField
+		Unit            bool
+		Tuple           bool
+		Helpers         []scale_codec.VariantHelper
+		Doc             string
 	}
 
 	variantsDefs := new(strings.Builder)
 	for _, enum := range parsedEnums {
-		for index, vari := range enum.Variants {
+		if enum.Unit {
+			continue
+		}
+
+		for position, vari := range enum.Variants {
 			unmarshalScale := defaultUnmarshalSCALE
 			if strings.TrimSpace(vari.UnmarshalScale) != "" {
 				unmarshalScale = strings.TrimSpace(vari.UnmarshalScale)
 			}
 
 			value := variant{
+				genericParams:   newGenericParams(enum.TypeParams),
 				EnumName:        enum.Name,
-				Name:            vari.Name,
+				Name:            naming.variantTypeName(enum.Name, vari.Name),
+				SchemaName:      vari.Name,
 				Type:            vari.Type,
 				TypeConstructor: vari.TypeConstructor,
-				Index:           index,
+				Index:           vari.Index,
+				Position:        position,
 				UnmarshalSCALE:  unmarshalScale,
+				FromJSON:        vari.FromJSON,
+				Fields:          vari.Fields,
+				Unit:            vari.Unit,
+				Tuple:           vari.Tuple,
+				Helpers:         vari.Helpers,
+				Doc:             vari.Doc,
 			}
-			err := t.Execute(variantsDefs, value)
+
+			variantTemplate := t
+			if len(vari.Fields) > 0 {
+				variantTemplate = fieldsTemplate
+			}
+
+			err := variantTemplate.Execute(variantsDefs, value)
 			if err != nil {
 				log.Fatalf("Error: %v", err)
 			}
@@ -252,29 +548,112 @@ func parseVariantsDefinitions(parsedEnums []scale_codec.Enum) string {
 	return variantsDefs.String()
 }
 
+func parseStructsDefinitions(structs []scale_codec.Struct) string {
+	t, err := template.New("structs_definitions").Funcs(templateFuncs).Parse(StructDefinitionTemplate)
+	if err != nil {
+		log.Fatalf("Parsing template error: %v", err)
+	}
+
+	type structDefinition struct {
+		genericParams
+		scale_codec.Struct
+		SchemaName string
+	}
+
+	structsDefs := new(strings.Builder)
+	for _, structDef := range structs {
+		value := structDefinition{
+			genericParams: newGenericParams(structDef.TypeParams),
+			Struct:        structDef,
+			SchemaName:    structDef.Name,
+		}
+
+		err := t.Execute(structsDefs, value)
+		if err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		structsDefs.WriteRune('\n')
+	}
+
+	return structsDefs.String()
+}
+
+// parseImports returns the import specs of the packages generated
+// for the imported schemas, sorted to keep the output stable
+func parseImports(imports map[string]string) []string {
+	specs := make([]string, 0, len(imports))
+	for name, path := range imports {
+		specs = append(specs, fmt.Sprintf("%s %q", name, path))
+	}
+
+	sort.Strings(specs)
+	return specs
+}
+
+func parseNewtypesDefinitions(newtypes []scale_codec.Newtype) string {
+	t, err := template.New("newtypes_definitions").Funcs(templateFuncs).Parse(NewtypeDefinitionTemplate)
+	if err != nil {
+		log.Fatalf("Parsing template error: %v", err)
+	}
+
+	newtypesDefs := new(strings.Builder)
+	for _, newtype := range newtypes {
+		err := t.Execute(newtypesDefs, newtype)
+		if err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		newtypesDefs.WriteRune('\n')
+	}
+
+	return newtypesDefs.String()
+}
+
 const EnumFileTemplate = `// Code generated by scale_codec/enum_script. DO NOT EDIT.
 package {{.Package}}
 
 import (
-	"bytes"
-	"fmt"
-	"io"
+{{- range .StdImports }}
+	"{{ . }}"
+{{- end }}
 
 	scale_codec "github.com/crypto2lab/scale-codec"
+{{- range .Imports }}
+	{{ . }}
+{{- end }}
 )
 
 {{ .GenericTupleDefinitions }}
 
 {{ .EnumsDefinitions }}
 
-{{ .VariantsDefinitions }}`
+{{ .VariantsDefinitions }}
 
-const EnumDefinitionTemplate = `type {{ .EnumName }} interface {
+{{ .StructsDefinitions }}
+{{- if .NewtypesDefinitions }}
+
+{{ .NewtypesDefinitions }}
+{{- end }}`
+
+const EnumDefinitionTemplate = `{{ doc .Doc "" }}type {{ .EnumName }}{{ .TypeParamsDecl }} interface {
+	{{- if .TypeArgs }}
+	scale_codec.Marshaler
+	{{- else }}
 	scale_codec.Encodable
+	{{- end }}
 	Is{{ .EnumName }}()
 }
+{{ if .TypeArgs }}
+func Unmarshal{{ .EnumName }}FromRawBytes{{ .TypeParamsDecl }}(
+	{{ .FuncSignatures }}) func(io.Reader) ({{ .EnumName }}{{ .TypeArgs }}, error) {
+	return func(reader io.Reader) ({{ .EnumName }}{{ .TypeArgs }}, error) {
+		return unmarshal{{ .EnumName }}(reader{{ .FuncArgs }})
+	}
+}
 
+func unmarshal{{ .EnumName }}{{ .TypeParamsDecl }}(reader io.Reader{{ .FuncParams }}) ({{ .EnumName }}{{ .TypeArgs }}, error) {
+{{- else }}
 func Unmarshal{{ .EnumName }}(reader io.Reader) ({{ .EnumName }}, error) {
+{{- end }}
 	enumTag := make([]byte, 1)
 	n, err := reader.Read(enumTag)
 	if err != nil {
@@ -287,9 +666,9 @@ func Unmarshal{{ .EnumName }}(reader io.Reader) ({{ .EnumName }}, error) {
 
 	switch enumTag[0] {
 	{{ range $i, $a := .Variants }}
-	case {{ $a }}Index:
-		unmarshaler := New{{ $a }}()
-		err := unmarshaler.UnmarshalSCALE(reader)
+	case {{ $a.Name }}Index:
+		unmarshaler := New{{ $a.Name }}{{ $.TypeArgs }}()
+		err := unmarshaler.UnmarshalSCALE(reader{{ $.FuncArgs }})
 		if err != nil {
 			return nil, err
 		}
@@ -298,26 +677,229 @@ func Unmarshal{{ .EnumName }}(reader io.Reader) ({{ .EnumName }}, error) {
 	default:
 		return nil, fmt.Errorf("unexpected enum tag: %v", enumTag[0])
 	}
+}
+{{ if .TypeArgs }}
+func Unmarshal{{ .EnumName }}FromJSON{{ .TypeParamsDecl }}(
+	{{ .JSONFuncSignatures }}) func([]byte) ({{ .EnumName }}{{ .TypeArgs }}, error) {
+	return func(data []byte) ({{ .EnumName }}{{ .TypeArgs }}, error) {
+		return unmarshal{{ .EnumName }}JSON(data{{ .JSONFuncArgs }})
+	}
+}
+
+func unmarshal{{ .EnumName }}JSON{{ .TypeParamsDecl }}(data []byte{{ .JSONFuncParams }}) ({{ .EnumName }}{{ .TypeArgs }}, error) {
+{{- else }}
+func Unmarshal{{ .EnumName }}JSON(data []byte) ({{ .EnumName }}, error) {
+{{- end }}
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	{{ range $i, $a := .Variants }}
+	case "{{ $a.SchemaName }}":
+		unmarshaler := New{{ $a.Name }}{{ $.TypeArgs }}()
+		{{- if $.TypeArgs }}
+		err := unmarshaler.UnmarshalJSONWith(data{{ $.JSONFuncArgs }})
+		{{- else }}
+		err := unmarshaler.UnmarshalJSON(data)
+		{{- end }}
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	{{ end }}
+	default:
+		return nil, fmt.Errorf("%w: unexpected {{ .EnumName }} variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+}
+
+// {{ .EnumName }}Visitor has a method per {{ .EnumName }} variant, a visitor given
+// to Visit{{ .EnumName }} stops compiling when a variant is added
+type {{ .EnumName }}Visitor{{ .TypeParamsDecl }} interface {
+{{- range .Variants }}
+	Visit{{ .SchemaName }}(*{{ .Name }}{{ $.TypeArgs }}) error
+{{- end }}
+}
+
+// Visit{{ .EnumName }} calls the visitor method of the variant of value
+func Visit{{ .EnumName }}{{ .TypeParamsDecl }}(value {{ .EnumName }}{{ .TypeArgs }}, visitor {{ .EnumName }}Visitor{{ .TypeArgs }}) error {
+	switch variant := value.(type) {
+	{{- range .Variants }}
+	case *{{ .Name }}{{ $.TypeArgs }}:
+		return visitor.Visit{{ .SchemaName }}(variant)
+	{{- end }}
+	default:
+		return fmt.Errorf("unexpected {{ .EnumName }} variant: %T", value)
+	}
+}
+
+// {{ .EnumName }}Cases holds a func per {{ .EnumName }} variant for Match{{ .EnumName }},
+// it is built by New{{ .EnumName }}Cases so a variant added breaks the build
+type {{ .EnumName }}Cases{{ .MatchParamsDecl }} struct {
+{{- range .Variants }}
+	on{{ .SchemaName }} func(*{{ .Name }}{{ $.TypeArgs }}) {{ $.Result }}
+{{- end }}
+}
+
+// New{{ .EnumName }}Cases returns the cases of Match{{ .EnumName }}, taking
+// a func per {{ .EnumName }} variant in declaration order
+func New{{ .EnumName }}Cases{{ .MatchParamsDecl }}(
+{{- range $idx, $variant := .Variants }}{{ if $idx }}, {{ end }}on{{ .SchemaName }} func(*{{ .Name }}{{ $.TypeArgs }}) {{ $.Result }}{{ end -}}
+) {{ .EnumName }}Cases{{ .MatchArgs }} {
+	return {{ .EnumName }}Cases{{ .MatchArgs }}{
+	{{- range .Variants }}
+		on{{ .SchemaName }}: on{{ .SchemaName }},
+	{{- end }}
+	}
+}
+
+// Match{{ .EnumName }} returns the result of the case of the variant of value,
+// it panics if that case is nil
+func Match{{ .EnumName }}{{ .MatchParamsDecl }}(value {{ .EnumName }}{{ .TypeArgs }}, cases {{ .EnumName }}Cases{{ .MatchArgs }}) {{ .Result }} {
+	switch variant := value.(type) {
+	{{- range .Variants }}
+	case *{{ .Name }}{{ $.TypeArgs }}:
+		if cases.on{{ .SchemaName }} == nil {
+			panic("Match{{ $.EnumName }}: missing case for {{ .SchemaName }}")
+		}
+		return cases.on{{ .SchemaName }}(variant)
+	{{- end }}
+	default:
+		panic(fmt.Sprintf("Match{{ .EnumName }}: unexpected variant %T", value))
+	}
+}`
+
+const UnitEnumDefinitionTemplate = `{{ doc .Doc "" }}type {{ .EnumName }} uint8
+
+const (
+{{- range .Variants }}
+	{{ doc .Doc "\t" }}{{ .Name }} {{ $.EnumName }} = {{ .Index }}
+{{- end }}
+)
+
+var _ scale_codec.Encodable = (*{{ .EnumName }})(nil)
+
+// Ptr returns a pointer to a copy of e, as expected by the fields of type {{ .EnumName }}
+func (e {{ .EnumName }}) Ptr() *{{ .EnumName }} {
+	return &e
+}
+
+// VariantPosition orders the {{ .EnumName }} variants by declaration, as Rust's Ord
+func (e {{ .EnumName }}) VariantPosition() int {
+	switch e {
+	{{- range $position, $variant := .Variants }}
+	case {{ $variant.Name }}:
+		return {{ $position }}
+	{{- end }}
+	default:
+		return -1
+	}
+}
+
+func (e {{ .EnumName }}) IsValid() bool {
+	switch e {
+	case {{ range $i, $v := .Variants }}{{ if $i }}, {{ end }}{{ $v.Name }}{{ end }}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (e {{ .EnumName }}) String() string {
+	switch e {
+	{{- range .Variants }}
+	case {{ .Name }}:
+		return "{{ .SchemaName }}"
+	{{- end }}
+	default:
+		return fmt.Sprintf("{{ .EnumName }}(%d)", uint8(e))
+	}
+}
+
+func (e {{ .EnumName }}) MarshalJSON() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("unexpected {{ .EnumName }} value: %d", uint8(e))
+	}
+	return []byte(` + "`" + `"` + "`" + ` + e.String() + ` + "`" + `"` + "`" + `), nil
+}
+
+func (e *{{ .EnumName }}) UnmarshalJSON(data []byte) error {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	{{- range .Variants }}
+	case "{{ .SchemaName }}":
+		*e = {{ .Name }}
+	{{- end }}
+	default:
+		return fmt.Errorf("%w: unexpected {{ .EnumName }} variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+	return nil
+}
+
+func (e {{ .EnumName }}) MarshalSCALE() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("unexpected {{ .EnumName }} value: %d", uint8(e))
+	}
+	return []byte{byte(e)}, nil
+}
+
+func (e *{{ .EnumName }}) UnmarshalSCALE(reader io.Reader) error {
+	enumTag := make([]byte, 1)
+	n, err := reader.Read(enumTag)
+	if err != nil {
+		return err
+	}
+
+	if n != 1 {
+		return fmt.Errorf("%w: got %v", scale_codec.ErrExpectedOneByteRead, n)
+	}
+
+	value := {{ .EnumName }}(enumTag[0])
+	if !value.IsValid() {
+		return fmt.Errorf("unexpected enum tag: %v", enumTag[0])
+	}
+
+	*e = value
+	return nil
+}
+
+func Unmarshal{{ .EnumName }}(reader io.Reader) (*{{ .EnumName }}, error) {
+	e := new({{ .EnumName }})
+	if err := e.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+	return e, nil
 }`
 
 const defaultUnmarshalSCALE = "return i.Inner.UnmarshalSCALE(reader)"
-const EnumVariantDefinitionTempate = `var {{ .Name }}Index byte = {{ .Index }}
 
-var _ {{ .EnumName }} = (*{{ .Name }})(nil)
+var EnumVariantDefinitionTempate = `var {{ .Name }}Index byte = {{ .Index }}
 
-type {{ .Name }} struct {
+var _ {{ .EnumName }}{{ .AnyTypeArgs }} = (*{{ .Name }}{{ .AnyTypeArgs }})(nil)
+
+{{ doc .Doc "" }}type {{ .Name }}{{ .TypeParamsDecl }} struct {
 	Inner {{ .Type }}
 }
 
-func New{{ .Name }}() *{{ .Name }} {
-	return &{{ .Name }}{
+func New{{ .Name }}{{ .TypeParamsDecl }}() *{{ .Name }}{{ .TypeArgs }} {
+	return &{{ .Name }}{{ .TypeArgs }}{
 		Inner: {{ .TypeConstructor }},
 	}
 }
 
-func ({{ .Name }}) Is{{ .EnumName }}() {}
+func ({{ .Name }}{{ .TypeArgs }}) Is{{ .EnumName }}() {}
 
-func (i {{ .Name }}) MarshalSCALE() ([]byte, error) {
+// VariantPosition orders the {{ .EnumName }} variants by declaration, as Rust's Ord
+func ({{ .Name }}{{ .TypeArgs }}) VariantPosition() int {
+	return {{ .Position }}
+}
+
+func (i {{ .Name }}{{ .TypeArgs }}) MarshalSCALE() ([]byte, error) {
 	innerEncode, err := i.Inner.MarshalSCALE()
 	if err != nil {
 		return nil, err
@@ -327,8 +909,330 @@ func (i {{ .Name }}) MarshalSCALE() ([]byte, error) {
 	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
 }
 
-func (i *{{ .Name }}) UnmarshalSCALE(reader io.Reader) error {
+func (i *{{ .Name }}{{ .TypeArgs }}) UnmarshalSCALE(reader io.Reader{{ .FuncParams }}) error {
 	{{ .UnmarshalSCALE }}
+}
+
+func (i {{ .Name }}{{ .TypeArgs }}) String() string {
+	{{- if .Unit }}
+	return "{{ .SchemaName }}"
+	{{- else }}
+	return scale_codec.DebugTuple("{{ .SchemaName }}", i.Inner)
+	{{- end }}
+}
+
+func (i {{ .Name }}{{ .TypeArgs }}) MarshalJSON() ([]byte, error) {
+	{{- if .Unit }}
+	return scale_codec.MarshalJSONVariant("{{ .SchemaName }}", nil)
+	{{- else }}
+	return scale_codec.MarshalJSONVariant("{{ .SchemaName }}", i.Inner)
+	{{- end }}
+}
+
+` + variantUnmarshalJSONHeader + `
+	{{- if not .Unit }}
+
+	i.Inner, err = {{ .FromJSON }}(value)
+	return err
+	{{- else }}
+	return nil
+	{{- end }}
+}` + variantHelpersTemplate
+
+var EnumFieldsVariantDefinitionTemplate = `var {{ .Name }}Index byte = {{ .Index }}
+
+var _ {{ .EnumName }}{{ .AnyTypeArgs }} = (*{{ .Name }}{{ .AnyTypeArgs }})(nil)
+
+{{ doc .Doc "" }}type {{ .Name }}{{ .TypeParamsDecl }} struct {
+{{- range .Fields }}
+	{{ doc .Doc "\t" }}{{ .Name }} {{ .Type }}
+{{- end }}
+}
+
+func New{{ .Name }}{{ .TypeParamsDecl }}() *{{ .Name }}{{ .TypeArgs }} {
+	return &{{ .Name }}{{ .TypeArgs }}{
+	{{- range .Fields }}
+		{{ .Name }}: {{ .TypeConstructor }},
+	{{- end }}
+	}
+}
+
+func ({{ .Name }}{{ .TypeArgs }}) Is{{ .EnumName }}() {}
+
+// VariantPosition orders the {{ .EnumName }} variants by declaration, as Rust's Ord
+func ({{ .Name }}{{ .TypeArgs }}) VariantPosition() int {
+	return {{ .Position }}
+}
+
+func (i {{ .Name }}{{ .TypeArgs }}) MarshalSCALE() (output []byte, err error) {
+	output = []byte{ {{- .Name }}Index}
+	var enc []byte
+	{{ range .Fields }}
+	enc, err = i.{{ .Name }}.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	{{ end }}
+	return output, nil
+}
+
+func (i *{{ .Name }}{{ .TypeArgs }}) UnmarshalSCALE(reader io.Reader{{ .FuncParams }}) (err error) {
+	{{ range .Fields }}
+	i.{{ .Name }}, err = {{ .FromRawBytes }}(reader)
+	if err != nil {
+		return err
+	}
+	{{ end }}
+	return nil
+}
+
+func (i {{ .Name }}{{ .TypeArgs }}) String() string {
+	` + fieldsDebug("i") + `
+}
+
+func (i {{ .Name }}{{ .TypeArgs }}) MarshalJSON() ([]byte, error) {
+	{{- if .Tuple }}
+	value, err := scale_codec.MarshalJSONTuple(` + fieldsValues("i") + `)
+	{{- else }}
+	value, err := scale_codec.MarshalJSONObject(` + fieldsJSONNames + `, ` + fieldsValues("i") + `)
+	{{- end }}
+	if err != nil {
+		return nil, err
+	}
+	return scale_codec.MarshalJSONVariant("{{ .SchemaName }}", json.RawMessage(value))
+}
+
+` + variantUnmarshalJSONHeader + `
+
+	` + fieldsFromJSON("i", "value") + `
+}` + variantHelpersTemplate
+
+// variantHelpersTemplate declares the typed constructors of a variant,
+// which take native Go values, and their getters
+const variantHelpersTemplate = `
+{{- range .Helpers }}
+
+// New{{ $.Name }}{{ .Suffix }} builds a {{ $.SchemaName }} variant from Go values
+func New{{ $.Name }}{{ .Suffix }}{{ $.TypeParamsDecl }}(
+	{{- range $i, $p := .Params }}{{ if $i }}, {{ end }}{{ $p.Name }} {{ $p.Type }}{{ end -}}
+) *{{ $.Name }}{{ $.TypeArgs }} {
+	return &{{ $.Name }}{{ $.TypeArgs }}{
+	{{- range .Inits }}
+		{{ . }},
+	{{- end }}
+	}
+}
+{{- if .Unwrap }}
+
+func (variant {{ $.Name }}{{ $.TypeArgs }}) {{ .Getter }}() ({{ range .Params }}{{ .Name }} {{ .Type }}, {{ end }}ok bool) {
+	{{ if .Values }}inner, ok := {{ else }}_, ok = {{ end }}variant.Inner.{{ .Unwrap }}()
+	if !ok {
+		return {{ range .Params }}{{ .Name }}, {{ end }}false
+	}
+	return {{ range .Values }}{{ . }}, {{ end }}true
+}
+{{- else if .Getter }}
+
+func (variant {{ $.Name }}{{ $.TypeArgs }}) {{ .Getter }}() {{ if gt (len .Params) 1 }}({{ end }}
+	{{- range $i, $p := .Params }}{{ if $i }}, {{ end }}{{ $p.Type }}{{ end }}{{ if gt (len .Params) 1 }}){{ end }} {
+	return {{ range $i, $v := .Values }}{{ if $i }}, {{ end }}{{ $v }}{{ end }}
+}
+{{- end }}
+{{- end }}`
+
+// variantUnmarshalJSONHeader checks the variant name of the JSON value,
+// the rest of the UnmarshalJSON method decodes the variant value
+const variantUnmarshalJSONHeader = `{{ if .TypeArgs -}}
+func (i *{{ .Name }}{{ .TypeArgs }}) UnmarshalJSONWith(data []byte{{ .JSONFuncParams }}) error {
+{{- else -}}
+func (i *{{ .Name }}) UnmarshalJSON(data []byte) error {
+{{- end }}
+	name, {{ if .Unit }}_{{ else }}value{{ end }}, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "{{ .SchemaName }}" {
+		return fmt.Errorf("%w: expected {{ .SchemaName }} variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}`
+
+// fieldsDebug formats the fields of a struct or variant in Rust debug style
+func fieldsDebug(recv string) string {
+	return `{{ if .Tuple -}}
+	return scale_codec.DebugTuple("{{ .SchemaName }}", ` + fieldsValues(recv) + `)
+	{{- else -}}
+	return scale_codec.DebugStruct("{{ .SchemaName }}", []string{
+		{{- range $i, $f := .Fields }}{{ if $i }}, {{ end }}"{{ $f.SchemaName }}"{{ end -}}
+	}{{ range .Fields }}, ` + recv + `.{{ .Name }}{{ end }})
+	{{- end }}`
+}
+
+// fieldsValues lists the fields of a struct or variant as arguments
+func fieldsValues(recv string) string {
+	return `{{ range $i, $f := .Fields }}{{ if $i }}, {{ end }}` + recv + `.{{ $f.Name }}{{ end }}`
+}
+
+const fieldsJSONNames = `[]string{ {{- range $i, $f := .Fields }}{{ if $i }}, {{ end }}"{{ $f.JSONName }}"{{ end -}} }`
+
+// fieldsFromJSON decodes the fields of a struct or variant from the
+// input JSON array or object, a tuple struct is written as an array
+func fieldsFromJSON(recv, input string) string {
+	return `{{ if .Tuple -}}
+	{{ if .Fields }}items{{ else }}_{{ end }}, err := scale_codec.UnmarshalJSONTuple(` + input + `, {{ len .Fields }})
+	{{- else -}}
+	{{ if .Fields }}items{{ else }}_{{ end }}, err := scale_codec.UnmarshalJSONObject(` + input + `, ` + fieldsJSONNames + `)
+	{{- end }}
+	if err != nil {
+		return err
+	}
+	{{ range $i, $f := .Fields }}
+	` + recv + `.{{ $f.Name }}, err = {{ $f.FromJSON }}(items[{{ $i }}])
+	if err != nil {
+		return err
+	}
+	{{ end }}
+	return nil`
+}
+
+var StructDefinitionTemplate = `{{ if .TypeArgs -}}
+var _ scale_codec.Marshaler = (*{{ .Name }}{{ .AnyTypeArgs }})(nil)
+{{- else -}}
+var _ scale_codec.Encodable = (*{{ .Name }})(nil)
+{{- end }}
+
+{{ doc .Doc "" }}type {{ .Name }}{{ .TypeParamsDecl }} struct {
+{{- range .Fields }}
+	{{ doc .Doc "\t" }}{{ .Name }} {{ .Type }}
+{{- end }}
+}
+
+func New{{ .Name }}{{ .TypeParamsDecl }}() *{{ .Name }}{{ .TypeArgs }} {
+	return &{{ .Name }}{{ .TypeArgs }}{
+	{{- range .Fields }}
+		{{ .Name }}: {{ .TypeConstructor }},
+	{{- end }}
+	}
+}
+
+func (s {{ .Name }}{{ .TypeArgs }}) MarshalSCALE() (output []byte, err error) {
+	output = make([]byte, 0)
+	{{- if .Fields }}
+	var enc []byte
+	{{- end }}
+	{{ range .Fields }}
+	enc, err = s.{{ .Name }}.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	{{ end }}
+	return output, nil
+}
+
+func (s *{{ .Name }}{{ .TypeArgs }}) UnmarshalSCALE(reader io.Reader{{ .FuncParams }}) (err error) {
+	{{ range .Fields }}
+	s.{{ .Name }}, err = {{ .FromRawBytes }}(reader)
+	if err != nil {
+		return err
+	}
+	{{ end }}
+	return nil
+}
+
+func (s {{ .Name }}{{ .TypeArgs }}) String() string {
+	` + fieldsDebug("s") + `
+}
+
+func (s {{ .Name }}{{ .TypeArgs }}) MarshalJSON() ([]byte, error) {
+	{{- if .Tuple }}
+	return scale_codec.MarshalJSONTuple(` + fieldsValues("s") + `)
+	{{- else }}
+	return scale_codec.MarshalJSONObject(` + fieldsJSONNames + `{{ range .Fields }}, s.{{ .Name }}{{ end }})
+	{{- end }}
+}
+
+{{ if .TypeArgs -}}
+func (s *{{ .Name }}{{ .TypeArgs }}) UnmarshalJSONWith(data []byte{{ .JSONFuncParams }}) error {
+{{- else -}}
+func (s *{{ .Name }}) UnmarshalJSON(data []byte) error {
+{{- end }}
+	` + fieldsFromJSON("s", "data") + `
+}
+
+{{ if .TypeArgs -}}
+func Unmarshal{{ .Name }}FromJSON{{ .TypeParamsDecl }}(
+	{{ .JSONFuncSignatures }}) func([]byte) (*{{ .Name }}{{ .TypeArgs }}, error) {
+	return func(data []byte) (*{{ .Name }}{{ .TypeArgs }}, error) {
+		s := new({{ .Name }}{{ .TypeArgs }})
+		if err := s.UnmarshalJSONWith(data{{ .JSONFuncArgs }}); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+}
+
+func Unmarshal{{ .Name }}FromRawBytes{{ .TypeParamsDecl }}(
+	{{ .FuncSignatures }}) func(io.Reader) (*{{ .Name }}{{ .TypeArgs }}, error) {
+	return func(reader io.Reader) (*{{ .Name }}{{ .TypeArgs }}, error) {
+		s := new({{ .Name }}{{ .TypeArgs }})
+		if err := s.UnmarshalSCALE(reader{{ .FuncArgs }}); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+}
+{{- else -}}
+func Unmarshal{{ .Name }}(reader io.Reader) (*{{ .Name }}, error) {
+	s := new({{ .Name }})
+	if err := s.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+{{- end }}`
+
+const NewtypeDefinitionTemplate = `var _ scale_codec.Encodable = (*{{ .Name }})(nil)
+
+{{ doc .Doc "" }}type {{ .Name }} struct {
+	Inner {{ .Type }}
+}
+
+func New{{ .Name }}() *{{ .Name }} {
+	return &{{ .Name }}{
+		Inner: {{ .TypeConstructor }},
+	}
+}
+
+func (n {{ .Name }}) MarshalSCALE() ([]byte, error) {
+	return n.Inner.MarshalSCALE()
+}
+
+func (n *{{ .Name }}) UnmarshalSCALE(reader io.Reader) (err error) {
+	n.Inner, err = {{ .FromRawBytes }}(reader)
+	return err
+}
+
+func (n {{ .Name }}) String() string {
+	return scale_codec.DebugTuple("{{ .Name }}", n.Inner)
+}
+
+// MarshalJSON writes the inner value, a newtype is transparent in JSON
+func (n {{ .Name }}) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.Inner)
+}
+
+func (n *{{ .Name }}) UnmarshalJSON(data []byte) (err error) {
+	n.Inner, err = {{ .FromJSON }}(data)
+	return err
+}
+
+func Unmarshal{{ .Name }}(reader io.Reader) (*{{ .Name }}, error) {
+	n := new({{ .Name }})
+	if err := n.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+	return n, nil
 }`
 
 var GenericTupleStructTemplate = `type {{ .GenericTupleName }}[{{ .GenericArity }}] struct {
@@ -370,4 +1274,38 @@ func Unmarshal{{.GenericTupleName}}FromRawBytes[{{ .GenericArity }}](
 		}
 		return tuple, nil
 	}
+}
+
+func (t *{{ .GenericTupleName }}[{{ .GenericNames }}]) String() string {
+	return scale_codec.DebugTuple("", {{ range $i, $a := .Fields }}{{ if $i }}, {{ end }}t.{{ $a }}{{ end }})
+}
+
+func (t *{{ .GenericTupleName }}[{{ .GenericNames }}]) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONTuple({{ range $i, $a := .Fields }}{{ if $i }}, {{ end }}t.{{ $a }}{{ end }})
+}
+
+func (t *{{ .GenericTupleName }}[{{ .GenericNames }}]) UnmarshalJSONWith(data []byte, {{ .JSONFuncSignatures }}) error {
+	items, err := scale_codec.UnmarshalJSONTuple(data, {{ len .Fields }})
+	if err != nil {
+		return err
+	}
+	{{ range $i, $func := .JSONFuncs }}
+	t.{{ index $.Fields $i }}, err = {{ $func }}(items[{{ $i }}])
+	if err != nil {
+		return err
+	}
+	{{ end }}
+	return nil
+}
+
+func Unmarshal{{ .GenericTupleName }}FromJSON[{{ .GenericArity }}](
+	{{ .JSONFuncSignatures }}) func([]byte) (*{{ .GenericTupleName }}[{{ .GenericNames }}], error) {
+	return func(data []byte) (*{{ .GenericTupleName }}[{{ .GenericNames }}], error) {
+		tuple := new({{ .GenericTupleName }}[{{ .GenericNames }}])
+		err := tuple.UnmarshalJSONWith(data, {{ range $i, $func := .JSONFuncs }}{{ if $i }}, {{ end }}{{ $func }}{{ end }})
+		if err != nil {
+			return nil, err
+		}
+		return tuple, nil
+	}
 }`
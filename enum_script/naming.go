@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+// namingStrategy decides the Go type name of the enum variants, the
+// variant index and constructor are named after it
+type namingStrategy string
+
+const (
+	// variantNaming names the variant types after the variant, `Transfer`
+	variantNaming namingStrategy = "variant"
+	// enumNaming prefixes the variant types with their enum, `CallTransfer`
+	enumNaming namingStrategy = "enum"
+)
+
+func (n *namingStrategy) String() string {
+	return string(*n)
+}
+
+func (n *namingStrategy) Set(value string) error {
+	switch strategy := namingStrategy(value); strategy {
+	case variantNaming, enumNaming:
+		*n = strategy
+		return nil
+	default:
+		return fmt.Errorf("unknown naming strategy %q, expected %q or %q", value, variantNaming, enumNaming)
+	}
+}
+
+func (n namingStrategy) variantTypeName(enum, variant string) string {
+	if n == enumNaming {
+		return enum + variant
+	}
+	return variant
+}
+
+// checkNames reports the Go types of a generated file that would be
+// declared twice, usually variants with the same name in different enums
+func checkNames(naming namingStrategy, tuples map[string]int, enums []scale_codec.Enum,
+	structs []scale_codec.Struct, newtypes []scale_codec.Newtype) error {
+	declared := make(map[string]string)
+	collided := make(map[string]bool)
+	var errs []string
+	declare := func(name, what string) {
+		if previous, ok := declared[name]; ok {
+			errs = append(errs, fmt.Sprintf("%s and %s are both generated as %s", previous, what, name))
+			collided[name] = true
+			return
+		}
+		declared[name] = what
+	}
+
+	// declareConstructor declares the New constructor of a type, a collision
+	// already reported between two types is not reported for their constructors
+	declareConstructor := func(typeName, name, what string) {
+		if !collided[typeName] {
+			declare(name, what)
+		}
+	}
+
+	for name := range tuples {
+		declare(name, "tuple "+name)
+	}
+
+	for _, enum := range enums {
+		declare(enum.Name, "enum "+enum.Name)
+		if !enum.Unit {
+			declare(enum.Name+"Visitor", "visitor of enum "+enum.Name)
+			declare(enum.Name+"Cases", "cases of enum "+enum.Name)
+		}
+	}
+
+	for _, structDecl := range structs {
+		declare(structDecl.Name, "struct "+structDecl.Name)
+	}
+
+	for _, newtype := range newtypes {
+		declare(newtype.Name, "newtype "+newtype.Name)
+	}
+
+	for _, enum := range enums {
+		for _, variant := range enum.Variants {
+			declare(naming.variantTypeName(enum.Name, variant.Name),
+				"variant "+enum.Name+"::"+variant.Name)
+		}
+	}
+
+	for _, structDecl := range structs {
+		declareConstructor(structDecl.Name, "New"+structDecl.Name, "constructor of struct "+structDecl.Name)
+	}
+
+	for _, newtype := range newtypes {
+		declareConstructor(newtype.Name, "New"+newtype.Name, "constructor of newtype "+newtype.Name)
+	}
+
+	for _, enum := range enums {
+		if enum.Unit {
+			continue
+		}
+
+		for _, variant := range enum.Variants {
+			variantType := naming.variantTypeName(enum.Name, variant.Name)
+			declareConstructor(variantType, "New"+variantType, "constructor of variant "+enum.Name+"::"+variant.Name)
+			for _, helper := range variant.Helpers {
+				declareConstructor(variantType, "New"+variantType+helper.Suffix,
+					"constructor "+helper.Suffix+" of variant "+enum.Name+"::"+variant.Name)
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	sort.Strings(errs)
+	hint := ""
+	if naming == variantNaming {
+		hint = ", use -naming enum to prefix the variant types with their enum"
+	}
+	return fmt.Errorf("name collisions in the generated code%s:\n\t%s", hint, strings.Join(errs, "\n\t"))
+}
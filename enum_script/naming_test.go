@@ -0,0 +1,82 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+func TestCheckNames(t *testing.T) {
+	enums := []scale_codec.Enum{
+		{Name: "Call", Variants: []scale_codec.EnumField{{Name: "None"}, {Name: "Transfer"}}},
+		{Name: "Event", Variants: []scale_codec.EnumField{{Name: "None"}, {Name: "Call"}}},
+	}
+	structs := []scale_codec.Struct{{Name: "Transfer"}}
+
+	cases := []struct {
+		naming   namingStrategy
+		expected []string
+	}{
+		{
+			naming: variantNaming,
+			expected: []string{
+				"enum Call and variant Event::Call are both generated as Call",
+				"struct Transfer and variant Call::Transfer are both generated as Transfer",
+				"variant Call::None and variant Event::None are both generated as None",
+				"use -naming enum",
+			},
+		},
+		{
+			naming: enumNaming,
+		},
+	}
+
+	for _, tt := range cases {
+		err := checkNames(tt.naming, nil, enums, structs, nil)
+		if len(tt.expected) == 0 {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("expected an error with %s naming", tt.naming)
+		}
+
+		for _, msg := range tt.expected {
+			if !strings.Contains(err.Error(), msg) {
+				t.Fatalf("\nexpected: %v\ngot: %v", msg, err)
+			}
+		}
+	}
+
+	err := checkNames(enumNaming, nil, enums, []scale_codec.Struct{{Name: "CallVisitor"}}, nil)
+	if err == nil || !strings.Contains(err.Error(), "visitor of enum Call and struct CallVisitor") {
+		t.Fatalf("expected the CallVisitor collision to be reported, got %v", err)
+	}
+
+	helpers := []scale_codec.Enum{
+		{Name: "Call", Variants: []scale_codec.EnumField{
+			{Name: "A", Helpers: []scale_codec.VariantHelper{{Suffix: "None"}, {Suffix: "Some"}}},
+			{Name: "ASome"},
+		}},
+	}
+
+	err = checkNames(variantNaming, nil, helpers, nil, nil)
+	expected := "constructor Some of variant Call::A and constructor of variant Call::ASome are both generated as NewASome"
+	if err == nil || !strings.Contains(err.Error(), expected) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expected, err)
+	}
+
+	err = checkNames(variantNaming, nil, enums, structs, nil)
+	if strings.Contains(err.Error(), "NewTransfer") {
+		t.Fatalf("expected the Transfer collision to be reported once, got %v", err)
+	}
+
+	var naming namingStrategy
+	if err := naming.Set("snake"); err == nil {
+		t.Fatalf("expected an error for an unknown naming strategy")
+	}
+}
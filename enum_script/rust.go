@@ -0,0 +1,437 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+// language is the language of the generated code
+type language string
+
+const (
+	goLanguage   language = "go"
+	rustLanguage language = "rust"
+)
+
+const rustOutputExt = ".rs"
+
+func (l *language) String() string {
+	return string(*l)
+}
+
+func (l *language) Set(value string) error {
+	switch lang := language(value); lang {
+	case goLanguage, rustLanguage:
+		*l = lang
+		return nil
+	default:
+		return fmt.Errorf("unknown language %q, expected %q or %q", value, goLanguage, rustLanguage)
+	}
+}
+
+// rustKeywords are the field names that must be written as raw identifiers
+var rustKeywords = map[string]bool{
+	"as": true, "async": true, "await": true, "break": true, "const": true, "continue": true,
+	"dyn": true, "else": true, "enum": true, "extern": true, "false": true, "fn": true,
+	"for": true, "if": true, "impl": true, "in": true, "let": true, "loop": true,
+	"match": true, "mod": true, "move": true, "mut": true, "pub": true, "ref": true,
+	"return": true, "static": true, "struct": true, "trait": true, "true": true, "type": true,
+	"unsafe": true, "use": true, "where": true, "while": true, "abstract": true, "become": true,
+	"box": true, "do": true, "final": true, "macro": true, "override": true, "priv": true,
+	"try": true, "typeof": true, "unsized": true, "virtual": true, "yield": true,
+}
+
+type rustField struct {
+	Name string
+	Type string
+}
+
+type rustVariant struct {
+	Name string
+	// Index is set when the variant index is not its position
+	Index string
+	Body  string
+}
+
+type rustEnum struct {
+	Name     string
+	Params   string
+	Variants []rustVariant
+}
+
+type rustStruct struct {
+	Name   string
+	Params string
+	Tuple  bool
+	Fields []rustField
+}
+
+type rustFile struct {
+	Source  string
+	Uses    []string
+	Aliases []rustField
+	Structs []rustStruct
+	Enums   []rustEnum
+}
+
+// rustRenderer writes the schema types as Rust types, the types containing
+// themselves by value, directly or through other types, are boxed
+type rustRenderer struct {
+	schema  *scale_codec.Schema
+	decls   map[string]bool
+	edges   map[string][]string
+	compact bool
+	maps    bool
+	sets    bool
+}
+
+func newRustRenderer(schema *scale_codec.Schema) *rustRenderer {
+	r := &rustRenderer{
+		schema: schema,
+		decls:  make(map[string]bool),
+		edges:  make(map[string][]string),
+	}
+
+	for _, enum := range schema.Enums {
+		r.decls[enum.Name] = true
+	}
+	for _, structDecl := range schema.Structs {
+		r.decls[structDecl.Name] = true
+	}
+	for _, newtype := range schema.Newtypes {
+		r.decls[newtype.Name] = true
+	}
+
+	for _, enum := range schema.Enums {
+		for _, variant := range enum.Variants {
+			if variant.Payload != nil {
+				r.edges[enum.Name] = r.valueRefs(variant.Payload, r.edges[enum.Name], nil)
+			}
+			for _, field := range variant.Fields {
+				r.edges[enum.Name] = r.valueRefs(field.Type, r.edges[enum.Name], nil)
+			}
+		}
+	}
+	for _, structDecl := range schema.Structs {
+		for _, field := range structDecl.Fields {
+			r.edges[structDecl.Name] = r.valueRefs(field.Type, r.edges[structDecl.Name], nil)
+		}
+	}
+	for _, newtype := range schema.Newtypes {
+		r.edges[newtype.Name] = r.valueRefs(newtype.Type, r.edges[newtype.Name], nil)
+	}
+
+	return r
+}
+
+func (r *rustRenderer) alias(name string) *scale_codec.AliasDecl {
+	for _, alias := range r.schema.Aliases {
+		if alias.Name == name {
+			return alias
+		}
+	}
+	return nil
+}
+
+// valueRefs appends the types of the schema held by value in t, the
+// ones behind a Vec, BTreeMap or BTreeSet are already on the heap
+func (r *rustRenderer) valueRefs(t *scale_codec.TypeExpr, refs []string, seen map[string]bool) []string {
+	switch t.Kind {
+	case scale_codec.VecType, scale_codec.MapType, scale_codec.SetType:
+		return refs
+	case scale_codec.NamedType:
+		if t.Package != "" {
+			break
+		}
+
+		if alias := r.alias(t.Name); alias != nil {
+			if seen == nil {
+				seen = make(map[string]bool)
+			}
+			if !seen[alias.Name] {
+				seen[alias.Name] = true
+				refs = r.valueRefs(alias.Type, refs, seen)
+			}
+		} else if r.decls[t.Name] {
+			refs = append(refs, t.Name)
+		}
+	}
+
+	for _, arg := range t.Args {
+		refs = r.valueRefs(arg, refs, seen)
+	}
+	return refs
+}
+
+// reaches reports whether from holds owner by value
+func (r *rustRenderer) reaches(from, owner string) bool {
+	visited := make(map[string]bool)
+	var visit func(name string) bool
+	visit = func(name string) bool {
+		if name == owner {
+			return true
+		}
+		if visited[name] {
+			return false
+		}
+		visited[name] = true
+
+		for _, next := range r.edges[name] {
+			if visit(next) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return visit(from)
+}
+
+// typ returns the Rust type of t used by the owner declaration, byValue
+// is false once t is behind a pointer and does not need to be boxed
+func (r *rustRenderer) typ(t *scale_codec.TypeExpr, owner string, byValue bool) string {
+	args := func(byValue bool) []string {
+		args := make([]string, len(t.Args))
+		for idx, arg := range t.Args {
+			args[idx] = r.typ(arg, owner, byValue)
+		}
+		return args
+	}
+
+	switch t.Kind {
+	case scale_codec.PrimitiveType:
+		return rustPrimitive(t.Name)
+	case scale_codec.ParamType:
+		return t.Name
+	case scale_codec.UnitType:
+		return "()"
+	case scale_codec.OptionType:
+		return "Option<" + args(byValue)[0] + ">"
+	case scale_codec.ResultType:
+		return "Result<" + strings.Join(args(byValue), ", ") + ">"
+	case scale_codec.TupleType:
+		return "(" + strings.Join(args(byValue), ", ") + ")"
+	case scale_codec.ArrayType:
+		return fmt.Sprintf("[%s; %d]", args(byValue)[0], t.Len)
+	case scale_codec.CompactType:
+		r.compact = true
+		return "Compact<" + args(byValue)[0] + ">"
+	case scale_codec.VecType:
+		return "Vec<" + args(false)[0] + ">"
+	case scale_codec.MapType:
+		r.maps = true
+		return "BTreeMap<" + strings.Join(args(false), ", ") + ">"
+	case scale_codec.SetType:
+		r.sets = true
+		return "BTreeSet<" + args(false)[0] + ">"
+	}
+
+	if t.Package != "" {
+		name := t.Package + "::" + t.Name
+		if len(t.Args) > 0 {
+			name += "<" + strings.Join(args(byValue), ", ") + ">"
+		}
+		return name
+	}
+
+	boxed := false
+	if byValue && owner != "" {
+		if r.decls[t.Name] {
+			boxed = r.reaches(t.Name, owner)
+		} else {
+			for _, ref := range r.valueRefs(t, nil, nil) {
+				boxed = boxed || r.reaches(ref, owner)
+			}
+		}
+	}
+
+	name := t.Name
+	if len(t.Args) > 0 {
+		name += "<" + strings.Join(args(byValue && !boxed), ", ") + ">"
+	}
+
+	if boxed {
+		return "Box<" + name + ">"
+	}
+	return name
+}
+
+// rustPrimitive maps the schema primitives to Rust, `uint64` is `u64`
+func rustPrimitive(name string) string {
+	switch {
+	case name == "Bytes":
+		return "Vec<u8>"
+	case strings.HasPrefix(name, "uint"):
+		return "u" + strings.TrimPrefix(name, "uint")
+	case strings.HasPrefix(name, "int"):
+		return "i" + strings.TrimPrefix(name, "int")
+	default:
+		return name
+	}
+}
+
+func rustFieldName(name string) string {
+	if rustKeywords[name] {
+		return "r#" + name
+	}
+	return name
+}
+
+func rustTypeParams(params []*scale_codec.TypeParam) string {
+	if len(params) == 0 {
+		return ""
+	}
+
+	names := make([]string, len(params))
+	for idx, param := range params {
+		names[idx] = param.Name
+	}
+	return "<" + strings.Join(names, ", ") + ">"
+}
+
+func (r *rustRenderer) fields(owner string, fields []*scale_codec.FieldDecl) []rustField {
+	rendered := make([]rustField, len(fields))
+	for idx, field := range fields {
+		rendered[idx] = rustField{Name: rustFieldName(field.Name), Type: r.typ(field.Type, owner, true)}
+	}
+	return rendered
+}
+
+func (r *rustRenderer) variant(owner string, position int, variant *scale_codec.VariantDecl) rustVariant {
+	rendered := rustVariant{Name: variant.Name}
+	if variant.Index != position {
+		rendered.Index = fmt.Sprint(variant.Index)
+	}
+
+	fields := r.fields(owner, variant.Fields)
+	switch {
+	case variant.Payload != nil:
+		rendered.Body = "(" + r.typ(variant.Payload, owner, true) + ")"
+	case variant.Named:
+		items := make([]string, len(fields))
+		for idx, field := range fields {
+			items[idx] = field.Name + ": " + field.Type
+		}
+		rendered.Body = " { " + strings.Join(items, ", ") + " }"
+	case len(fields) > 0:
+		items := make([]string, len(fields))
+		for idx, field := range fields {
+			items[idx] = field.Type
+		}
+		rendered.Body = "(" + strings.Join(items, ", ") + ")"
+	}
+
+	return rendered
+}
+
+// generateRust generates a Rust module declaring the schema types with the
+// parity-scale-codec derives, the imported schemas are expected to be
+// generated as sibling modules
+func generateRust(schema *scale_codec.Schema) (string, error) {
+	r := newRustRenderer(schema)
+	file := rustFile{Source: filepath.Base(schema.Filename)}
+
+	for _, alias := range schema.Aliases {
+		file.Aliases = append(file.Aliases, rustField{Name: alias.Name, Type: r.typ(alias.Type, "", false)})
+	}
+
+	for _, newtype := range schema.Newtypes {
+		file.Structs = append(file.Structs, rustStruct{
+			Name:   newtype.Name,
+			Tuple:  true,
+			Fields: []rustField{{Type: r.typ(newtype.Type, newtype.Name, true)}},
+		})
+	}
+
+	for _, structDecl := range schema.Structs {
+		file.Structs = append(file.Structs, rustStruct{
+			Name:   structDecl.Name,
+			Params: rustTypeParams(structDecl.TypeParams),
+			Tuple:  structDecl.Tuple,
+			Fields: r.fields(structDecl.Name, structDecl.Fields),
+		})
+	}
+
+	for _, enum := range schema.Enums {
+		rendered := rustEnum{Name: enum.Name, Params: rustTypeParams(enum.TypeParams)}
+		for idx, variant := range enum.Variants {
+			rendered.Variants = append(rendered.Variants, r.variant(enum.Name, idx, variant))
+		}
+		file.Enums = append(file.Enums, rendered)
+	}
+
+	codecUses := "Decode, Encode"
+	if r.compact {
+		codecUses = "Compact, " + codecUses
+	}
+	file.Uses = append(file.Uses, "parity_scale_codec::{"+codecUses+"}")
+
+	switch {
+	case r.maps && r.sets:
+		file.Uses = append(file.Uses, "std::collections::{BTreeMap, BTreeSet}")
+	case r.maps:
+		file.Uses = append(file.Uses, "std::collections::BTreeMap")
+	case r.sets:
+		file.Uses = append(file.Uses, "std::collections::BTreeSet")
+	}
+
+	for _, imp := range schema.Imports {
+		module := removeExtension(filepath.Base(imp.Path))
+		if module == imp.Name {
+			file.Uses = append(file.Uses, "super::"+module)
+		} else {
+			file.Uses = append(file.Uses, "super::"+module+" as "+imp.Name)
+		}
+	}
+
+	t, err := template.New("rust_file").Parse(RustFileTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	var output bytes.Buffer
+	if err := t.Execute(&output, file); err != nil {
+		return "", err
+	}
+	return output.String(), nil
+}
+
+const RustFileTemplate = `// Code generated by scale_codec/enum_script from {{ .Source }}. DO NOT EDIT.
+#![allow(dead_code)]
+{{ range .Uses }}
+use {{ . }};
+{{- end }}
+{{ range .Aliases }}
+pub type {{ .Name }} = {{ .Type }};
+{{ end }}
+{{- range .Structs }}
+#[derive(Debug, Clone, PartialEq, Eq, PartialOrd, Ord, Encode, Decode)]
+{{ if .Tuple -}}
+pub struct {{ .Name }}{{ .Params }}({{ range $idx, $field := .Fields }}{{ if $idx }}, {{ end }}pub {{ $field.Type }}{{ end }});
+{{ else if not .Fields -}}
+pub struct {{ .Name }}{{ .Params }} {}
+{{ else -}}
+pub struct {{ .Name }}{{ .Params }} {
+{{- range .Fields }}
+    pub {{ .Name }}: {{ .Type }},
+{{- end }}
+}
+{{ end }}
+{{- end }}
+{{- range .Enums }}
+#[derive(Debug, Clone, PartialEq, Eq, PartialOrd, Ord, Encode, Decode)]
+pub enum {{ .Name }}{{ .Params }} {
+{{- range .Variants }}
+{{- if .Index }}
+    #[codec(index = {{ .Index }})]
+{{- end }}
+    {{ .Name }}{{ .Body }},
+{{- end }}
+}
+{{ end -}}
+`
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+func TestGenerateRust(t *testing.T) {
+	src := `
+type Hash = [u8; 32];
+
+newtype Nonce(Compact<u32>)
+
+struct Transfer {
+	to: Hash,
+	ref: u64,
+	memo: Option<Call>,
+}
+
+struct Empty {}
+
+enum MaybeRef<T> {
+	Inline(T)
+	Hash(Hash)
+}
+
+enum Call {
+	Remark
+	Send(Transfer)
+	Batch(Vec<Call>)
+	Nested(MaybeRef<Call>)
+	Move { to: Hash, amount: u128 }
+	@index(9) Pair(Nonce, BTreeMap<u8, Bytes>)
+}
+`
+
+	schema, err := scale_codec.ParseSchema("calls.scale", strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output, err := generateRust(schema)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"from calls.scale. DO NOT EDIT.",
+		"use parity_scale_codec::{Compact, Decode, Encode};",
+		"use std::collections::BTreeMap;",
+		"pub type Hash = [u8; 32];",
+		"pub struct Nonce(pub Compact<u32>);",
+		"pub struct Transfer {\n    pub to: Hash,\n    pub r#ref: u64,\n    pub memo: Option<Box<Call>>,\n}",
+		"pub struct Empty {}",
+		"pub enum MaybeRef<T> {\n    Inline(T),\n    Hash(Hash),\n}",
+		"    Remark,\n    Send(Box<Transfer>),\n    Batch(Vec<Call>),",
+		"    Nested(MaybeRef<Box<Call>>),",
+		"    Move { to: Hash, amount: u128 },",
+		"    #[codec(index = 9)]\n    Pair(Nonce, BTreeMap<u8, Vec<u8>>),",
+	}
+
+	for _, snippet := range expected {
+		if !strings.Contains(output, snippet) {
+			t.Fatalf("\nexpected: %v\ngot: %v", snippet, output)
+		}
+	}
+}
@@ -36,7 +36,11 @@ func TestEnumParser(t *testing.T) {
 		P(Result<Nested, Error>)
 		Q((Nested, uint64, Error))
 		R((Result<uint64, bool>, Option<uint64>, Error))
-    }`
+    }
+
+	enum Error {
+		FailureX
+	}`
 
 	expectedEnum := []Enum{
 		{
@@ -46,6 +50,18 @@ func TestEnumParser(t *testing.T) {
 					Name:            "Number",
 					Type:            "*scale_codec.Integer[int32]",
 					TypeConstructor: "new(scale_codec.Integer[int32])",
+					FromJSON:        "scale_codec.FromJSON[scale_codec.Integer[int32]]",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "From",
+							Params: []HelperParam{{Name: "v", Type: "int32"}},
+							Inits:  []string{"Inner: &scale_codec.Integer[int32]{Value: v}"},
+							Getter: "Get",
+							Values: []string{"variant.Inner.Value"},
+						},
+					},
+					Random: "scale_codec.RandomInteger[int32]",
+					Finite: true,
 				},
 			},
 		},
@@ -54,128 +70,488 @@ func TestEnumParser(t *testing.T) {
 			Variants: []EnumField{
 				{
 					Name:            "Single",
+					Index:           0,
 					Type:            "*scale_codec.SimpleVariant",
 					TypeConstructor: "new(scale_codec.SimpleVariant)",
+					Unit:            true,
+					Finite:          true,
 				},
 				{
 					Name:            "Int",
+					Index:           1,
 					Type:            "*scale_codec.Integer[uint64]",
 					TypeConstructor: "new(scale_codec.Integer[uint64])",
+					FromJSON:        "scale_codec.FromJSON[scale_codec.Integer[uint64]]",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "From",
+							Params: []HelperParam{{Name: "v", Type: "uint64"}},
+							Inits:  []string{"Inner: &scale_codec.Integer[uint64]{Value: v}"},
+							Getter: "Get",
+							Values: []string{"variant.Inner.Value"},
+						},
+					},
+					Random: "scale_codec.RandomInteger[uint64]",
+					Finite: true,
 				},
 				{
 					Name:            "Bool",
+					Index:           2,
 					Type:            "*scale_codec.Bool",
 					TypeConstructor: "new(scale_codec.Bool)",
+					FromJSON:        "scale_codec.FromJSON[scale_codec.Bool]",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "From",
+							Params: []HelperParam{{Name: "v", Type: "bool"}},
+							Inits:  []string{"Inner: &scale_codec.Bool{Value: v}"},
+							Getter: "Get",
+							Values: []string{"variant.Inner.Value"},
+						},
+					},
+					Random: "scale_codec.RandomBool",
+					Finite: true,
 				},
 				{
 					Name:            "A",
+					Index:           3,
 					Type:            "*scale_codec.OptionG[*scale_codec.Bool]",
 					TypeConstructor: "new(scale_codec.OptionG[*scale_codec.Bool])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader,scale_codec.BoolFromRawBytes)",
+					FromJSON:        "scale_codec.UnmarshalOptionFromJSON[*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Bool])",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "None",
+							Inits:  []string{"Inner: scale_codec.NoneG[*scale_codec.Bool]()"},
+						},
+						{
+							Suffix: "Some",
+							Params: []HelperParam{{Name: "v", Type: "bool"}},
+							Inits:  []string{"Inner: scale_codec.SomeG[*scale_codec.Bool](&scale_codec.Bool{Value: v})"},
+							Getter: "Some",
+							Unwrap: "Unwrap",
+							Values: []string{"inner.Value"},
+						},
+					},
+					Random: "scale_codec.RandomOption(scale_codec.RandomBool)",
+					Finite: true,
 				},
 				{
 					Name:            "B",
+					Index:           4,
 					Type:            "*scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Integer[uint64]]",
 					TypeConstructor: "new(scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Integer[uint64]])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader, scale_codec.IntegerFromRawBytes[uint64], scale_codec.IntegerFromRawBytes[uint64])",
+					FromJSON:        "scale_codec.UnmarshalResultFromJSON[*scale_codec.Integer[uint64],*scale_codec.Integer[uint64]](scale_codec.FromJSON[scale_codec.Integer[uint64]],scale_codec.FromJSON[scale_codec.Integer[uint64]])",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "Ok",
+							Params: []HelperParam{{Name: "v", Type: "uint64"}},
+							Inits:  []string{"Inner: scale_codec.OkG[*scale_codec.Integer[uint64], *scale_codec.Integer[uint64]](&scale_codec.Integer[uint64]{Value: v})"},
+							Getter: "Ok",
+							Unwrap: "Ok",
+							Values: []string{"inner.Value"},
+						},
+						{
+							Suffix: "Err",
+							Params: []HelperParam{{Name: "v", Type: "uint64"}},
+							Inits:  []string{"Inner: scale_codec.ErrG[*scale_codec.Integer[uint64], *scale_codec.Integer[uint64]](&scale_codec.Integer[uint64]{Value: v})"},
+							Getter: "Err",
+							Unwrap: "Err",
+							Values: []string{"inner.Value"},
+						},
+					},
+					Random: "scale_codec.RandomResult(scale_codec.RandomInteger[uint64], scale_codec.RandomInteger[uint64])",
+					Finite: true,
 				},
 				{
 					Name:            "C",
+					Index:           5,
 					Type:            "*scale_codec.OptionG[Nested]",
 					TypeConstructor: "new(scale_codec.OptionG[Nested])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader, UnmarshalNested)",
+					FromJSON:        "scale_codec.UnmarshalOptionFromJSON[Nested](UnmarshalNestedJSON)",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "None",
+							Inits:  []string{"Inner: scale_codec.NoneG[Nested]()"},
+						},
+						{
+							Suffix: "Some",
+							Params: []HelperParam{{Name: "v", Type: "Nested"}},
+							Inits:  []string{"Inner: scale_codec.SomeG[Nested](v)"},
+							Getter: "Some",
+							Unwrap: "Unwrap",
+							Values: []string{"inner"},
+						},
+					},
+					Random: "scale_codec.RandomOption(randomNested)",
+					Finite: true,
 				},
 				{
 					Name:            "D",
+					Index:           6,
 					Type:            "*scale_codec.ResultG[Nested,*scale_codec.Integer[uint64]]",
 					TypeConstructor: "new(scale_codec.ResultG[Nested,*scale_codec.Integer[uint64]])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader, UnmarshalNested, scale_codec.IntegerFromRawBytes[uint64])",
+					FromJSON:        "scale_codec.UnmarshalResultFromJSON[Nested,*scale_codec.Integer[uint64]](UnmarshalNestedJSON,scale_codec.FromJSON[scale_codec.Integer[uint64]])",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "Ok",
+							Params: []HelperParam{{Name: "v", Type: "Nested"}},
+							Inits:  []string{"Inner: scale_codec.OkG[Nested, *scale_codec.Integer[uint64]](v)"},
+							Getter: "Ok",
+							Unwrap: "Ok",
+							Values: []string{"inner"},
+						},
+						{
+							Suffix: "Err",
+							Params: []HelperParam{{Name: "v", Type: "uint64"}},
+							Inits:  []string{"Inner: scale_codec.ErrG[Nested, *scale_codec.Integer[uint64]](&scale_codec.Integer[uint64]{Value: v})"},
+							Getter: "Err",
+							Unwrap: "Err",
+							Values: []string{"inner.Value"},
+						},
+					},
+					Random: "scale_codec.RandomResult(randomNested, scale_codec.RandomInteger[uint64])",
+					Finite: true,
 				},
 				{
 					Name:            "E",
+					Index:           7,
 					Type:            "*scale_codec.ResultG[Nested,Nested]",
 					TypeConstructor: "new(scale_codec.ResultG[Nested,Nested])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader, UnmarshalNested, UnmarshalNested)",
+					FromJSON:        "scale_codec.UnmarshalResultFromJSON[Nested,Nested](UnmarshalNestedJSON,UnmarshalNestedJSON)",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "Ok",
+							Params: []HelperParam{{Name: "v", Type: "Nested"}},
+							Inits:  []string{"Inner: scale_codec.OkG[Nested, Nested](v)"},
+							Getter: "Ok",
+							Unwrap: "Ok",
+							Values: []string{"inner"},
+						},
+						{
+							Suffix: "Err",
+							Params: []HelperParam{{Name: "v", Type: "Nested"}},
+							Inits:  []string{"Inner: scale_codec.ErrG[Nested, Nested](v)"},
+							Getter: "Err",
+							Unwrap: "Err",
+							Values: []string{"inner"},
+						},
+					},
+					Random: "scale_codec.RandomResult(randomNested, randomNested)",
 				},
 				{
 					Name:            "F",
+					Index:           8,
 					Type:            "*scale_codec.ResultG[*scale_codec.Integer[uint64],Nested]",
 					TypeConstructor: "new(scale_codec.ResultG[*scale_codec.Integer[uint64],Nested])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader, scale_codec.IntegerFromRawBytes[uint64], UnmarshalNested)",
+					FromJSON:        "scale_codec.UnmarshalResultFromJSON[*scale_codec.Integer[uint64],Nested](scale_codec.FromJSON[scale_codec.Integer[uint64]],UnmarshalNestedJSON)",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "Ok",
+							Params: []HelperParam{{Name: "v", Type: "uint64"}},
+							Inits:  []string{"Inner: scale_codec.OkG[*scale_codec.Integer[uint64], Nested](&scale_codec.Integer[uint64]{Value: v})"},
+							Getter: "Ok",
+							Unwrap: "Ok",
+							Values: []string{"inner.Value"},
+						},
+						{
+							Suffix: "Err",
+							Params: []HelperParam{{Name: "v", Type: "Nested"}},
+							Inits:  []string{"Inner: scale_codec.ErrG[*scale_codec.Integer[uint64], Nested](v)"},
+							Getter: "Err",
+							Unwrap: "Err",
+							Values: []string{"inner"},
+						},
+					},
+					Random: "scale_codec.RandomResult(scale_codec.RandomInteger[uint64], randomNested)",
+					Finite: true,
 				},
 				{
 					Name:            "G",
+					Index:           9,
 					Type:            "*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]",
 					TypeConstructor: "new(T2[*scale_codec.Integer[uint64],*scale_codec.Bool])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader,scale_codec.IntegerFromRawBytes[uint64],scale_codec.BoolFromRawBytes)",
+					FromJSON:        "UnmarshalT2FromJSON[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Integer[uint64]],scale_codec.FromJSON[scale_codec.Bool])",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "From",
+							Params: []HelperParam{{Name: "a", Type: "uint64"}, {Name: "b", Type: "bool"}},
+							Inits:  []string{"Inner: &T2[*scale_codec.Integer[uint64],*scale_codec.Bool]{F0: &scale_codec.Integer[uint64]{Value: a}, F1: &scale_codec.Bool{Value: b}}"},
+							Getter: "Get",
+							Values: []string{"variant.Inner.F0.Value", "variant.Inner.F1.Value"},
+						},
+					},
+					Random: "randomT2(scale_codec.RandomInteger[uint64], scale_codec.RandomBool)",
+					Finite: true,
 				},
 				{
 					Name:            "H",
+					Index:           10,
 					Type:            "*scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]]",
 					TypeConstructor: "new(scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader,UnmarshalT2FromRawBytes[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.IntegerFromRawBytes[uint64],scale_codec.BoolFromRawBytes))",
+					FromJSON:        "scale_codec.UnmarshalOptionFromJSON[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]](UnmarshalT2FromJSON[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Integer[uint64]],scale_codec.FromJSON[scale_codec.Bool]))",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "None",
+							Inits:  []string{"Inner: scale_codec.NoneG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]]()"},
+						},
+						{
+							Suffix: "Some",
+							Params: []HelperParam{{Name: "a", Type: "uint64"}, {Name: "b", Type: "bool"}},
+							Inits:  []string{"Inner: scale_codec.SomeG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]](&T2[*scale_codec.Integer[uint64],*scale_codec.Bool]{F0: &scale_codec.Integer[uint64]{Value: a}, F1: &scale_codec.Bool{Value: b}})"},
+							Getter: "Some",
+							Unwrap: "Unwrap",
+							Values: []string{"inner.F0.Value", "inner.F1.Value"},
+						},
+					},
+					Random: "scale_codec.RandomOption(randomT2(scale_codec.RandomInteger[uint64], scale_codec.RandomBool))",
+					Finite: true,
 				},
 				{
 					Name:            "J",
+					Index:           11,
 					Type:            "*scale_codec.ResultG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.Bool]",
 					TypeConstructor: "new(scale_codec.ResultG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.Bool])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader, UnmarshalT2FromRawBytes[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.IntegerFromRawBytes[uint64],scale_codec.BoolFromRawBytes), scale_codec.BoolFromRawBytes)",
+					FromJSON:        "scale_codec.UnmarshalResultFromJSON[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.Bool](UnmarshalT2FromJSON[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Integer[uint64]],scale_codec.FromJSON[scale_codec.Bool]),scale_codec.FromJSON[scale_codec.Bool])",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "Ok",
+							Params: []HelperParam{{Name: "a", Type: "uint64"}, {Name: "b", Type: "bool"}},
+							Inits:  []string{"Inner: scale_codec.OkG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool], *scale_codec.Bool](&T2[*scale_codec.Integer[uint64],*scale_codec.Bool]{F0: &scale_codec.Integer[uint64]{Value: a}, F1: &scale_codec.Bool{Value: b}})"},
+							Getter: "Ok",
+							Unwrap: "Ok",
+							Values: []string{"inner.F0.Value", "inner.F1.Value"},
+						},
+						{
+							Suffix: "Err",
+							Params: []HelperParam{{Name: "v", Type: "bool"}},
+							Inits:  []string{"Inner: scale_codec.ErrG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool], *scale_codec.Bool](&scale_codec.Bool{Value: v})"},
+							Getter: "Err",
+							Unwrap: "Err",
+							Values: []string{"inner.Value"},
+						},
+					},
+					Random: "scale_codec.RandomResult(randomT2(scale_codec.RandomInteger[uint64], scale_codec.RandomBool), scale_codec.RandomBool)",
+					Finite: true,
 				},
 				{
 					Name:            "K",
+					Index:           12,
 					Type:            "*T2[*scale_codec.OptionG[*scale_codec.Bool],*scale_codec.ResultG[*scale_codec.Bool,*scale_codec.Bool]]",
 					TypeConstructor: "new(T2[*scale_codec.OptionG[*scale_codec.Bool],*scale_codec.ResultG[*scale_codec.Bool,*scale_codec.Bool]])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader,scale_codec.UnmarshalOptionFromRawBytes[*scale_codec.Bool](scale_codec.BoolFromRawBytes),scale_codec.UnmarshalResultFromRawBytes[*scale_codec.Bool,*scale_codec.Bool](scale_codec.BoolFromRawBytes,scale_codec.BoolFromRawBytes))",
+					FromJSON:        "UnmarshalT2FromJSON[*scale_codec.OptionG[*scale_codec.Bool],*scale_codec.ResultG[*scale_codec.Bool,*scale_codec.Bool]](scale_codec.UnmarshalOptionFromJSON[*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Bool]),scale_codec.UnmarshalResultFromJSON[*scale_codec.Bool,*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Bool],scale_codec.FromJSON[scale_codec.Bool]))",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "From",
+							Params: []HelperParam{{Name: "a", Type: "*scale_codec.OptionG[*scale_codec.Bool]"}, {Name: "b", Type: "*scale_codec.ResultG[*scale_codec.Bool,*scale_codec.Bool]"}},
+							Inits:  []string{"Inner: &T2[*scale_codec.OptionG[*scale_codec.Bool],*scale_codec.ResultG[*scale_codec.Bool,*scale_codec.Bool]]{F0: a, F1: b}"},
+							Getter: "Get",
+							Values: []string{"variant.Inner.F0", "variant.Inner.F1"},
+						},
+					},
+					Random: "randomT2(scale_codec.RandomOption(scale_codec.RandomBool), scale_codec.RandomResult(scale_codec.RandomBool, scale_codec.RandomBool))",
+					Finite: true,
 				},
 				{
 					Name:            "L",
+					Index:           13,
 					Type:            "*scale_codec.ResultG[*scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]],*scale_codec.Integer[uint64]]",
 					TypeConstructor: "new(scale_codec.ResultG[*scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]],*scale_codec.Integer[uint64]])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader, scale_codec.UnmarshalOptionFromRawBytes[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]](UnmarshalT2FromRawBytes[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.IntegerFromRawBytes[uint64],scale_codec.BoolFromRawBytes)), scale_codec.IntegerFromRawBytes[uint64])",
+					FromJSON:        "scale_codec.UnmarshalResultFromJSON[*scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]],*scale_codec.Integer[uint64]](scale_codec.UnmarshalOptionFromJSON[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]](UnmarshalT2FromJSON[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Integer[uint64]],scale_codec.FromJSON[scale_codec.Bool])),scale_codec.FromJSON[scale_codec.Integer[uint64]])",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "Ok",
+							Params: []HelperParam{{Name: "v", Type: "*scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]]"}},
+							Inits:  []string{"Inner: scale_codec.OkG[*scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]], *scale_codec.Integer[uint64]](v)"},
+							Getter: "Ok",
+							Unwrap: "Ok",
+							Values: []string{"inner"},
+						},
+						{
+							Suffix: "Err",
+							Params: []HelperParam{{Name: "v", Type: "uint64"}},
+							Inits:  []string{"Inner: scale_codec.ErrG[*scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]], *scale_codec.Integer[uint64]](&scale_codec.Integer[uint64]{Value: v})"},
+							Getter: "Err",
+							Unwrap: "Err",
+							Values: []string{"inner.Value"},
+						},
+					},
+					Random: "scale_codec.RandomResult(scale_codec.RandomOption(randomT2(scale_codec.RandomInteger[uint64], scale_codec.RandomBool)), scale_codec.RandomInteger[uint64])",
+					Finite: true,
 				},
 				{
 					Name:            "M",
+					Index:           14,
 					Type:            "*scale_codec.OptionG[Nested]",
 					TypeConstructor: "new(scale_codec.OptionG[Nested])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader, UnmarshalNested)",
+					FromJSON:        "scale_codec.UnmarshalOptionFromJSON[Nested](UnmarshalNestedJSON)",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "None",
+							Inits:  []string{"Inner: scale_codec.NoneG[Nested]()"},
+						},
+						{
+							Suffix: "Some",
+							Params: []HelperParam{{Name: "v", Type: "Nested"}},
+							Inits:  []string{"Inner: scale_codec.SomeG[Nested](v)"},
+							Getter: "Some",
+							Unwrap: "Unwrap",
+							Values: []string{"inner"},
+						},
+					},
+					Random: "scale_codec.RandomOption(randomNested)",
+					Finite: true,
 				},
 				{
 					Name:            "N",
+					Index:           15,
 					Type:            "*scale_codec.ResultG[Nested,*scale_codec.Bool]",
 					TypeConstructor: "new(scale_codec.ResultG[Nested,*scale_codec.Bool])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader, UnmarshalNested, scale_codec.BoolFromRawBytes)",
+					FromJSON:        "scale_codec.UnmarshalResultFromJSON[Nested,*scale_codec.Bool](UnmarshalNestedJSON,scale_codec.FromJSON[scale_codec.Bool])",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "Ok",
+							Params: []HelperParam{{Name: "v", Type: "Nested"}},
+							Inits:  []string{"Inner: scale_codec.OkG[Nested, *scale_codec.Bool](v)"},
+							Getter: "Ok",
+							Unwrap: "Ok",
+							Values: []string{"inner"},
+						},
+						{
+							Suffix: "Err",
+							Params: []HelperParam{{Name: "v", Type: "bool"}},
+							Inits:  []string{"Inner: scale_codec.ErrG[Nested, *scale_codec.Bool](&scale_codec.Bool{Value: v})"},
+							Getter: "Err",
+							Unwrap: "Err",
+							Values: []string{"inner.Value"},
+						},
+					},
+					Random: "scale_codec.RandomResult(randomNested, scale_codec.RandomBool)",
+					Finite: true,
 				},
 				{
 					Name:            "O",
+					Index:           16,
 					Type:            "*scale_codec.ResultG[*scale_codec.Bool,Nested]",
 					TypeConstructor: "new(scale_codec.ResultG[*scale_codec.Bool,Nested])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader, scale_codec.BoolFromRawBytes, UnmarshalNested)",
+					FromJSON:        "scale_codec.UnmarshalResultFromJSON[*scale_codec.Bool,Nested](scale_codec.FromJSON[scale_codec.Bool],UnmarshalNestedJSON)",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "Ok",
+							Params: []HelperParam{{Name: "v", Type: "bool"}},
+							Inits:  []string{"Inner: scale_codec.OkG[*scale_codec.Bool, Nested](&scale_codec.Bool{Value: v})"},
+							Getter: "Ok",
+							Unwrap: "Ok",
+							Values: []string{"inner.Value"},
+						},
+						{
+							Suffix: "Err",
+							Params: []HelperParam{{Name: "v", Type: "Nested"}},
+							Inits:  []string{"Inner: scale_codec.ErrG[*scale_codec.Bool, Nested](v)"},
+							Getter: "Err",
+							Unwrap: "Err",
+							Values: []string{"inner"},
+						},
+					},
+					Random: "scale_codec.RandomResult(scale_codec.RandomBool, randomNested)",
+					Finite: true,
 				},
 				{
 					Name:            "P",
-					Type:            "*scale_codec.ResultG[Nested,Error]",
-					TypeConstructor: "new(scale_codec.ResultG[Nested,Error])",
+					Index:           17,
+					Type:            "*scale_codec.ResultG[Nested,*Error]",
+					TypeConstructor: "new(scale_codec.ResultG[Nested,*Error])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader, UnmarshalNested, UnmarshalError)",
+					FromJSON:        "scale_codec.UnmarshalResultFromJSON[Nested,*Error](UnmarshalNestedJSON,scale_codec.FromJSON[Error])",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "Ok",
+							Params: []HelperParam{{Name: "v", Type: "Nested"}},
+							Inits:  []string{"Inner: scale_codec.OkG[Nested, *Error](v)"},
+							Getter: "Ok",
+							Unwrap: "Ok",
+							Values: []string{"inner"},
+						},
+						{
+							Suffix: "Err",
+							Params: []HelperParam{{Name: "v", Type: "Error"}},
+							Inits:  []string{"Inner: scale_codec.ErrG[Nested, *Error](v.Ptr())"},
+							Getter: "Err",
+							Unwrap: "Err",
+							Values: []string{"*inner"},
+						},
+					},
+					Random: "scale_codec.RandomResult(randomNested, randomError)",
 				},
 				{
 					Name:            "Q",
-					Type:            "*T3[Nested,*scale_codec.Integer[uint64],Error]",
-					TypeConstructor: "new(T3[Nested,*scale_codec.Integer[uint64],Error])",
+					Index:           18,
+					Type:            "*T3[Nested,*scale_codec.Integer[uint64],*Error]",
+					TypeConstructor: "new(T3[Nested,*scale_codec.Integer[uint64],*Error])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader,UnmarshalNested,scale_codec.IntegerFromRawBytes[uint64],UnmarshalError)",
+					FromJSON:        "UnmarshalT3FromJSON[Nested,*scale_codec.Integer[uint64],*Error](UnmarshalNestedJSON,scale_codec.FromJSON[scale_codec.Integer[uint64]],scale_codec.FromJSON[Error])",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "From",
+							Params: []HelperParam{{Name: "a", Type: "Nested"}, {Name: "b", Type: "uint64"}, {Name: "c", Type: "Error"}},
+							Inits:  []string{"Inner: &T3[Nested,*scale_codec.Integer[uint64],*Error]{F0: a, F1: &scale_codec.Integer[uint64]{Value: b}, F2: c.Ptr()}"},
+							Getter: "Get",
+							Values: []string{"variant.Inner.F0", "variant.Inner.F1.Value", "*variant.Inner.F2"},
+						},
+					},
+					Random: "randomT3(randomNested, scale_codec.RandomInteger[uint64], randomError)",
 				},
 				{
 					Name:            "R",
-					Type:            "*T3[*scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.OptionG[*scale_codec.Integer[uint64]],Error]",
-					TypeConstructor: "new(T3[*scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.OptionG[*scale_codec.Integer[uint64]],Error])",
+					Index:           19,
+					Type:            "*T3[*scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.OptionG[*scale_codec.Integer[uint64]],*Error]",
+					TypeConstructor: "new(T3[*scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.OptionG[*scale_codec.Integer[uint64]],*Error])",
 					UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader,scale_codec.UnmarshalResultFromRawBytes[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.IntegerFromRawBytes[uint64],scale_codec.BoolFromRawBytes),scale_codec.UnmarshalOptionFromRawBytes[*scale_codec.Integer[uint64]](scale_codec.IntegerFromRawBytes[uint64]),UnmarshalError)",
+					FromJSON:        "UnmarshalT3FromJSON[*scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.OptionG[*scale_codec.Integer[uint64]],*Error](scale_codec.UnmarshalResultFromJSON[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Integer[uint64]],scale_codec.FromJSON[scale_codec.Bool]),scale_codec.UnmarshalOptionFromJSON[*scale_codec.Integer[uint64]](scale_codec.FromJSON[scale_codec.Integer[uint64]]),scale_codec.FromJSON[Error])",
+					Helpers: []VariantHelper{
+						{
+							Suffix: "From",
+							Params: []HelperParam{{Name: "a", Type: "*scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Bool]"}, {Name: "b", Type: "*scale_codec.OptionG[*scale_codec.Integer[uint64]]"}, {Name: "c", Type: "Error"}},
+							Inits:  []string{"Inner: &T3[*scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.OptionG[*scale_codec.Integer[uint64]],*Error]{F0: a, F1: b, F2: c.Ptr()}"},
+							Getter: "Get",
+							Values: []string{"variant.Inner.F0", "variant.Inner.F1", "*variant.Inner.F2"},
+						},
+					},
+					Random: "randomT3(scale_codec.RandomResult(scale_codec.RandomInteger[uint64], scale_codec.RandomBool), scale_codec.RandomOption(scale_codec.RandomInteger[uint64]), randomError)",
+				},
+			},
+		},
+		{
+			Name: "Error",
+			Unit: true,
+			Variants: []EnumField{
+				{
+					Name:            "FailureX",
+					Type:            "*scale_codec.SimpleVariant",
+					TypeConstructor: "new(scale_codec.SimpleVariant)",
+					Unit:            true,
+					Finite:          true,
 				},
 			},
 		},
 	}
 
-	result := ParseEnum("", strings.NewReader(input))
-	if result != 0 {
-		t.Fatalf("error to parse enum")
+	err := ParseEnum("", strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("error to parse enum: %v", err)
 	}
 
 	for i, expected := range expectedEnum {
@@ -184,6 +560,10 @@ func TestEnumParser(t *testing.T) {
 			t.Fatalf("\nexpected: %v\ngot: %v", expected.Name, actual.Name)
 		}
 
+		if expected.Unit != actual.Unit {
+			t.Fatalf("\nexpected unit enum %s: %v\ngot: %v", expected.Name, expected.Unit, actual.Unit)
+		}
+
 		for j, variant := range expected.Variants {
 			actualVariant := actual.Variants[j]
 			if !reflect.DeepEqual(variant, actualVariant) {
@@ -194,3 +574,454 @@ func TestEnumParser(t *testing.T) {
 
 	fmt.Println(GenericTuple)
 }
+
+func TestStructParser(t *testing.T) {
+	const input = `
+	struct Id(uint32)
+
+	struct Transfer {
+		dest_id: Id,
+		value: Option<uint64>,
+		call: Call
+	}
+
+	enum Call {
+		Remark
+		Send(Transfer)
+		Pair(uint32, bool)
+		Move { to_id: Id, amount: uint64 }
+	}`
+
+	expectedStructs := []Struct{
+		{
+			Name: "Id",
+			Fields: []StructField{
+				{
+					Name:            "F0",
+					Type:            "*scale_codec.Integer[uint32]",
+					TypeConstructor: "new(scale_codec.Integer[uint32])",
+					FromRawBytes:    "scale_codec.IntegerFromRawBytes[uint32]",
+					FromJSON:        "scale_codec.FromJSON[scale_codec.Integer[uint32]]",
+					Random:          "scale_codec.RandomInteger[uint32]",
+				},
+			},
+			Tuple: true,
+		},
+		{
+			Name: "Transfer",
+			Fields: []StructField{
+				{
+					Name:            "DestId",
+					Type:            "*Id",
+					TypeConstructor: "new(Id)",
+					FromRawBytes:    "UnmarshalId",
+					FromJSON:        "scale_codec.FromJSON[Id]",
+					SchemaName:      "dest_id",
+					JSONName:        "destId",
+					Random:          "randomId",
+				},
+				{
+					Name:            "Value",
+					Type:            "*scale_codec.OptionG[*scale_codec.Integer[uint64]]",
+					TypeConstructor: "new(scale_codec.OptionG[*scale_codec.Integer[uint64]])",
+					FromRawBytes:    "scale_codec.UnmarshalOptionFromRawBytes[*scale_codec.Integer[uint64]](scale_codec.IntegerFromRawBytes[uint64])",
+					FromJSON:        "scale_codec.UnmarshalOptionFromJSON[*scale_codec.Integer[uint64]](scale_codec.FromJSON[scale_codec.Integer[uint64]])",
+					SchemaName:      "value",
+					JSONName:        "value",
+					Random:          "scale_codec.RandomOption(scale_codec.RandomInteger[uint64])",
+				},
+				{
+					Name:            "Call",
+					Type:            "Call",
+					TypeConstructor: "nil",
+					FromRawBytes:    "UnmarshalCall",
+					FromJSON:        "UnmarshalCallJSON",
+					SchemaName:      "call",
+					JSONName:        "call",
+					Random:          "randomCall",
+				},
+			},
+		},
+	}
+
+	expectedSend := EnumField{
+		Name:            "Send",
+		Type:            "*Transfer",
+		TypeConstructor: "new(Transfer)",
+		FromJSON:        "scale_codec.FromJSON[Transfer]",
+		Index:           1,
+		Random:          "randomTransfer",
+	}
+
+	expectedPair := EnumField{
+		Name:  "Pair",
+		Index: 2,
+		Fields: []StructField{
+			{
+				Name:            "F0",
+				Type:            "*scale_codec.Integer[uint32]",
+				TypeConstructor: "new(scale_codec.Integer[uint32])",
+				FromRawBytes:    "scale_codec.IntegerFromRawBytes[uint32]",
+				FromJSON:        "scale_codec.FromJSON[scale_codec.Integer[uint32]]",
+				Random:          "scale_codec.RandomInteger[uint32]",
+			},
+			{
+				Name:            "F1",
+				Type:            "*scale_codec.Bool",
+				TypeConstructor: "new(scale_codec.Bool)",
+				FromRawBytes:    "scale_codec.BoolFromRawBytes",
+				FromJSON:        "scale_codec.FromJSON[scale_codec.Bool]",
+				Random:          "scale_codec.RandomBool",
+			},
+		},
+		Tuple: true,
+		Helpers: []VariantHelper{
+			{
+				Suffix: "From",
+				Params: []HelperParam{{Name: "a", Type: "uint32"}, {Name: "b", Type: "bool"}},
+				Inits:  []string{"F0: &scale_codec.Integer[uint32]{Value: a}", "F1: &scale_codec.Bool{Value: b}"},
+			},
+		},
+		Finite: true,
+	}
+
+	expectedMove := EnumField{
+		Name:  "Move",
+		Index: 3,
+		Fields: []StructField{
+			{
+				Name:            "ToId",
+				Type:            "*Id",
+				TypeConstructor: "new(Id)",
+				FromRawBytes:    "UnmarshalId",
+				FromJSON:        "scale_codec.FromJSON[Id]",
+				SchemaName:      "to_id",
+				JSONName:        "toId",
+				Random:          "randomId",
+			},
+			{
+				Name:            "Amount",
+				Type:            "*scale_codec.Integer[uint64]",
+				TypeConstructor: "new(scale_codec.Integer[uint64])",
+				FromRawBytes:    "scale_codec.IntegerFromRawBytes[uint64]",
+				FromJSON:        "scale_codec.FromJSON[scale_codec.Integer[uint64]]",
+				SchemaName:      "amount",
+				JSONName:        "amount",
+				Random:          "scale_codec.RandomInteger[uint64]",
+			},
+		},
+		Helpers: []VariantHelper{
+			{
+				Suffix: "From",
+				Params: []HelperParam{{Name: "toId", Type: "*Id"}, {Name: "amount", Type: "uint64"}},
+				Inits:  []string{"ToId: toId", "Amount: &scale_codec.Integer[uint64]{Value: amount}"},
+			},
+		},
+	}
+
+	err := ParseEnum("", strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("error to parse schema: %v", err)
+	}
+
+	if !reflect.DeepEqual(expectedStructs, Structs) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expectedStructs, Structs)
+	}
+
+	for idx, expected := range []EnumField{expectedSend, expectedPair, expectedMove} {
+		actual := Enums[0].Variants[idx+1]
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("\nexpected: %v\ngot: %v", expected, actual)
+		}
+	}
+}
+
+func TestEnumExplicitIndex(t *testing.T) {
+	const input = `
+	enum Call {
+		Remark = 0
+		@index(0x0a) Transfer(uint64)
+		Kill
+		Burn = 3
+	}`
+
+	err := ParseEnum("", strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("error to parse enum: %v", err)
+	}
+
+	expectedIndexes := []int{0, 10, 2, 3}
+	for idx, expected := range expectedIndexes {
+		actual := Enums[0].Variants[idx]
+		if expected != actual.Index {
+			t.Fatalf("%s\nexpected: %v\ngot: %v", actual.Name, expected, actual.Index)
+		}
+	}
+}
+
+func TestCollectionTypesParser(t *testing.T) {
+	const input = `
+	struct Block {
+		hash: [u8; 32],
+		extrinsics: Vec<Bytes>,
+		nonce: Compact<u64>,
+		balance: u128,
+		memo: String,
+		votes: Option<Vec<(u32, [u8; 32])>>,
+		owners: BTreeMap<u32, [u16; 2]>,
+		tags: BTreeSet<String>,
+		ack: (),
+	}`
+
+	expectedFields := []StructField{
+		{
+			Name:            "Hash",
+			Type:            "*scale_codec.ByteArray",
+			TypeConstructor: "scale_codec.NewByteArray(32)",
+			FromRawBytes:    "scale_codec.UnmarshalByteArrayFromRawBytes(32)",
+			FromJSON:        "scale_codec.UnmarshalByteArrayFromJSON(32)",
+			SchemaName:      "hash",
+			JSONName:        "hash",
+			Random:          "scale_codec.RandomByteArray(32)",
+		},
+		{
+			Name:            "Extrinsics",
+			Type:            "*scale_codec.VecG[*scale_codec.Bytes]",
+			TypeConstructor: "new(scale_codec.VecG[*scale_codec.Bytes])",
+			FromRawBytes:    "scale_codec.UnmarshalVecFromRawBytes[*scale_codec.Bytes](scale_codec.BytesFromRawBytes)",
+			FromJSON:        "scale_codec.UnmarshalVecFromJSON[*scale_codec.Bytes](scale_codec.FromJSON[scale_codec.Bytes])",
+			SchemaName:      "extrinsics",
+			JSONName:        "extrinsics",
+			Random:          "scale_codec.RandomVec(scale_codec.RandomBytes)",
+		},
+		{
+			Name:            "Nonce",
+			Type:            "*scale_codec.Compact",
+			TypeConstructor: "new(scale_codec.Compact)",
+			FromRawBytes:    "scale_codec.CompactFromRawBytes",
+			FromJSON:        "scale_codec.FromJSON[scale_codec.Compact]",
+			SchemaName:      "nonce",
+			JSONName:        "nonce",
+			Random:          "scale_codec.RandomCompact",
+		},
+		{
+			Name:            "Balance",
+			Type:            "*scale_codec.U128",
+			TypeConstructor: "new(scale_codec.U128)",
+			FromRawBytes:    "scale_codec.U128FromRawBytes",
+			FromJSON:        "scale_codec.FromJSON[scale_codec.U128]",
+			SchemaName:      "balance",
+			JSONName:        "balance",
+			Random:          "scale_codec.RandomU128",
+		},
+		{
+			Name:            "Memo",
+			Type:            "*scale_codec.String",
+			TypeConstructor: "new(scale_codec.String)",
+			FromRawBytes:    "scale_codec.StringFromRawBytes",
+			FromJSON:        "scale_codec.FromJSON[scale_codec.String]",
+			SchemaName:      "memo",
+			JSONName:        "memo",
+			Random:          "scale_codec.RandomString",
+		},
+		{
+			Name:            "Votes",
+			Type:            "*scale_codec.OptionG[*scale_codec.VecG[*T2[*scale_codec.Integer[uint32],*scale_codec.ByteArray]]]",
+			TypeConstructor: "new(scale_codec.OptionG[*scale_codec.VecG[*T2[*scale_codec.Integer[uint32],*scale_codec.ByteArray]]])",
+			FromRawBytes: "scale_codec.UnmarshalOptionFromRawBytes[*scale_codec.VecG[*T2[*scale_codec.Integer[uint32],*scale_codec.ByteArray]]](" +
+				"scale_codec.UnmarshalVecFromRawBytes[*T2[*scale_codec.Integer[uint32],*scale_codec.ByteArray]](" +
+				"UnmarshalT2FromRawBytes[*scale_codec.Integer[uint32],*scale_codec.ByteArray](" +
+				"scale_codec.IntegerFromRawBytes[uint32],scale_codec.UnmarshalByteArrayFromRawBytes(32))))",
+			FromJSON: "scale_codec.UnmarshalOptionFromJSON[*scale_codec.VecG[*T2[*scale_codec.Integer[uint32],*scale_codec.ByteArray]]](" +
+				"scale_codec.UnmarshalVecFromJSON[*T2[*scale_codec.Integer[uint32],*scale_codec.ByteArray]](" +
+				"UnmarshalT2FromJSON[*scale_codec.Integer[uint32],*scale_codec.ByteArray](" +
+				"scale_codec.FromJSON[scale_codec.Integer[uint32]],scale_codec.UnmarshalByteArrayFromJSON(32))))",
+			SchemaName: "votes",
+			JSONName:   "votes",
+			Random:     "scale_codec.RandomOption(scale_codec.RandomVec(randomT2(scale_codec.RandomInteger[uint32], scale_codec.RandomByteArray(32))))",
+		},
+		{
+			Name:            "Owners",
+			Type:            "*scale_codec.MapG[*scale_codec.Integer[uint32],*scale_codec.ArrayG[*scale_codec.Integer[uint16]]]",
+			TypeConstructor: "new(scale_codec.MapG[*scale_codec.Integer[uint32],*scale_codec.ArrayG[*scale_codec.Integer[uint16]]])",
+			FromRawBytes: "scale_codec.UnmarshalMapFromRawBytes[*scale_codec.Integer[uint32],*scale_codec.ArrayG[*scale_codec.Integer[uint16]]](" +
+				"scale_codec.IntegerFromRawBytes[uint32]," +
+				"scale_codec.UnmarshalArrayFromRawBytes[*scale_codec.Integer[uint16]](2, scale_codec.IntegerFromRawBytes[uint16]))",
+			FromJSON: "scale_codec.UnmarshalMapFromJSON[*scale_codec.Integer[uint32],*scale_codec.ArrayG[*scale_codec.Integer[uint16]]](" +
+				"scale_codec.FromJSON[scale_codec.Integer[uint32]]," +
+				"scale_codec.UnmarshalArrayFromJSON[*scale_codec.Integer[uint16]](2, scale_codec.FromJSON[scale_codec.Integer[uint16]]))",
+			SchemaName: "owners",
+			JSONName:   "owners",
+			Random:     "scale_codec.RandomMap(scale_codec.RandomInteger[uint32], scale_codec.RandomArray(2, scale_codec.RandomInteger[uint16]))",
+		},
+		{
+			Name:            "Tags",
+			Type:            "*scale_codec.SetG[*scale_codec.String]",
+			TypeConstructor: "new(scale_codec.SetG[*scale_codec.String])",
+			FromRawBytes:    "scale_codec.UnmarshalSetFromRawBytes[*scale_codec.String](scale_codec.StringFromRawBytes)",
+			FromJSON:        "scale_codec.UnmarshalSetFromJSON[*scale_codec.String](scale_codec.FromJSON[scale_codec.String])",
+			SchemaName:      "tags",
+			JSONName:        "tags",
+			Random:          "scale_codec.RandomSet(scale_codec.RandomString)",
+		},
+		{
+			Name:            "Ack",
+			Type:            "*scale_codec.Unit",
+			TypeConstructor: "new(scale_codec.Unit)",
+			FromRawBytes:    "scale_codec.UnitFromRawBytes",
+			FromJSON:        "scale_codec.FromJSON[scale_codec.Unit]",
+			SchemaName:      "ack",
+			JSONName:        "ack",
+			Random:          "scale_codec.RandomUnit",
+		},
+	}
+
+	err := ParseEnum("", strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("error to parse schema: %v", err)
+	}
+
+	for idx, expected := range expectedFields {
+		actual := Structs[0].Fields[idx]
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("\nexpected: %v\ngot: %v", expected, actual)
+		}
+	}
+}
+
+func TestGenericParser(t *testing.T) {
+	const input = `
+	enum MaybeRef<T> {
+		Inline(T)
+		Hash([u8; 32])
+	}
+
+	struct Pair<A, B>(A, Option<B>)
+
+	enum Call {
+		Store(MaybeRef<u64>)
+		Both(Pair<bool, Call>)
+	}`
+
+	err := ParseEnum("", strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("error to parse schema: %v", err)
+	}
+
+	expectedInline := EnumField{
+		Name:            "Inline",
+		Type:            "T",
+		TypeConstructor: "*new(T)",
+		UnmarshalScale:  "var err error\n\ti.Inner, err = funcT(reader)\n\treturn err",
+		FromJSON:        "jsonT",
+		Random:          "randomT",
+		Finite:          true,
+	}
+
+	if !reflect.DeepEqual([]string{"T"}, Enums[0].TypeParams) {
+		t.Fatalf("\nexpected: %v\ngot: %v", []string{"T"}, Enums[0].TypeParams)
+	}
+
+	if !reflect.DeepEqual(expectedInline, Enums[0].Variants[0]) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expectedInline, Enums[0].Variants[0])
+	}
+
+	expectedPair := Struct{
+		Name:       "Pair",
+		TypeParams: []string{"A", "B"},
+		Fields: []StructField{
+			{
+				Name:            "F0",
+				Type:            "A",
+				TypeConstructor: "*new(A)",
+				FromRawBytes:    "funcA",
+				FromJSON:        "jsonA",
+				Random:          "randomA",
+			},
+			{
+				Name:            "F1",
+				Type:            "*scale_codec.OptionG[B]",
+				TypeConstructor: "new(scale_codec.OptionG[B])",
+				FromRawBytes:    "scale_codec.UnmarshalOptionFromRawBytes[B](funcB)",
+				FromJSON:        "scale_codec.UnmarshalOptionFromJSON[B](jsonB)",
+				Random:          "scale_codec.RandomOption(randomB)",
+			},
+		},
+		Tuple: true,
+	}
+
+	if !reflect.DeepEqual(expectedPair, Structs[0]) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expectedPair, Structs[0])
+	}
+
+	expectedStore := EnumField{
+		Name:            "Store",
+		Type:            "MaybeRef[*scale_codec.Integer[uint64]]",
+		TypeConstructor: "nil",
+		FromJSON:        "UnmarshalMaybeRefFromJSON[*scale_codec.Integer[uint64]](scale_codec.FromJSON[scale_codec.Integer[uint64]])",
+		UnmarshalScale: "var err error\n\ti.Inner, err = UnmarshalMaybeRefFromRawBytes[*scale_codec.Integer[uint64]](" +
+			"scale_codec.IntegerFromRawBytes[uint64])(reader)\n\treturn err",
+		Random: "randomMaybeRef(scale_codec.RandomInteger[uint64])",
+		Finite: true,
+	}
+
+	expectedBoth := EnumField{
+		Name:            "Both",
+		Type:            "*Pair[*scale_codec.Bool,Call]",
+		TypeConstructor: "new(Pair[*scale_codec.Bool,Call])",
+		UnmarshalScale:  "return i.Inner.UnmarshalSCALE(reader, scale_codec.BoolFromRawBytes, UnmarshalCall)",
+		FromJSON:        "UnmarshalPairFromJSON[*scale_codec.Bool,Call](scale_codec.FromJSON[scale_codec.Bool],UnmarshalCallJSON)",
+		Index:           1,
+		Random:          "randomPair(scale_codec.RandomBool, randomCall)",
+		Finite:          true,
+	}
+
+	for idx, expected := range []EnumField{expectedStore, expectedBoth} {
+		actual := Enums[1].Variants[idx]
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("\nexpected: %v\ngot: %v", expected, actual)
+		}
+	}
+}
+
+func TestAliasAndNewtypeParser(t *testing.T) {
+	const input = `
+	type Byte = u8;
+	type Hash = [Byte; 32];
+	newtype AccountId(Hash)
+
+	struct Transfer {
+		to: AccountId,
+		memo: Vec<Byte>,
+		nonce: Compact<Nonce>,
+	}
+
+	type Nonce = u64`
+
+	err := ParseEnum("", strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("error to parse schema: %v", err)
+	}
+
+	expectedNewtypes := []Newtype{
+		{
+			Name:            "AccountId",
+			Type:            "*scale_codec.ByteArray",
+			TypeConstructor: "scale_codec.NewByteArray(32)",
+			FromRawBytes:    "scale_codec.UnmarshalByteArrayFromRawBytes(32)",
+			FromJSON:        "scale_codec.UnmarshalByteArrayFromJSON(32)",
+			Random:          "scale_codec.RandomByteArray(32)",
+		},
+	}
+
+	if !reflect.DeepEqual(expectedNewtypes, Newtypes) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expectedNewtypes, Newtypes)
+	}
+
+	expectedTypes := []string{"*AccountId", "*scale_codec.Bytes", "*scale_codec.Compact"}
+	for idx, expected := range expectedTypes {
+		if actual := Structs[0].Fields[idx].Type; expected != actual {
+			t.Fatalf("\nexpected: %v\ngot: %v", expected, actual)
+		}
+	}
+}
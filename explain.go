@@ -0,0 +1,299 @@
+package scale_codec
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// explainHexWidth is the number of bytes per line of an explanation hexdump
+const explainHexWidth = 8
+
+// ExplainedRange labels the bytes [Start, End) of a payload with the path
+// of the value they encode, such as `Transfer.to` or `Batch[1].0`, its type
+// and its decoded value. Enum tags have the type `tag` and the value
+// `Enum::Variant`, sequence prefixes the type `length`, and bytes runs,
+// such as Bytes or [u8; 32], are a single range without a value
+type ExplainedRange struct {
+	Start int
+	End   int
+	Path  string
+	Type  string
+	Value string
+}
+
+func (r ExplainedRange) String() string {
+	return r.bounds() + " " + r.label()
+}
+
+// bounds returns the range as `[start..end]`, or `[start]` for a single byte
+func (r ExplainedRange) bounds() string {
+	if r.End-r.Start == 1 {
+		return fmt.Sprintf("[%d]", r.Start)
+	}
+
+	return fmt.Sprintf("[%d..%d]", r.Start, r.End)
+}
+
+// label returns the path, the type and the value of the range
+func (r ExplainedRange) label() string {
+	var parts []string
+	for _, part := range []string{r.Path, r.Type} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	if r.Value != "" {
+		parts = append(parts, "= "+r.Value)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Explanation is a payload whose bytes are labelled by the
+// values they encode, in order and without gaps
+type Explanation struct {
+	Payload []byte
+	Ranges  []ExplainedRange
+}
+
+// Explain decodes payload as the type described by desc and labels every
+// byte range with the value it encodes, the annotated hexdump is printed
+// by the String method. When decoding fails, or bytes are left over, the
+// explanation of the bytes decoded so far is returned with the error and
+// the remaining bytes are labelled `undecoded` or `trailing bytes`
+func Explain(desc *TypeDesc, payload []byte) (*Explanation, error) {
+	e := &explainer{
+		explanation: &Explanation{Payload: payload},
+		reader:      bytes.NewReader(payload),
+	}
+
+	err := e.explain(desc, "")
+	if err == nil && e.reader.Len() > 0 {
+		err = fmt.Errorf("%d trailing bytes at offset %d", e.reader.Len(), e.offset())
+		e.addRemaining("trailing bytes")
+	} else if err != nil {
+		e.addRemaining("undecoded")
+	}
+
+	return e.explanation, err
+}
+
+// String returns the annotated hexdump of the payload, a line per range
+// with its bytes in hex, wrapped on the following lines when there are
+// more than eight, and its label
+func (e *Explanation) String() string {
+	width := 0
+	for _, r := range e.Ranges {
+		width = max(width, len(r.bounds()))
+	}
+
+	var output strings.Builder
+	for _, r := range e.Ranges {
+		bounds, label := r.bounds(), r.label()
+		for start := r.Start; start < r.End; start += explainHexWidth {
+			chunk := e.Payload[start:min(start+explainHexWidth, r.End)]
+			line := fmt.Sprintf("%-*s  %-*s  %s", width, bounds, 3*explainHexWidth-1, hexBytes(chunk), label)
+			output.WriteString(strings.TrimRight(line, " "))
+			output.WriteByte('\n')
+			bounds, label = "", ""
+		}
+	}
+
+	return output.String()
+}
+
+func hexBytes(data []byte) string {
+	parts := make([]string, len(data))
+	for idx, b := range data {
+		parts[idx] = fmt.Sprintf("%02x", b)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// explainer decodes a payload as DecodeValue does,
+// recording the range of every value it reads
+type explainer struct {
+	explanation *Explanation
+	reader      *bytes.Reader
+}
+
+func (e *explainer) offset() int {
+	return len(e.explanation.Payload) - e.reader.Len()
+}
+
+func (e *explainer) add(start int, path, typeName, value string) {
+	e.explanation.Ranges = append(e.explanation.Ranges, ExplainedRange{
+		Start: start,
+		End:   e.offset(),
+		Path:  path,
+		Type:  typeName,
+		Value: value,
+	})
+}
+
+// addRemaining labels the bytes following the last range
+func (e *explainer) addRemaining(label string) {
+	start := 0
+	if ranges := e.explanation.Ranges; len(ranges) > 0 {
+		start = ranges[len(ranges)-1].End
+	}
+
+	if start < len(e.explanation.Payload) {
+		e.explanation.Ranges = append(e.explanation.Ranges, ExplainedRange{
+			Start: start,
+			End:   len(e.explanation.Payload),
+			Type:  label,
+		})
+	}
+}
+
+// fail wraps the error of the value at path starting at start
+func (e *explainer) fail(start int, path string, desc *TypeDesc, err error) error {
+	if path == "" {
+		return fmt.Errorf("decoding %s at offset %d: %w", describeType(desc), start, err)
+	}
+
+	return fmt.Errorf("decoding %s %s at offset %d: %w", path, describeType(desc), start, err)
+}
+
+func (e *explainer) explain(desc *TypeDesc, path string) error {
+	start := e.offset()
+	switch desc.Kind {
+	case PrimitiveDesc, CompactDesc, BitSequenceDesc:
+		value, err := DecodeValue(desc, e.reader)
+		if err != nil {
+			return e.fail(start, path, desc, err)
+		}
+
+		e.add(start, path, describeType(desc), value.String())
+		return nil
+	case CompositeDesc:
+		return e.explainFields(desc.Fields, path)
+	case EnumDesc:
+		tag, err := e.reader.ReadByte()
+		if err != nil {
+			return e.fail(start, path, desc, err)
+		}
+
+		variant := desc.variantIndexed(tag)
+		if variant == nil {
+			return e.fail(start, path, desc, fmt.Errorf("%w: %v", ErrWrongEnumTag, tag))
+		}
+
+		enumName := desc.Name
+		if enumName == "" {
+			enumName = "enum"
+		}
+
+		e.add(start, path, "tag", enumName+"::"+variant.Name)
+		return e.explainFields(variant.Fields, joinExplainPath(path, variant.Name))
+	case SequenceDesc, SetDesc, MapDesc:
+		length, err := decodeCompactLength(e.reader)
+		if err != nil {
+			return e.fail(start, path, desc, err)
+		}
+
+		e.add(start, path, "length", strconv.FormatUint(length, 10))
+		return e.explainItems(desc, length, path)
+	case ArrayDesc:
+		return e.explainItems(desc, uint64(desc.Len), path)
+	default:
+		return fmt.Errorf("unexpected type kind: %d", desc.Kind)
+	}
+}
+
+// explainFields explains the fields of a composite or a variant, a
+// single unnamed field, as in newtypes or Some(T), adds no path segment
+func (e *explainer) explainFields(descs []*FieldDesc, path string) error {
+	for idx, field := range descs {
+		fieldPath := path
+		if len(descs) > 1 || field.Name != "" {
+			fieldPath = joinExplainPath(path, fieldLabel(field.Name, idx))
+		}
+
+		if err := e.explain(field.Type, fieldPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// explainItems explains the items of a sequence, a set, a map or an
+// array, whose bytes are a single range for sequences and arrays of u8
+func (e *explainer) explainItems(desc *TypeDesc, length uint64, path string) error {
+	if desc.isByteString() {
+		if length == 0 {
+			return nil
+		}
+
+		start := e.offset()
+		if err := copyBytes(io.Discard, e.reader, length); err != nil {
+			return e.fail(start, path, desc, err)
+		}
+
+		e.add(start, path, describeType(desc), "")
+		return nil
+	}
+
+	for idx := uint64(0); idx < length; idx++ {
+		if err := e.explain(desc.Elem, fmt.Sprintf("%s[%d]", path, idx)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func joinExplainPath(path, segment string) string {
+	if path == "" {
+		return segment
+	}
+
+	return path + "." + segment
+}
+
+// describeType returns the name of the described type as written in
+// a .scale file, the generic types declared by schemas are only named
+func describeType(desc *TypeDesc) string {
+	switch desc.Kind {
+	case PrimitiveDesc:
+		return desc.Primitive
+	case CompactDesc:
+		return "Compact<" + desc.Primitive + ">"
+	case CompositeDesc, EnumDesc:
+		switch {
+		case desc.isOption():
+			return "Option<" + describeType(desc.Variants[1].Fields[0].Type) + ">"
+		case desc.isResult():
+			return "Result<" + describeType(desc.Variants[0].Fields[0].Type) + ", " +
+				describeType(desc.Variants[1].Fields[0].Type) + ">"
+		case desc.Name != "":
+			return desc.Name
+		}
+
+		items := make([]string, len(desc.Fields))
+		for idx, field := range desc.Fields {
+			items[idx] = describeType(field.Type)
+		}
+		return "(" + strings.Join(items, ", ") + ")"
+	case SequenceDesc:
+		return "Vec<" + describeType(desc.Elem) + ">"
+	case SetDesc:
+		return "BTreeSet<" + describeType(desc.Elem) + ">"
+	case ArrayDesc:
+		return fmt.Sprintf("[%s; %d]", describeType(desc.Elem), desc.Len)
+	case MapDesc:
+		return "BTreeMap<" + describeType(desc.Elem.Fields[0].Type) + ", " +
+			describeType(desc.Elem.Fields[1].Type) + ">"
+	case BitSequenceDesc:
+		return "BitVec"
+	default:
+		return fmt.Sprintf("unexpected type kind: %d", desc.Kind)
+	}
+}
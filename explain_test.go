@@ -0,0 +1,102 @@
+package scale_codec
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	const src = `
+newtype AccountId([u8; 2])
+
+enum Call {
+	Remark(String)
+	Transfer { to: AccountId, amounts: Vec<Compact<u64>> }
+	J(Result<(u64, bool), bool>)
+}
+`
+	schema, err := ParseSchema("calls.scale", strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	desc, err := ParseTypeDesc("Vec<Call>", schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	payload := []byte{
+		8,
+		2, 0, 5, 0, 0, 0, 0, 0, 0, 0, 1,
+		1, 0xaa, 0xbb, 8, 4, 0xfe, 0xff, 0xff, 0xff,
+	}
+
+	explanation, err := Explain(desc, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "" +
+		"[0]       08                       length = 2\n" +
+		"[1]       02                       [0] tag = Call::J\n" +
+		"[2]       00                       [0].J tag = Result::Ok\n" +
+		"[3..11]   05 00 00 00 00 00 00 00  [0].J.Ok.0 u64 = 5\n" +
+		"[11]      01                       [0].J.Ok.1 bool = true\n" +
+		"[12]      01                       [1] tag = Call::Transfer\n" +
+		"[13..15]  aa bb                    [1].Transfer.to [u8; 2]\n" +
+		"[15]      08                       [1].Transfer.amounts length = 2\n" +
+		"[16]      04                       [1].Transfer.amounts[0] Compact<u64> = 1\n" +
+		"[17..21]  fe ff ff ff              [1].Transfer.amounts[1] Compact<u64> = 1073741823\n"
+	if explanation.String() != expected {
+		t.Fatalf("\nexpected:\n%v\ngot:\n%v", expected, explanation)
+	}
+
+	errorCases := []struct {
+		payload       []byte
+		expectedError string
+		expectedLast  string
+		expectedErr   error
+	}{
+		{
+			payload:       []byte{4, 0, 8, 'h'},
+			expectedError: "decoding [0].Remark String at offset 2:",
+			expectedLast:  "[2..4] undecoded",
+			expectedErr:   ErrUnexpectedReadBytes,
+		},
+		{
+			payload:       []byte{4, 3, 0},
+			expectedError: "decoding [0] Call at offset 1:",
+			expectedLast:  "[1..3] undecoded",
+			expectedErr:   ErrWrongEnumTag,
+		},
+		{
+			payload:       []byte{0, 1},
+			expectedError: "1 trailing bytes at offset 1",
+			expectedLast:  "[1] trailing bytes",
+		},
+	}
+
+	for _, tt := range errorCases {
+		explanation, err := Explain(desc, tt.payload)
+		if err == nil || !strings.HasPrefix(err.Error(), tt.expectedError) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedError, err)
+		}
+
+		if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedErr, err)
+		}
+
+		last := explanation.Ranges[len(explanation.Ranges)-1]
+		if last.String() != tt.expectedLast {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedLast, last)
+		}
+	}
+
+	// a 2^64 - 1 length is rejected as DecodeValue rejects it
+	huge := []byte{0x13, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	_, err = Explain(NewSequenceDesc(NewPrimitiveDesc("u8")), huge)
+	if !errors.Is(err, ErrUnexpectedLength) || !strings.HasPrefix(err.Error(), "decoding Vec<u8> at offset 0:") {
+		t.Fatalf("\nexpected: %v at offset 0\ngot: %v", ErrUnexpectedLength, err)
+	}
+}
@@ -0,0 +1,316 @@
+package scale_codec
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+var ErrInvalidJSON = errors.New("invalid json")
+
+// maxSafeJSONInteger is the largest integer a javascript number holds
+// exactly, bigger integers are written as hex strings as polkadot.js does
+var maxSafeJSONInteger = big.NewInt(1<<53 - 1)
+
+// FromJSON decodes the types whose pointer implements json.Unmarshaler,
+// it is the JSON counterpart of IntegerFromRawBytes, BoolFromRawBytes...
+// so FromJSON[Integer[uint32]] can be given to UnmarshalOptionFromJSON
+func FromJSON[T any, PT interface {
+	*T
+	json.Unmarshaler
+}](data []byte) (PT, error) {
+	value := PT(new(T))
+	if err := value.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// decodeJSON is the decoder used by the UnmarshalJSON method of the generic
+// types, it only knows how to allocate T when T is a pointer type
+func decodeJSON[T any](data []byte) (T, error) {
+	var value T
+	err := json.Unmarshal(data, &value)
+	return value, err
+}
+
+func isJSONNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
+
+// MarshalJSONTuple encodes tuples and tuple structs as a JSON array
+func MarshalJSONTuple(values ...any) ([]byte, error) {
+	if values == nil {
+		values = []any{}
+	}
+	return json.Marshal(values)
+}
+
+// UnmarshalJSONTuple splits a JSON array of length items
+func UnmarshalJSONTuple(data []byte, length int) ([]json.RawMessage, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("%w: expected an array: %v", ErrInvalidJSON, err)
+	}
+
+	if len(items) != length {
+		return nil, fmt.Errorf("%w: expected %d items, got %d", ErrInvalidJSON, length, len(items))
+	}
+
+	return items, nil
+}
+
+// MarshalJSONObject encodes structs as a JSON object whose keys
+// are written in the given order, values[i] being keys[i] value
+func MarshalJSONObject(keys []string, values ...any) ([]byte, error) {
+	output := bytes.NewBufferString("{")
+	for idx, key := range keys {
+		if idx > 0 {
+			output.WriteByte(',')
+		}
+
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+
+		encodedValue, err := json.Marshal(values[idx])
+		if err != nil {
+			return nil, fmt.Errorf("encoding %s: %w", key, err)
+		}
+
+		output.Write(encodedKey)
+		output.WriteByte(':')
+		output.Write(encodedValue)
+	}
+	output.WriteByte('}')
+
+	return output.Bytes(), nil
+}
+
+// UnmarshalJSONObject returns the values of the keys of a JSON object in
+// the order of keys, missing and unknown keys are reported as errors
+func UnmarshalJSONObject(data []byte, keys []string) ([]json.RawMessage, error) {
+	entries, err := unmarshalJSONEntries(data)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make(map[string]int, len(keys))
+	for idx, key := range keys {
+		positions[key] = idx
+	}
+
+	values := make([]json.RawMessage, len(keys))
+	for _, entry := range entries {
+		idx, ok := positions[entry.key]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidJSON, entry.key)
+		}
+		values[idx] = entry.value
+	}
+
+	for idx, value := range values {
+		if value == nil {
+			return nil, fmt.Errorf("%w: missing field %q", ErrInvalidJSON, keys[idx])
+		}
+	}
+
+	return values, nil
+}
+
+// MarshalJSONVariant encodes an enum variant as `{"Name": value}`
+func MarshalJSONVariant(name string, value any) ([]byte, error) {
+	return MarshalJSONObject([]string{name}, value)
+}
+
+// UnmarshalJSONVariant returns the name and value of an enum variant, both
+// `{"Name": value}` and the `"Name"` string of unit variants are accepted
+func UnmarshalJSONVariant(data []byte) (string, json.RawMessage, error) {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		return name, json.RawMessage("null"), nil
+	}
+
+	entries, err := unmarshalJSONEntries(data)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(entries) != 1 {
+		return "", nil, fmt.Errorf("%w: expected an object with a single variant, got %d keys",
+			ErrInvalidJSON, len(entries))
+	}
+
+	return entries[0].key, entries[0].value, nil
+}
+
+type jsonEntry struct {
+	key   string
+	value json.RawMessage
+}
+
+// unmarshalJSONEntries splits a JSON object keeping the order of its keys
+func unmarshalJSONEntries(data []byte) ([]jsonEntry, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+	}
+
+	if token != json.Delim('{') {
+		return nil, fmt.Errorf("%w: expected an object", ErrInvalidJSON)
+	}
+
+	entries := make([]jsonEntry, 0)
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+		}
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+		}
+
+		entries = append(entries, jsonEntry{key: token.(string), value: value})
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+	}
+
+	return entries, nil
+}
+
+// marshalJSONInteger writes integers as JSON numbers while they fit in a
+// javascript number and as size bytes big endian hex strings otherwise
+func marshalJSONInteger(value *big.Int, size int) ([]byte, error) {
+	if new(big.Int).Abs(value).Cmp(maxSafeJSONInteger) <= 0 {
+		return []byte(value.String()), nil
+	}
+
+	encoded := new(big.Int).Set(value)
+	if encoded.Sign() < 0 {
+		encoded.Add(encoded, new(big.Int).Lsh(big.NewInt(1), uint(size*8)))
+	}
+
+	return json.Marshal("0x" + hex.EncodeToString(encoded.FillBytes(make([]byte, size))))
+}
+
+// unmarshalJSONInteger accepts JSON numbers, decimal strings and hex strings,
+// the hex strings of signed integers are read in two's complement
+func unmarshalJSONInteger(data []byte, size int, signed bool) (*big.Int, error) {
+	text := string(bytes.TrimSpace(data))
+	if strings.HasPrefix(text, `"`) {
+		if err := json.Unmarshal(data, &text); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+		}
+	}
+
+	bits := uint(size * 8)
+	value, ok := new(big.Int), false
+	if digits, isHex := strings.CutPrefix(text, "0x"); isHex {
+		value, ok = value.SetString(digits, 16)
+		if ok && len(digits) > size*2 {
+			return nil, fmt.Errorf("%w: %s does not fit in %d bits", ErrUnexpectedInteger, text, bits)
+		}
+
+		if ok && signed && value.Bit(int(bits)-1) == 1 {
+			value.Sub(value, new(big.Int).Lsh(big.NewInt(1), bits))
+		}
+	} else {
+		value, ok = value.SetString(text, 10)
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w: expected an integer, got %s", ErrInvalidJSON, data)
+	}
+
+	if !integerFits(value, bits, signed) {
+		return nil, fmt.Errorf("%w: %s does not fit in %d bits", ErrUnexpectedInteger, text, bits)
+	}
+
+	return value, nil
+}
+
+// integerFits reports whether value is in the range of the integers of
+// bits bits, signed integers being stored in two's complement
+func integerFits(value *big.Int, bits uint, signed bool) bool {
+	min, max := big.NewInt(0), new(big.Int).Lsh(big.NewInt(1), bits)
+	if signed {
+		max.Rsh(max, 1)
+		min.Neg(max)
+	}
+
+	return value.Cmp(min) >= 0 && value.Cmp(max) < 0
+}
+
+func isSigned[T constraints.Integer]() bool {
+	var zero T
+	return zero-1 < zero
+}
+
+func marshalJSONHex(value []byte) ([]byte, error) {
+	return json.Marshal("0x" + hex.EncodeToString(value))
+}
+
+func unmarshalJSONHex(data []byte) ([]byte, error) {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return nil, fmt.Errorf("%w: expected a hex string: %v", ErrInvalidJSON, err)
+	}
+
+	digits, ok := strings.CutPrefix(text, "0x")
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a 0x prefixed hex string, got %q", ErrInvalidJSON, text)
+	}
+
+	value, err := hex.DecodeString(digits)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+	}
+
+	return value, nil
+}
+
+// DebugTuple formats tuples, tuple structs and variants as Rust
+// debug does, `Name(a, b)`, or `(a, b)` when name is empty
+func DebugTuple(name string, values ...any) string {
+	items := make([]string, len(values))
+	for idx, value := range values {
+		items[idx] = fmt.Sprint(value)
+	}
+
+	return name + "(" + strings.Join(items, ", ") + ")"
+}
+
+// DebugStruct formats structs as Rust debug does, `Name { a: 1, b: 2 }`
+func DebugStruct(name string, fields []string, values ...any) string {
+	if len(fields) == 0 {
+		return name
+	}
+
+	items := make([]string, len(fields))
+	for idx, field := range fields {
+		items[idx] = field + ": " + fmt.Sprint(values[idx])
+	}
+
+	return name + " { " + strings.Join(items, ", ") + " }"
+}
+
+func debugList[T any](items []T) string {
+	formatted := make([]string, len(items))
+	for idx, item := range items {
+		formatted[idx] = fmt.Sprint(item)
+	}
+
+	return "[" + strings.Join(formatted, ", ") + "]"
+}
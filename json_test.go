@@ -0,0 +1,292 @@
+package scale_codec_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+func TestJSON(t *testing.T) {
+	type u32 = scale_codec.Integer[uint32]
+	bigU128, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+
+	cases := []struct {
+		value          any
+		expectedJSON   string
+		expectedString string
+		decode         func([]byte) (any, error)
+	}{
+		{
+			value:          &scale_codec.Integer[uint8]{Value: 255},
+			expectedJSON:   `255`,
+			expectedString: "255",
+			decode:         decodeWith(scale_codec.FromJSON[scale_codec.Integer[uint8]]),
+		},
+		{
+			value:          &scale_codec.Integer[int16]{Value: -300},
+			expectedJSON:   `-300`,
+			expectedString: "-300",
+			decode:         decodeWith(scale_codec.FromJSON[scale_codec.Integer[int16]]),
+		},
+		{
+			value:          &scale_codec.Integer[uint64]{Value: 1 << 60},
+			expectedJSON:   `"0x1000000000000000"`,
+			expectedString: "1152921504606846976",
+			decode:         decodeWith(scale_codec.FromJSON[scale_codec.Integer[uint64]]),
+		},
+		{
+			value:          &scale_codec.Integer[int64]{Value: -1 << 60},
+			expectedJSON:   `"0xf000000000000000"`,
+			expectedString: "-1152921504606846976",
+			decode:         decodeWith(scale_codec.FromJSON[scale_codec.Integer[int64]]),
+		},
+		{
+			value:          scale_codec.U128FromBigInt(bigU128),
+			expectedJSON:   `"0xffffffffffffffffffffffffffffffff"`,
+			expectedString: "340282366920938463463374607431768211455",
+			decode:         decodeWith(scale_codec.FromJSON[scale_codec.U128]),
+		},
+		{
+			value:          scale_codec.I128FromBigInt(big.NewInt(-5)),
+			expectedJSON:   `-5`,
+			expectedString: "-5",
+			decode:         decodeWith(scale_codec.FromJSON[scale_codec.I128]),
+		},
+		{
+			value:          &scale_codec.Compact{Value: &scale_codec.CompactInteger[uint8]{Value: 42}},
+			expectedJSON:   `42`,
+			expectedString: "42",
+			decode:         decodeWith(scale_codec.FromJSON[scale_codec.Compact]),
+		},
+		{
+			value:          &scale_codec.Bool{Value: true},
+			expectedJSON:   `true`,
+			expectedString: "true",
+			decode:         decodeWith(scale_codec.FromJSON[scale_codec.Bool]),
+		},
+		{
+			value:          &scale_codec.String{Value: "hello"},
+			expectedJSON:   `"hello"`,
+			expectedString: `"hello"`,
+			decode:         decodeWith(scale_codec.FromJSON[scale_codec.String]),
+		},
+		{
+			value:          &scale_codec.Bytes{Value: []byte{0xde, 0xad}},
+			expectedJSON:   `"0xdead"`,
+			expectedString: "0xdead",
+			decode:         decodeWith(scale_codec.FromJSON[scale_codec.Bytes]),
+		},
+		{
+			value:          &scale_codec.ByteArray{Value: []byte{1, 2}},
+			expectedJSON:   `"0x0102"`,
+			expectedString: "0x0102",
+			decode:         decodeWith(scale_codec.UnmarshalByteArrayFromJSON(2)),
+		},
+		{
+			value:          &scale_codec.Unit{},
+			expectedJSON:   `null`,
+			expectedString: "()",
+			decode:         decodeWith(scale_codec.FromJSON[scale_codec.Unit]),
+		},
+		{
+			value:          scale_codec.SomeG[*u32](&u32{Value: 7}),
+			expectedJSON:   `7`,
+			expectedString: "Some(7)",
+			decode:         decodeWith(scale_codec.UnmarshalOptionFromJSON(scale_codec.FromJSON[u32])),
+		},
+		{
+			value:          scale_codec.NoneG[*u32](),
+			expectedJSON:   `null`,
+			expectedString: "None",
+			decode:         decodeWith(scale_codec.UnmarshalOptionFromJSON(scale_codec.FromJSON[u32])),
+		},
+		{
+			value:          scale_codec.OkG[*u32, *scale_codec.String](&u32{Value: 5}),
+			expectedJSON:   `{"ok":5}`,
+			expectedString: "Ok(5)",
+			decode: decodeWith(scale_codec.UnmarshalResultFromJSON(
+				scale_codec.FromJSON[u32], scale_codec.FromJSON[scale_codec.String])),
+		},
+		{
+			value:          scale_codec.ErrG[*u32, *scale_codec.String](&scale_codec.String{Value: "fail"}),
+			expectedJSON:   `{"err":"fail"}`,
+			expectedString: `Err("fail")`,
+			decode: decodeWith(scale_codec.UnmarshalResultFromJSON(
+				scale_codec.FromJSON[u32], scale_codec.FromJSON[scale_codec.String])),
+		},
+		{
+			value:          &scale_codec.VecG[*u32]{Items: []*u32{{Value: 1}, {Value: 2}}},
+			expectedJSON:   `[1,2]`,
+			expectedString: "[1, 2]",
+			decode:         decodeWith(scale_codec.UnmarshalVecFromJSON(scale_codec.FromJSON[u32])),
+		},
+		{
+			value:          &scale_codec.VecG[*u32]{Items: []*u32{}},
+			expectedJSON:   `[]`,
+			expectedString: "[]",
+			decode:         decodeWith(scale_codec.UnmarshalVecFromJSON(scale_codec.FromJSON[u32])),
+		},
+		{
+			value:          &scale_codec.ArrayG[*scale_codec.Bool]{Items: []*scale_codec.Bool{{Value: true}}},
+			expectedJSON:   `[true]`,
+			expectedString: "[true]",
+			decode: decodeWith(scale_codec.UnmarshalArrayFromJSON(1,
+				scale_codec.FromJSON[scale_codec.Bool])),
+		},
+		{
+			value: &scale_codec.MapG[*u32, *scale_codec.String]{
+				Entries: []scale_codec.MapEntry[*u32, *scale_codec.String]{
+					{Key: &u32{Value: 2}, Value: &scale_codec.String{Value: "b"}},
+					{Key: &u32{Value: 1}, Value: &scale_codec.String{Value: "a"}},
+				},
+			},
+			expectedJSON:   `{"2":"b","1":"a"}`,
+			expectedString: `{2: "b", 1: "a"}`,
+			decode: decodeWith(scale_codec.UnmarshalMapFromJSON(
+				scale_codec.FromJSON[u32], scale_codec.FromJSON[scale_codec.String])),
+		},
+	}
+
+	for _, tt := range cases {
+		output, err := json.Marshal(tt.value)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if string(output) != tt.expectedJSON {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedJSON, string(output))
+		}
+
+		if str := fmt.Sprint(tt.value); str != tt.expectedString {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedString, str)
+		}
+
+		decoded, err := tt.decode(output)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(tt.value, decoded) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.value, decoded)
+		}
+	}
+}
+
+func TestUnmarshalJSONMethods(t *testing.T) {
+	option := new(scale_codec.OptionG[*scale_codec.Integer[uint16]])
+	if err := json.Unmarshal([]byte(`"0x0102"`), option); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := scale_codec.SomeG(&scale_codec.Integer[uint16]{Value: 258})
+	if !reflect.DeepEqual(expected, option) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expected, option)
+	}
+
+	integer := new(scale_codec.Integer[uint16])
+	if err := json.Unmarshal([]byte(`"1000"`), integer); err != nil || integer.Value != 1000 {
+		t.Fatalf("expected 1000 from a decimal string, got %v (%v)", integer, err)
+	}
+}
+
+func TestJSONErrors(t *testing.T) {
+	cases := []struct {
+		input       string
+		decode      func([]byte) (any, error)
+		expectedErr error
+	}{
+		{
+			input:       `256`,
+			decode:      decodeWith(scale_codec.FromJSON[scale_codec.Integer[uint8]]),
+			expectedErr: scale_codec.ErrUnexpectedInteger,
+		},
+		{
+			input:       `-1`,
+			decode:      decodeWith(scale_codec.FromJSON[scale_codec.Integer[uint32]]),
+			expectedErr: scale_codec.ErrUnexpectedInteger,
+		},
+		{
+			input:       `1.5`,
+			decode:      decodeWith(scale_codec.FromJSON[scale_codec.Integer[uint32]]),
+			expectedErr: scale_codec.ErrInvalidJSON,
+		},
+		{
+			input:       `"dead"`,
+			decode:      decodeWith(scale_codec.FromJSON[scale_codec.Bytes]),
+			expectedErr: scale_codec.ErrInvalidJSON,
+		},
+		{
+			input:       `"0x010203"`,
+			decode:      decodeWith(scale_codec.UnmarshalByteArrayFromJSON(2)),
+			expectedErr: scale_codec.ErrUnexpectedLength,
+		},
+		{
+			input: `[1, 2]`,
+			decode: decodeWith(scale_codec.UnmarshalArrayFromJSON(3,
+				scale_codec.FromJSON[scale_codec.Integer[uint8]])),
+			expectedErr: scale_codec.ErrUnexpectedLength,
+		},
+		{
+			input: `{"maybe": 1}`,
+			decode: decodeWith(scale_codec.UnmarshalResultFromJSON(
+				scale_codec.FromJSON[scale_codec.Integer[uint8]], scale_codec.FromJSON[scale_codec.Bool])),
+			expectedErr: scale_codec.ErrUnexpectedResultTag,
+		},
+	}
+
+	for _, tt := range cases {
+		_, err := tt.decode([]byte(tt.input))
+		if !errors.Is(err, tt.expectedErr) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedErr, err)
+		}
+	}
+}
+
+func TestJSONObjects(t *testing.T) {
+	output, err := scale_codec.MarshalJSONObject([]string{"b", "a"},
+		&scale_codec.Bool{Value: true}, &scale_codec.Integer[uint8]{Value: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(output) != `{"b":true,"a":1}` {
+		t.Fatalf("\nexpected: %v\ngot: %v", `{"b":true,"a":1}`, string(output))
+	}
+
+	values, err := scale_codec.UnmarshalJSONObject([]byte(`{"a": 1, "b": true}`), []string{"b", "a"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(values[0]) != "true" || string(values[1]) != "1" {
+		t.Fatalf("unexpected values: %s", values)
+	}
+
+	for _, input := range []string{`{"a": 1}`, `{"a": 1, "b": true, "c": 2}`, `[1, true]`} {
+		if _, err := scale_codec.UnmarshalJSONObject([]byte(input), []string{"b", "a"}); !errors.Is(err, scale_codec.ErrInvalidJSON) {
+			t.Fatalf("\nexpected: %v\ngot: %v", scale_codec.ErrInvalidJSON, err)
+		}
+	}
+
+	for input, expected := range map[string]string{`"Remark"`: "Remark", `{"Transfer": 10}`: "Transfer"} {
+		name, _, err := scale_codec.UnmarshalJSONVariant([]byte(input))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if name != expected {
+			t.Fatalf("\nexpected: %v\ngot: %v", expected, name)
+		}
+	}
+}
+
+func decodeWith[T any](f func([]byte) (T, error)) func([]byte) (any, error) {
+	return func(data []byte) (any, error) {
+		return f(data)
+	}
+}
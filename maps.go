@@ -0,0 +1,176 @@
+package scale_codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type MapEntry[K Marshaler, V Marshaler] struct {
+	Key   K
+	Value V
+}
+
+// MapG is encoded as BTreeMap, a sequence of key and value pairs
+// prefixed by its compact encoded length. The entries are encoded
+// sorted by key, as the Rust Ord of the key type orders them, and
+// are sorted once decoded, a duplicated key keeping its last value
+type MapG[K Marshaler, V Marshaler] struct {
+	Entries []MapEntry[K, V]
+}
+
+func UnmarshalMapFromRawBytes[K Marshaler, V Marshaler](
+	keyF func(io.Reader) (K, error),
+	valueF func(io.Reader) (V, error)) func(reader io.Reader) (*MapG[K, V], error) {
+	return func(reader io.Reader) (*MapG[K, V], error) {
+		m := &MapG[K, V]{}
+		err := m.UnmarshalSCALE(reader, keyF, valueF)
+		if err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+}
+
+func (m MapG[K, V]) MarshalSCALE() ([]byte, error) {
+	entries := m.sortedEntries()
+	if len(entries) != len(m.Entries) {
+		return nil, fmt.Errorf("%w: duplicate key", ErrUnexpectedValue)
+	}
+
+	output, err := encodeCompactLength(len(entries))
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, entry := range entries {
+		encodedKey, err := entry.Key.MarshalSCALE()
+		if err != nil {
+			return nil, fmt.Errorf("encoding key at index %v: %w", idx, err)
+		}
+
+		encodedValue, err := entry.Value.MarshalSCALE()
+		if err != nil {
+			return nil, fmt.Errorf("encoding value at index %v: %w", idx, err)
+		}
+
+		output = append(output, encodedKey...)
+		output = append(output, encodedValue...)
+	}
+
+	return output, nil
+}
+
+func (m *MapG[K, V]) UnmarshalSCALE(reader io.Reader,
+	keyF func(io.Reader) (K, error), valueF func(io.Reader) (V, error)) error {
+	length, err := decodeCompactLength(reader)
+	if err != nil {
+		return err
+	}
+
+	m.Entries = make([]MapEntry[K, V], 0)
+	for idx := uint64(0); idx < length; idx++ {
+		key, err := keyF(reader)
+		if err != nil {
+			return fmt.Errorf("decoding key at index %v: %w", idx, err)
+		}
+
+		value, err := valueF(reader)
+		if err != nil {
+			return fmt.Errorf("decoding value at index %v: %w", idx, err)
+		}
+
+		m.Entries = append(m.Entries, MapEntry[K, V]{Key: key, Value: value})
+	}
+
+	m.Entries = m.sortedEntries()
+	return nil
+}
+
+// sortedEntries returns the entries sorted by key without duplicated keys
+func (m MapG[K, V]) sortedEntries() []MapEntry[K, V] {
+	return sortUnique(m.Entries, func(entry MapEntry[K, V]) any { return entry.Key })
+}
+
+func UnmarshalMapFromJSON[K Marshaler, V Marshaler](
+	keyF func([]byte) (K, error),
+	valueF func([]byte) (V, error)) func(data []byte) (*MapG[K, V], error) {
+	return func(data []byte) (*MapG[K, V], error) {
+		m := &MapG[K, V]{}
+		err := m.UnmarshalJSONWith(data, keyF, valueF)
+		if err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+}
+
+func (m MapG[K, V]) String() string {
+	entries := make([]string, len(m.Entries))
+	for idx, entry := range m.Entries {
+		entries[idx] = fmt.Sprint(entry.Key) + ": " + fmt.Sprint(entry.Value)
+	}
+
+	return "{" + strings.Join(entries, ", ") + "}"
+}
+
+// MarshalJSON writes the map as an object in the order of its entries, keys
+// encoded as JSON strings are used as is and other keys by their JSON text
+func (m MapG[K, V]) MarshalJSON() ([]byte, error) {
+	keys := make([]string, len(m.Entries))
+	values := make([]any, len(m.Entries))
+	for idx, entry := range m.Entries {
+		encodedKey, err := json.Marshal(entry.Key)
+		if err != nil {
+			return nil, fmt.Errorf("encoding key at index %v: %w", idx, err)
+		}
+
+		if err := json.Unmarshal(encodedKey, &keys[idx]); err != nil {
+			keys[idx] = string(encodedKey)
+		}
+		values[idx] = entry.Value
+	}
+
+	return MarshalJSONObject(keys, values...)
+}
+
+func (m *MapG[K, V]) UnmarshalJSON(data []byte) error {
+	return m.UnmarshalJSONWith(data, decodeJSON[K], decodeJSON[V])
+}
+
+// UnmarshalJSONWith keeps the entries in the order of the object keys, a key
+// is decoded as a JSON string first and as JSON text when that fails
+func (m *MapG[K, V]) UnmarshalJSONWith(data []byte,
+	keyF func([]byte) (K, error), valueF func([]byte) (V, error)) error {
+	entries, err := unmarshalJSONEntries(data)
+	if err != nil {
+		return err
+	}
+
+	m.Entries = make([]MapEntry[K, V], 0, len(entries))
+	for idx, entry := range entries {
+		quotedKey, err := json.Marshal(entry.key)
+		if err != nil {
+			return err
+		}
+
+		key, err := keyF(quotedKey)
+		if err != nil && json.Valid([]byte(entry.key)) {
+			key, err = keyF([]byte(entry.key))
+		}
+
+		if err != nil {
+			return fmt.Errorf("decoding key at index %v: %w", idx, err)
+		}
+
+		value, err := valueF(entry.value)
+		if err != nil {
+			return fmt.Errorf("decoding value at index %v: %w", idx, err)
+		}
+
+		m.Entries = append(m.Entries, MapEntry[K, V]{Key: key, Value: value})
+	}
+
+	return nil
+}
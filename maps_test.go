@@ -0,0 +1,85 @@
+package scale_codec_test
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+func TestMap(t *testing.T) {
+	type entry = scale_codec.MapEntry[*scale_codec.Integer[uint8], *scale_codec.Bool]
+
+	m := scale_codec.MapG[*scale_codec.Integer[uint8], *scale_codec.Bool]{
+		Entries: []entry{
+			{Key: &scale_codec.Integer[uint8]{Value: 1}, Value: &scale_codec.Bool{Value: true}},
+			{Key: &scale_codec.Integer[uint8]{Value: 2}, Value: &scale_codec.Bool{Value: false}},
+		},
+	}
+
+	expected := []byte{8, 1, 1, 2, 0}
+	output, err := m.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(expected, output) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expected, output)
+	}
+
+	unmarshal := scale_codec.UnmarshalMapFromRawBytes(
+		scale_codec.IntegerFromRawBytes[uint8], scale_codec.BoolFromRawBytes)
+	decoded, err := unmarshal(bytes.NewReader(expected))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(&m, decoded) {
+		t.Fatalf("\nexpected: %v\ngot: %v", m, decoded)
+	}
+}
+
+func TestMapSortsEntriesByKey(t *testing.T) {
+	type entry = scale_codec.MapEntry[*scale_codec.Integer[uint16], *scale_codec.Bool]
+
+	m := scale_codec.MapG[*scale_codec.Integer[uint16], *scale_codec.Bool]{
+		Entries: []entry{
+			{Key: &scale_codec.Integer[uint16]{Value: 256}, Value: &scale_codec.Bool{Value: false}},
+			{Key: &scale_codec.Integer[uint16]{Value: 1}, Value: &scale_codec.Bool{Value: true}},
+		},
+	}
+
+	expected := []byte{8, 1, 0, 1, 0, 1, 0}
+	output, err := m.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(expected, output) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expected, output)
+	}
+
+	m.Entries = append(m.Entries, entry{Key: &scale_codec.Integer[uint16]{Value: 1}, Value: &scale_codec.Bool{}})
+	_, err = m.MarshalSCALE()
+	if !errors.Is(err, scale_codec.ErrUnexpectedValue) {
+		t.Fatalf("expected %v, got %v", scale_codec.ErrUnexpectedValue, err)
+	}
+
+	// decoding keeps the last value of a duplicated key, as collecting a BTreeMap does
+	unmarshal := scale_codec.UnmarshalMapFromRawBytes(
+		scale_codec.IntegerFromRawBytes[uint16], scale_codec.BoolFromRawBytes)
+	decoded, err := unmarshal(bytes.NewReader([]byte{12, 2, 0, 1, 1, 0, 1, 1, 0, 0}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedEntries := []entry{
+		{Key: &scale_codec.Integer[uint16]{Value: 1}, Value: &scale_codec.Bool{Value: false}},
+		{Key: &scale_codec.Integer[uint16]{Value: 2}, Value: &scale_codec.Bool{Value: true}},
+	}
+	if !reflect.DeepEqual(expectedEntries, decoded.Entries) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expectedEntries, decoded.Entries)
+	}
+}
@@ -3,10 +3,10 @@ package scale_codec
 import (
 	"encoding/binary"
 	"errors"
-	"fmt"
 	"io"
 	"math/big"
 	"math/bits"
+	"strconv"
 	"unsafe"
 
 	"golang.org/x/exp/constraints"
@@ -41,15 +41,10 @@ func (i *Integer[T]) UnmarshalSCALE(reader io.Reader) error {
 	sizeof := unsafe.Sizeof(T(0))
 	enc := make([]byte, sizeof)
 
-	n, err := reader.Read(enc)
-	if err != nil {
+	if err := readFull(reader, enc); err != nil {
 		return err
 	}
 
-	if n != int(sizeof) {
-		return fmt.Errorf("%w: want: %v, got: %v", ErrUnexpectedReadBytes, sizeof, n)
-	}
-
 	acc := T(enc[0])
 	for i := 1; i < int(sizeof); i++ {
 		acc |= T(enc[i]) << (i * 8)
@@ -59,6 +54,38 @@ func (i *Integer[T]) UnmarshalSCALE(reader io.Reader) error {
 	return nil
 }
 
+func (in Integer[T]) String() string {
+	if isSigned[T]() {
+		return strconv.FormatInt(int64(in.Value), 10)
+	}
+	return strconv.FormatUint(uint64(in.Value), 10)
+}
+
+func (in Integer[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONInteger(in.toBigInt(), int(unsafe.Sizeof(in.Value)))
+}
+
+func (i *Integer[T]) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalJSONInteger(data, int(unsafe.Sizeof(i.Value)), isSigned[T]())
+	if err != nil {
+		return err
+	}
+
+	if isSigned[T]() {
+		i.Value = T(value.Int64())
+	} else {
+		i.Value = T(value.Uint64())
+	}
+	return nil
+}
+
+func (in Integer[T]) toBigInt() *big.Int {
+	if isSigned[T]() {
+		return big.NewInt(int64(in.Value))
+	}
+	return new(big.Int).SetUint64(uint64(in.Value))
+}
+
 var MaxU128 = U128{
 	lower: ^uint64(0),
 	upper: ^uint64(0),
@@ -69,6 +96,15 @@ type U128 struct {
 	lower uint64
 }
 
+func U128FromRawBytes(reader io.Reader) (*U128, error) {
+	scaleU128 := new(U128)
+	if err := scaleU128.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+
+	return scaleU128, nil
+}
+
 func U128FromUpperLower(u, l uint64) *U128 {
 	return &U128{upper: u, lower: l}
 }
@@ -102,8 +138,7 @@ func (u U128) MarshalSCALE() ([]byte, error) {
 
 func (u *U128) UnmarshalSCALE(reader io.Reader) error {
 	encoded := make([]byte, 16)
-	_, err := reader.Read(encoded)
-	if err != nil {
+	if err := readFull(reader, encoded); err != nil {
 		return err
 	}
 
@@ -139,3 +174,89 @@ func (u *U128) AsUint8() uint8 {
 func (u *U128) IsZero() bool {
 	return u.upper == 0 && u.lower == 0
 }
+
+func (u U128) String() string {
+	return u.ToBigInt().String()
+}
+
+func (u U128) MarshalJSON() ([]byte, error) {
+	return marshalJSONInteger(u.ToBigInt(), 16)
+}
+
+func (u *U128) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalJSONInteger(data, 16, false)
+	if err != nil {
+		return err
+	}
+
+	*u = *U128FromBigInt(value)
+	return nil
+}
+
+// I128 is a signed 128 bits integer stored in two's complement
+type I128 struct {
+	upper uint64
+	lower uint64
+}
+
+func I128FromRawBytes(reader io.Reader) (*I128, error) {
+	scaleI128 := new(I128)
+	if err := scaleI128.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+
+	return scaleI128, nil
+}
+
+// I128FromBigInt wraps b into 128 bits, values out of
+// range keep only their 128 least significant bits
+func I128FromBigInt(b *big.Int) *I128 {
+	value := new(big.Int).And(b, MaxU128.ToBigInt())
+	mask := new(big.Int).SetUint64(^uint64(0))
+	return &I128{
+		lower: new(big.Int).And(value, mask).Uint64(),
+		upper: new(big.Int).Rsh(value, 64).Uint64(),
+	}
+}
+
+func (i I128) MarshalSCALE() ([]byte, error) {
+	return U128{upper: i.upper, lower: i.lower}.MarshalSCALE()
+}
+
+func (i *I128) UnmarshalSCALE(reader io.Reader) error {
+	encoded := make([]byte, 16)
+	if err := readFull(reader, encoded); err != nil {
+		return err
+	}
+
+	i.lower = binary.LittleEndian.Uint64(encoded[:8])
+	i.upper = binary.LittleEndian.Uint64(encoded[8:])
+	return nil
+}
+
+func (i *I128) ToBigInt() *big.Int {
+	bigint := (&U128{upper: i.upper, lower: i.lower}).ToBigInt()
+	if i.upper>>63 == 1 {
+		bigint.Sub(bigint, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+
+	return bigint
+}
+
+func (i I128) String() string {
+	return i.ToBigInt().String()
+}
+
+func (i I128) MarshalJSON() ([]byte, error) {
+	return marshalJSONInteger(i.ToBigInt(), 16)
+}
+
+func (i *I128) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalJSONInteger(data, 16, true)
+	if err != nil {
+		return err
+	}
+
+	*i = *I128FromBigInt(value)
+	return nil
+}
@@ -231,3 +231,52 @@ func TestU128Unmarshaler(t *testing.T) {
 		reflect.DeepEqual(expected, actual.ToBigInt())
 	}
 }
+
+func TestI128(t *testing.T) {
+	cases := []struct {
+		bignumber string
+		encoded   []byte
+	}{
+		{
+			bignumber: "-1",
+			encoded:   []byte{255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+		},
+		{
+			bignumber: "-170141183460469231731687303715884105728",
+			encoded:   []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128},
+		},
+		{
+			bignumber: "170141183460469231731687303715884105727",
+			encoded:   []byte{255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 127},
+		},
+		{
+			bignumber: "18446744073709551616",
+			encoded:   []byte{0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+		},
+	}
+
+	for _, tt := range cases {
+		value, ok := new(big.Int).SetString(tt.bignumber, 10)
+		if !ok {
+			t.Fatalf("failed to convert %s to big int", tt.bignumber)
+		}
+
+		output, err := scale_codec.I128FromBigInt(value).MarshalSCALE()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !bytes.Equal(tt.encoded, output) {
+			t.Fatalf("\nexpected: %v\ngot: %v\n", tt.encoded, output)
+		}
+
+		decoded, err := scale_codec.I128FromRawBytes(bytes.NewReader(tt.encoded))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if decoded.ToBigInt().Cmp(value) != 0 {
+			t.Fatalf("\nexpected: %v\ngot: %v\n", value, decoded.ToBigInt())
+		}
+	}
+}
@@ -2,6 +2,7 @@ package scale_codec
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 )
@@ -41,15 +42,11 @@ func (o *OptionG[T]) MarshalSCALE() ([]byte, error) {
 
 func (o *OptionG[T]) UnmarshalSCALE(reader io.Reader, f func(io.Reader) (T, error)) error {
 	encodedOptionTag := make([]byte, 1)
-	n, err := reader.Read(encodedOptionTag)
+	err := readFull(reader, encodedOptionTag)
 	if err != nil {
 		return err
 	}
 
-	if n != 1 {
-		return fmt.Errorf("%w: want: 1, got: %v", ErrUnexpectedReadBytes, n)
-	}
-
 	switch encodedOptionTag[0] {
 	case 0x00:
 		o.isNone = true
@@ -78,6 +75,68 @@ func NoneG[T Marshaler]() *OptionG[T] {
 	}
 }
 
+// Unwrap returns the inner value and true, or the zero value and false
+// when the option is None
+func (o *OptionG[T]) Unwrap() (T, bool) {
+	if o == nil || o.isNone {
+		return *new(T), false
+	}
+	return o.inner, true
+}
+
+func (o *OptionG[T]) variantOrder() (int, []any) {
+	if o.isNone {
+		return 0, nil
+	}
+	return 1, []any{o.inner}
+}
+
+func UnmarshalOptionFromJSON[T Marshaler](
+	f func([]byte) (T, error)) func(data []byte) (*OptionG[T], error) {
+	return func(data []byte) (*OptionG[T], error) {
+		option := &OptionG[T]{}
+		err := option.UnmarshalJSONWith(data, f)
+		if err != nil {
+			return nil, err
+		}
+		return option, nil
+	}
+}
+
+func (o OptionG[T]) String() string {
+	if o.isNone {
+		return "None"
+	}
+	return DebugTuple("Some", o.inner)
+}
+
+// MarshalJSON writes None as null and Some as its inner value
+func (o OptionG[T]) MarshalJSON() ([]byte, error) {
+	if o.isNone {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.inner)
+}
+
+func (o *OptionG[T]) UnmarshalJSON(data []byte) error {
+	return o.UnmarshalJSONWith(data, decodeJSON[T])
+}
+
+func (o *OptionG[T]) UnmarshalJSONWith(data []byte, f func([]byte) (T, error)) error {
+	if isJSONNull(data) {
+		*o = *NoneG[T]()
+		return nil
+	}
+
+	inner, err := f(data)
+	if err != nil {
+		return err
+	}
+
+	*o = *SomeG[T](inner)
+	return nil
+}
+
 type Option struct {
 	inner  Encodable
 	isNone bool
@@ -115,15 +174,11 @@ func (o Option) MarshalSCALE() ([]byte, error) {
 
 func (o *Option) UnmarshalSCALE(reader io.Reader) error {
 	encodedOptionTag := make([]byte, 1)
-	n, err := reader.Read(encodedOptionTag)
+	err := readFull(reader, encodedOptionTag)
 	if err != nil {
 		return err
 	}
 
-	if n != 1 {
-		return fmt.Errorf("%w: want: 1, got: %v", ErrUnexpectedReadBytes, n)
-	}
-
 	switch encodedOptionTag[0] {
 	case 0x00:
 		o.inner = nil
@@ -137,6 +192,13 @@ func (o *Option) UnmarshalSCALE(reader io.Reader) error {
 	}
 }
 
+func (o *Option) variantOrder() (int, []any) {
+	if o.isNone {
+		return 0, nil
+	}
+	return 1, []any{o.inner}
+}
+
 func (o *Option) IsNone() bool {
 	return o.isNone
 }
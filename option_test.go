@@ -158,3 +158,17 @@ func TestUnmarshalOptionGeneric(t *testing.T) {
 		t.Fatalf("\nexpected: %v\nactual: %v\n", expected, unmarshaler)
 	}
 }
+
+func TestOptionUnwrap(t *testing.T) {
+	inner, ok := scale_codec.SomeG(&scale_codec.Bool{Value: true}).Unwrap()
+	if !ok || !inner.Value {
+		t.Fatalf("expected Some(true), got %v, %v", inner, ok)
+	}
+
+	var nilOption *scale_codec.OptionG[*scale_codec.Bool]
+	for _, option := range []*scale_codec.OptionG[*scale_codec.Bool]{scale_codec.NoneG[*scale_codec.Bool](), nilOption} {
+		if inner, ok := option.Unwrap(); ok || inner != nil {
+			t.Fatalf("expected None, got %v, %v", inner, ok)
+		}
+	}
+}
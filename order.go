@@ -0,0 +1,156 @@
+package scale_codec
+
+import (
+	"cmp"
+	"math/big"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// EnumVariant is implemented by the variants of the generated enums,
+// VariantPosition is the position of the variant in the enum declaration,
+// which orders the variants as the Rust Ord derived for the enum does
+type EnumVariant interface {
+	VariantPosition() int
+}
+
+// orderedVariant is implemented by the options and results of the library,
+// whose fields are hidden, it returns the position of their variant and
+// the values it holds
+type orderedVariant interface {
+	variantOrder() (int, []any)
+}
+
+// compareOrdered compares a and b as the Rust Ord of their type does, as
+// compareValues does for dynamic values: integers by value, false before
+// true, strings and sequences lexicographically, structs and tuples field
+// by field and enums by the position of their variant, then its fields
+func compareOrdered(a, b any) int {
+	return compareReflected(reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+func compareReflected(a, b reflect.Value) int {
+	// enums and compact values are held by interfaces
+	for a.Kind() == reflect.Interface {
+		a = a.Elem()
+	}
+	for b.Kind() == reflect.Interface {
+		b = b.Elem()
+	}
+
+	if isNil(a) || isNil(b) {
+		return cmp.Compare(boolOrder(!isNil(a)), boolOrder(!isNil(b)))
+	}
+
+	pa, pb := addressOf(a).Interface(), addressOf(b).Interface()
+	if variantA, ok := pa.(EnumVariant); ok {
+		if variantB, ok := pb.(EnumVariant); ok {
+			if c := cmp.Compare(variantA.VariantPosition(), variantB.VariantPosition()); c != 0 {
+				return c
+			}
+		}
+	}
+
+	if variantA, ok := pa.(orderedVariant); ok {
+		positionA, fieldsA := variantA.variantOrder()
+		positionB, fieldsB := pb.(orderedVariant).variantOrder()
+		if c := cmp.Compare(positionA, positionB); c != 0 {
+			return c
+		}
+
+		for idx := range fieldsA {
+			if c := compareOrdered(fieldsA[idx], fieldsB[idx]); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+
+	if integerA, ok := pa.(interface{ ToBigInt() *big.Int }); ok {
+		return integerA.ToBigInt().Cmp(pb.(interface{ ToBigInt() *big.Int }).ToBigInt())
+	}
+
+	if compactA, ok := pa.(*Compact); ok {
+		return compactA.toBigInt().Cmp(pb.(*Compact).toBigInt())
+	}
+
+	a, b = reflect.Indirect(a), reflect.Indirect(b)
+	switch a.Kind() {
+	case reflect.Bool:
+		return cmp.Compare(boolOrder(a.Bool()), boolOrder(b.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case reflect.String:
+		return strings.Compare(a.String(), b.String())
+	case reflect.Slice, reflect.Array:
+		for idx := 0; idx < min(a.Len(), b.Len()); idx++ {
+			if c := compareReflected(a.Index(idx), b.Index(idx)); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(a.Len(), b.Len())
+	case reflect.Struct:
+		// the hidden fields of the library types are not part of their value
+		for idx := 0; idx < a.NumField(); idx++ {
+			if !a.Type().Field(idx).IsExported() {
+				continue
+			}
+
+			if c := compareReflected(a.Field(idx), b.Field(idx)); c != 0 {
+				return c
+			}
+		}
+		return 0
+	default:
+		return 0
+	}
+}
+
+func isNil(v reflect.Value) bool {
+	return !v.IsValid() || (v.Kind() == reflect.Pointer && v.IsNil())
+}
+
+// addressOf returns a pointer to v, so the methods
+// of both the value and the pointer are found
+func addressOf(v reflect.Value) reflect.Value {
+	if v.Kind() == reflect.Pointer {
+		return v
+	}
+
+	pointer := reflect.New(v.Type())
+	pointer.Elem().Set(v)
+	return pointer
+}
+
+func boolOrder(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+// sortUnique sorts items by the keys returned by key, as compareOrdered
+// orders them, an item whose key equals the previous one replaces it, as
+// the BTreeMap and BTreeSet collected by Rust keep the last value
+func sortUnique[T any](items []T, key func(T) any) []T {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a, b T) int {
+		return compareOrdered(key(a), key(b))
+	})
+
+	unique := sorted[:0]
+	for _, item := range sorted {
+		if len(unique) > 0 && compareOrdered(key(unique[len(unique)-1]), key(item)) == 0 {
+			unique[len(unique)-1] = item
+			continue
+		}
+
+		unique = append(unique, item)
+	}
+
+	return unique
+}
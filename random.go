@@ -0,0 +1,193 @@
+package scale_codec
+
+import (
+	"bytes"
+	"math/big"
+	"math/rand"
+
+	"golang.org/x/exp/constraints"
+)
+
+// DefaultRandomDepth is the MaxDepth of the values built by NewRandom
+const DefaultRandomDepth = 4
+
+// randomRunes are the characters of the random strings, they
+// include multi byte runes to cover the utf-8 validation
+var randomRunes = []rune("abcxyzABCXYZ019 _-éß世界🙂")
+
+// Random builds the random values of the round-trip and fuzz tests
+// generated by enum_script -tests. The values are built the way
+// UnmarshalSCALE decodes them, so a value is deeply equal to its decoding
+type Random struct {
+	*rand.Rand
+
+	// MaxDepth bounds the nesting of the values, deeper options are None,
+	// deeper sequences are empty and deeper enums only pick the variants
+	// that can be built without nesting the enum in itself
+	MaxDepth int
+}
+
+// NewRandom returns a Random seeded with seed, the
+// same seed always builds the same sequence of values
+func NewRandom(seed int64) *Random {
+	return &Random{Rand: rand.New(rand.NewSource(seed)), MaxDepth: DefaultRandomDepth}
+}
+
+// RandomFunc builds a random T nested at depth
+type RandomFunc[T Marshaler] func(r *Random, depth int) T
+
+// Deep reports whether the values nested at depth reached MaxDepth
+func (r *Random) Deep(depth int) bool {
+	return depth >= r.MaxDepth
+}
+
+// length returns the length of a sequence nested at depth
+func (r *Random) length(depth int) int {
+	if r.Deep(depth) {
+		return 0
+	}
+
+	return r.Intn(4)
+}
+
+// integer returns either a small, a medium or a full width integer,
+// so the compact encodings and the integer bounds are all covered
+func (r *Random) integer() uint64 {
+	switch r.Intn(3) {
+	case 0:
+		return uint64(r.Intn(1 << 6))
+	case 1:
+		return uint64(r.Intn(1 << 16))
+	default:
+		return r.Uint64() >> r.Intn(64)
+	}
+}
+
+func RandomBool(r *Random, _ int) *Bool {
+	return &Bool{Value: r.Intn(2) == 1}
+}
+
+func RandomInteger[T constraints.Integer](r *Random, _ int) *Integer[T] {
+	return &Integer[T]{Value: T(r.integer())}
+}
+
+func RandomU128(r *Random, _ int) *U128 {
+	if r.Intn(2) == 0 {
+		return U128FromUpperLower(0, r.integer())
+	}
+
+	return U128FromUpperLower(r.integer(), r.Uint64())
+}
+
+func RandomI128(r *Random, _ int) *I128 {
+	return &I128{upper: r.integer(), lower: r.Uint64()}
+}
+
+// RandomCompact returns a compact decoded in the smallest integer that holds it
+func RandomCompact(r *Random, _ int) *Compact {
+	value := new(big.Int).SetUint64(r.integer())
+	if r.Intn(4) == 0 {
+		value.Lsh(value, uint(r.Intn(65)))
+	}
+
+	encoded, err := Compact{Value: &CompactBigInt{Value: value}}.MarshalSCALE()
+	if err != nil {
+		panic(err)
+	}
+
+	compact, err := CompactFromRawBytes(bytes.NewReader(encoded))
+	if err != nil {
+		panic(err)
+	}
+
+	return compact
+}
+
+func RandomString(r *Random, depth int) *String {
+	runes := make([]rune, r.length(depth)*2)
+	for idx := range runes {
+		runes[idx] = randomRunes[r.Intn(len(randomRunes))]
+	}
+
+	return &String{Value: string(runes)}
+}
+
+func RandomBytes(r *Random, depth int) *Bytes {
+	value := make([]byte, r.length(depth)*4)
+	r.Read(value)
+	return &Bytes{Value: value}
+}
+
+func RandomByteArray(length int) RandomFunc[*ByteArray] {
+	return func(r *Random, _ int) *ByteArray {
+		array := NewByteArray(length)
+		r.Read(array.Value)
+		return array
+	}
+}
+
+func RandomUnit(_ *Random, _ int) *Unit {
+	return &Unit{}
+}
+
+func RandomOption[T Marshaler](inner RandomFunc[T]) RandomFunc[*OptionG[T]] {
+	return func(r *Random, depth int) *OptionG[T] {
+		if r.Deep(depth) || r.Intn(2) == 0 {
+			return NoneG[T]()
+		}
+
+		return SomeG(inner(r, depth+1))
+	}
+}
+
+func RandomResult[T Marshaler, E Marshaler](ok RandomFunc[T], err RandomFunc[E]) RandomFunc[*ResultG[T, E]] {
+	return func(r *Random, depth int) *ResultG[T, E] {
+		if r.Intn(2) == 0 {
+			return OkG[T, E](ok(r, depth+1))
+		}
+
+		return ErrG[T](err(r, depth+1))
+	}
+}
+
+func RandomVec[T Marshaler](item RandomFunc[T]) RandomFunc[*VecG[T]] {
+	return func(r *Random, depth int) *VecG[T] {
+		vec := &VecG[T]{Items: make([]T, r.length(depth))}
+		for idx := range vec.Items {
+			vec.Items[idx] = item(r, depth+1)
+		}
+
+		return vec
+	}
+}
+
+func RandomSet[T Marshaler](item RandomFunc[T]) RandomFunc[*SetG[T]] {
+	return func(r *Random, depth int) *SetG[T] {
+		set := &SetG[T]{Items: RandomVec(item)(r, depth).Items}
+		set.Items = set.sortedItems()
+		return set
+	}
+}
+
+func RandomArray[T Marshaler](length int, item RandomFunc[T]) RandomFunc[*ArrayG[T]] {
+	return func(r *Random, depth int) *ArrayG[T] {
+		array := NewArrayG[T](length)
+		for idx := range array.Items {
+			array.Items[idx] = item(r, depth+1)
+		}
+
+		return array
+	}
+}
+
+func RandomMap[K Marshaler, V Marshaler](key RandomFunc[K], value RandomFunc[V]) RandomFunc[*MapG[K, V]] {
+	return func(r *Random, depth int) *MapG[K, V] {
+		m := &MapG[K, V]{Entries: make([]MapEntry[K, V], r.length(depth))}
+		for idx := range m.Entries {
+			m.Entries[idx] = MapEntry[K, V]{Key: key(r, depth+1), Value: value(r, depth+1)}
+		}
+
+		m.Entries = m.sortedEntries()
+		return m
+	}
+}
@@ -0,0 +1,85 @@
+package scale_codec_test
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+// randomRoundTrip builds values with random and checks that decoding
+// their encoding with unmarshal gives back a deeply equal value
+func randomRoundTrip[T scale_codec.Marshaler](t *testing.T, name string,
+	random scale_codec.RandomFunc[T], unmarshal func(io.Reader) (T, error)) {
+	t.Helper()
+
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 200; idx++ {
+		value := random(r, 0)
+		encoded, err := value.MarshalSCALE()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		reader := bytes.NewReader(encoded)
+		decoded, err := unmarshal(reader)
+		if err != nil {
+			t.Fatalf("%s: decoding %v: unexpected error: %v", name, encoded, err)
+		}
+
+		if reader.Len() != 0 {
+			t.Fatalf("%s: %d bytes left after decoding %v", name, reader.Len(), encoded)
+		}
+
+		if !reflect.DeepEqual(value, decoded) {
+			t.Fatalf("%s\nexpected: %v\ngot: %v", name, value, decoded)
+		}
+	}
+}
+
+func TestRandomRoundTrip(t *testing.T) {
+	randomRoundTrip(t, "bool", scale_codec.RandomBool, scale_codec.BoolFromRawBytes)
+	randomRoundTrip(t, "uint8", scale_codec.RandomInteger[uint8], scale_codec.IntegerFromRawBytes[uint8])
+	randomRoundTrip(t, "int64", scale_codec.RandomInteger[int64], scale_codec.IntegerFromRawBytes[int64])
+	randomRoundTrip(t, "u128", scale_codec.RandomU128, scale_codec.U128FromRawBytes)
+	randomRoundTrip(t, "i128", scale_codec.RandomI128, scale_codec.I128FromRawBytes)
+	randomRoundTrip(t, "Compact", scale_codec.RandomCompact, scale_codec.CompactFromRawBytes)
+	randomRoundTrip(t, "String", scale_codec.RandomString, scale_codec.StringFromRawBytes)
+	randomRoundTrip(t, "Bytes", scale_codec.RandomBytes, scale_codec.BytesFromRawBytes)
+	randomRoundTrip(t, "[u8; 4]", scale_codec.RandomByteArray(4), scale_codec.UnmarshalByteArrayFromRawBytes(4))
+	randomRoundTrip(t, "()", scale_codec.RandomUnit, scale_codec.UnitFromRawBytes)
+
+	randomRoundTrip(t, "Option<Vec<u16>>",
+		scale_codec.RandomOption(scale_codec.RandomVec(scale_codec.RandomInteger[uint16])),
+		scale_codec.UnmarshalOptionFromRawBytes(
+			scale_codec.UnmarshalVecFromRawBytes(scale_codec.IntegerFromRawBytes[uint16])))
+
+	randomRoundTrip(t, "Result<String, [bool; 2]>",
+		scale_codec.RandomResult(scale_codec.RandomString, scale_codec.RandomArray(2, scale_codec.RandomBool)),
+		scale_codec.UnmarshalResultFromRawBytes(scale_codec.StringFromRawBytes,
+			scale_codec.UnmarshalArrayFromRawBytes(2, scale_codec.BoolFromRawBytes)))
+
+	randomRoundTrip(t, "BTreeMap<u32, Option<Compact>>",
+		scale_codec.RandomMap(scale_codec.RandomInteger[uint32], scale_codec.RandomOption(scale_codec.RandomCompact)),
+		scale_codec.UnmarshalMapFromRawBytes(scale_codec.IntegerFromRawBytes[uint32],
+			scale_codec.UnmarshalOptionFromRawBytes(scale_codec.CompactFromRawBytes)))
+}
+
+func TestRandomMaxDepth(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	r.MaxDepth = 0
+
+	random := scale_codec.RandomOption(scale_codec.RandomVec(scale_codec.RandomBool))
+	for idx := 0; idx < 20; idx++ {
+		if _, ok := random(r, 0).Unwrap(); ok {
+			t.Fatalf("expected None at the max depth")
+		}
+	}
+
+	vec := scale_codec.RandomVec(scale_codec.RandomBool)(r, 0)
+	if len(vec.Items) != 0 {
+		t.Fatalf("\nexpected: no items\ngot: %v", vec.Items)
+	}
+}
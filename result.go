@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"strings"
 )
 
 type ResultG[T Marshaler, E Marshaler] struct {
@@ -54,15 +55,11 @@ func (r ResultG[T, E]) MarshalSCALE() ([]byte, error) {
 func (r *ResultG[T, E]) UnmarshalSCALE(reader io.Reader,
 	okF func(io.Reader) (T, error), errF func(io.Reader) (E, error)) error {
 	encResultTag := make([]byte, 1)
-	n, err := reader.Read(encResultTag)
+	err := readFull(reader, encResultTag)
 	if err != nil {
 		return err
 	}
 
-	if n != 1 {
-		return fmt.Errorf("%w: want %v, got %v", ErrUnexpectedReadBytes, 1, n)
-	}
-
 	switch encResultTag[0] {
 	case 0x00:
 		ok, err := okF(reader)
@@ -98,6 +95,93 @@ func ErrG[T Marshaler, E Marshaler](err E) *ResultG[T, E] {
 	}
 }
 
+// Ok returns the ok value and true, or the zero value and false
+func (r *ResultG[T, E]) Ok() (T, bool) {
+	if r == nil || !r.isOk {
+		return *new(T), false
+	}
+	return r.ok, true
+}
+
+// Err returns the error value and true, or the zero value and false
+func (r *ResultG[T, E]) Err() (E, bool) {
+	if r == nil || !r.isErr {
+		return *new(E), false
+	}
+	return r.err, true
+}
+
+func (r *ResultG[T, E]) variantOrder() (int, []any) {
+	if r.isErr {
+		return 1, []any{r.err}
+	}
+	return 0, []any{r.ok}
+}
+
+func UnmarshalResultFromJSON[T Marshaler, E Marshaler](
+	okF func([]byte) (T, error),
+	errF func([]byte) (E, error)) func(data []byte) (*ResultG[T, E], error) {
+	return func(data []byte) (*ResultG[T, E], error) {
+		result := &ResultG[T, E]{}
+		err := result.UnmarshalJSONWith(data, okF, errF)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+}
+
+func (r ResultG[T, E]) String() string {
+	if r.isErr {
+		return DebugTuple("Err", r.err)
+	}
+	return DebugTuple("Ok", r.ok)
+}
+
+// MarshalJSON writes the result as `{"ok": value}` or `{"err": value}`
+func (r ResultG[T, E]) MarshalJSON() ([]byte, error) {
+	if r.isErr {
+		return MarshalJSONVariant("err", r.err)
+	}
+
+	if r.isOk {
+		return MarshalJSONVariant("ok", r.ok)
+	}
+
+	return nil, ErrCannotEncodeEmptyResult
+}
+
+func (r *ResultG[T, E]) UnmarshalJSON(data []byte) error {
+	return r.UnmarshalJSONWith(data, decodeJSON[T], decodeJSON[E])
+}
+
+func (r *ResultG[T, E]) UnmarshalJSONWith(data []byte,
+	okF func([]byte) (T, error), errF func([]byte) (E, error)) error {
+	tag, value, err := UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(tag) {
+	case "ok":
+		ok, err := okF(value)
+		if err != nil {
+			return fmt.Errorf("while parsing result ok: %w", err)
+		}
+		*r = *OkG[T, E](ok)
+	case "err":
+		errResult, err := errF(value)
+		if err != nil {
+			return fmt.Errorf("while parsing result err: %w", err)
+		}
+		*r = *ErrG[T, E](errResult)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnexpectedResultTag, tag)
+	}
+
+	return nil
+}
+
 type Result struct {
 	ok   Encodable
 	isOk bool
@@ -153,15 +237,11 @@ func (r Result) MarshalSCALE() ([]byte, error) {
 
 func (r *Result) UnmarshalSCALE(reader io.Reader) error {
 	encResultTag := make([]byte, 1)
-	n, err := reader.Read(encResultTag)
+	err := readFull(reader, encResultTag)
 	if err != nil {
 		return err
 	}
 
-	if n != 1 {
-		return fmt.Errorf("%w: want %v, got %v", ErrUnexpectedReadBytes, 1, n)
-	}
-
 	var unmarshaler Encodable
 
 	switch encResultTag[0] {
@@ -190,6 +270,13 @@ func (r *Result) Unwrap() Encodable {
 	panic("cannot unwrap empty result")
 }
 
+func (r *Result) variantOrder() (int, []any) {
+	if r.isErr {
+		return 1, []any{r.err}
+	}
+	return 0, []any{r.ok}
+}
+
 func (r *Result) IsErr() bool {
 	return r.isErr
 }
@@ -189,3 +189,23 @@ func TestResultUnmarshalerGeneric(t *testing.T) {
 		t.Fatalf("\nexpected: %v\nactual: %v", expected, unmarshaler)
 	}
 }
+
+func TestResultAccessors(t *testing.T) {
+	ok := scale_codec.OkG[*scale_codec.Integer[uint8], *scale_codec.Bool](&scale_codec.Integer[uint8]{Value: 1})
+	if value, isOk := ok.Ok(); !isOk || value.Value != 1 {
+		t.Fatalf("expected Ok(1), got %v, %v", value, isOk)
+	}
+
+	if _, isErr := ok.Err(); isErr {
+		t.Fatalf("expected Ok(1) not to be an error")
+	}
+
+	err := scale_codec.ErrG[*scale_codec.Integer[uint8]](&scale_codec.Bool{Value: true})
+	if value, isErr := err.Err(); !isErr || !value.Value {
+		t.Fatalf("expected Err(true), got %v, %v", value, isErr)
+	}
+
+	if _, isOk := err.Ok(); isOk {
+		t.Fatalf("expected Err(true) not to be ok")
+	}
+}
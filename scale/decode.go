@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+const decodeUsage = "Usage: scale decode -type <expr> [-schema file.scale] [-json] [-raw] [-in file] [0x...]\n"
+
+// runDecode implements `scale decode`, it prints the payload decoded as a
+// tree or as JSON, and returns 1 when the payload cannot be decoded and 2
+// when the arguments, the type or the schema are invalid
+func runDecode(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("decode", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var opts typeFlags
+	opts.register(flags)
+	asJSON := flags.Bool("json", false, "print the decoded value as JSON instead of a tree")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, decodeUsage+"\nFlags:\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if opts.typeExpr == "" {
+		flags.Usage()
+		return 2
+	}
+
+	desc, err := opts.typeDesc()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	payload, err := opts.readPayload(flags.Args(), stdin)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	reader := bytes.NewReader(payload)
+	value, err := scale_codec.DecodeValue(desc, reader)
+	if err != nil {
+		fmt.Fprintf(stderr, "decoding %s at offset %d: %v\n", opts.typeExpr, failedOffset(desc, payload), err)
+		return 1
+	}
+
+	if reader.Len() > 0 {
+		fmt.Fprintf(stderr, "decoding %s: %d trailing bytes at offset %d\n",
+			opts.typeExpr, reader.Len(), len(payload)-reader.Len())
+		return 1
+	}
+
+	if *asJSON {
+		output, err := scale_codec.MarshalValueJSON(desc, value)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+
+		fmt.Fprintf(stdout, "%s\n", output)
+		return 0
+	}
+
+	fmt.Fprintln(stdout, formatTree(desc, value, ""))
+	return 0
+}
+
+// failedOffset returns the offset where the value failing to decode
+// starts, rather than where the reader stopped, as explain reports it
+func failedOffset(desc *scale_codec.TypeDesc, payload []byte) int {
+	explanation, _ := scale_codec.Explain(desc, payload)
+	for _, r := range explanation.Ranges {
+		if r.Type == "undecoded" {
+			return r.Start
+		}
+	}
+
+	// nothing was left to decode the value from
+	return len(payload)
+}
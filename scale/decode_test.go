@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testSchema = `
+newtype AccountId([u8; 2])
+
+enum Call {
+	Remark(Bytes)
+	Transfer { to: AccountId, amount: Compact<u128> }
+	Batch(Vec<Call>)
+}
+`
+
+func writeTestSchema(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "calls.scale")
+	if err := os.WriteFile(path, []byte(testSchema), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestRunDecode(t *testing.T) {
+	schema := writeTestSchema(t)
+	payload := filepath.Join(t.TempDir(), "payload.bin")
+	if err := os.WriteFile(payload, []byte{0, 8, 'h', 'i'}, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		args     []string
+		stdin    string
+		code     int
+		expected string
+		stderr   string
+	}{
+		{
+			args:     []string{"-type", "(u8, bool)", "0x0701"},
+			expected: "(7, true)\n",
+		},
+		{
+			// the layout follows the type, the None tuple is
+			// expanded as the Some one is
+			args: []string{"-type", "Vec<(u8, Option<bool>)>", "0x080101010200"},
+			expected: "[\n" +
+				"    (\n" +
+				"        1,\n" +
+				"        Some(true),\n" +
+				"    ),\n" +
+				"    (\n" +
+				"        2,\n" +
+				"        None,\n" +
+				"    ),\n" +
+				"]\n",
+		},
+		{
+			args:  []string{"-type", "Call", "-schema", schema},
+			stdin: "0x02 08 01aabb04 00 08 6869",
+			expected: "Batch(\n" +
+				"    [\n" +
+				"        Transfer {\n" +
+				"            to: AccountId([170, 187]),\n" +
+				"            amount: 1,\n" +
+				"        },\n" +
+				"        Remark([104, 105]),\n" +
+				"    ],\n" +
+				")\n",
+		},
+		{
+			args:     []string{"-type", "Call", "-schema", schema, "-json", "0x01aabb10"},
+			expected: `{"Transfer":{"to":"0xaabb","amount":4}}` + "\n",
+		},
+		{
+			args:     []string{"-type", "Call", "-schema", schema, "-raw", "-in", payload},
+			expected: "Remark([104, 105])\n",
+		},
+		{
+			args:   []string{"-type", "Call", "-schema", schema, "0x0208010203"},
+			code:   1,
+			stderr: "decoding Call at offset 5: decoding variant Batch: decoding field 0: decoding item at index 0: decoding variant Transfer: decoding field amount:",
+		},
+		{
+			// the offset is where the failing value starts, the wrong tag
+			// at byte 2, rather than the byte after it
+			args:   []string{"-type", "Call", "-schema", schema, "0x020407"},
+			code:   1,
+			stderr: "decoding Call at offset 2: decoding variant Batch: decoding field 0: decoding item at index 0:",
+		},
+		{
+			args:   []string{"-type", "Vec<u8>", "0x13ffffffffffffffff"},
+			code:   1,
+			stderr: "decoding Vec<u8> at offset 0: unexpected length",
+		},
+		{
+			args:   []string{"-type", "u16", "0x010203"},
+			code:   1,
+			stderr: "decoding u16: 1 trailing bytes at offset 2",
+		},
+		{
+			args:   []string{"-type", "Call", "0x00"},
+			code:   2,
+			stderr: "<input>:1:1: undefined: Call",
+		},
+		{
+			args:   []string{"-type", "u8", "0xzz"},
+			code:   2,
+			stderr: "invalid hex input",
+		},
+		{
+			args: []string{"0x00"},
+			code: 2,
+		},
+	}
+
+	for _, tt := range cases {
+		var stdout, stderr bytes.Buffer
+		code := runDecode(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+		if code != tt.code {
+			t.Fatalf("%v: expected exit code %d, got %d: %s", tt.args, tt.code, code, stderr.String())
+		}
+
+		if tt.expected != "" && stdout.String() != tt.expected {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expected, stdout.String())
+		}
+
+		if !strings.Contains(stderr.String(), tt.stderr) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.stderr, stderr.String())
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+const encodeUsage = "Usage: scale encode -type <expr> [-schema file.scale] [-raw] [-in file] ['<json>']\n"
+
+// runEncode implements `scale encode`, it prints the SCALE encoding of a
+// JSON value in hex, and returns 1 when the value does not match the type
+// and 2 when the arguments, the type or the schema are invalid
+func runEncode(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("encode", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var opts typeFlags
+	opts.register(flags)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, encodeUsage+"\nFlags:\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if opts.typeExpr == "" {
+		flags.Usage()
+		return 2
+	}
+
+	desc, err := opts.typeDesc()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	input, err := opts.readInput(flags.Args(), stdin)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	value, err := scale_codec.UnmarshalValueJSON(desc, input)
+	if err != nil {
+		fmt.Fprintf(stderr, "encoding %s: %v\n", opts.typeExpr, err)
+		return 1
+	}
+
+	encoded, err := scale_codec.EncodeValue(desc, value)
+	if err != nil {
+		fmt.Fprintf(stderr, "encoding %s: %v\n", opts.typeExpr, err)
+		return 1
+	}
+
+	if opts.raw {
+		stdout.Write(encoded)
+		return 0
+	}
+
+	fmt.Fprintf(stdout, "0x%s\n", hex.EncodeToString(encoded))
+	return 0
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRunEncode(t *testing.T) {
+	schema := writeTestSchema(t)
+
+	cases := []struct {
+		args     []string
+		stdin    string
+		code     int
+		expected string
+		stderr   string
+	}{
+		{
+			args:     []string{"-type", "(u8, Option<bool>)", "[7, true]"},
+			expected: "0x070101\n",
+		},
+		{
+			args:     []string{"-type", "Call", "-schema", schema},
+			stdin:    `{"Batch": [{"Transfer": {"to": "0xaabb", "amount": 1}}, {"Remark": "0x6869"}]}`,
+			expected: "0x020801aabb0400086869\n",
+		},
+		{
+			args:     []string{"-type", "Vec<u8>", "-raw", `"0x6869"`},
+			expected: "\x08hi",
+		},
+		{
+			args:   []string{"-type", "u8", "256"},
+			code:   1,
+			stderr: "encoding u8:",
+		},
+		{
+			args:   []string{"-type", "Call", "-schema", schema, `{"Burn": null}`},
+			code:   1,
+			stderr: "encoding Call:",
+		},
+		{
+			args: []string{"-type", "u8", "1", "2"},
+			code: 2,
+		},
+	}
+
+	for _, tt := range cases {
+		var stdout, stderr bytes.Buffer
+		code := runEncode(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+		if code != tt.code {
+			t.Fatalf("%v: expected exit code %d, got %d: %s", tt.args, tt.code, code, stderr.String())
+		}
+
+		if tt.expected != "" && stdout.String() != tt.expected {
+			t.Fatalf("\nexpected: %q\ngot: %q", tt.expected, stdout.String())
+		}
+
+		if !strings.Contains(stderr.String(), tt.stderr) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.stderr, stderr.String())
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+const explainUsage = "Usage: scale explain -type <expr> [-schema file.scale] [-raw] [-in file] [0x...]\n"
+
+// runExplain implements `scale explain`, it prints the annotated hexdump of
+// the payload, labelling every byte range with the value it encodes. When
+// the payload cannot be decoded the bytes decoded so far are printed, the
+// error is reported and 1 is returned, 2 when the arguments are invalid
+func runExplain(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("explain", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var opts typeFlags
+	opts.register(flags)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, explainUsage+"\nFlags:\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if opts.typeExpr == "" {
+		flags.Usage()
+		return 2
+	}
+
+	desc, err := opts.typeDesc()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	payload, err := opts.readPayload(flags.Args(), stdin)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	explanation, err := scale_codec.Explain(desc, payload)
+	fmt.Fprint(stdout, explanation)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRunExplain(t *testing.T) {
+	schema := writeTestSchema(t)
+
+	cases := []struct {
+		args     []string
+		stdin    string
+		code     int
+		expected string
+		stderr   string
+	}{
+		{
+			args: []string{"-type", "Call", "-schema", schema, "0x01aabb04"},
+			expected: "" +
+				"[0]     01                       tag = Call::Transfer\n" +
+				"[1..3]  aa bb                    Transfer.to [u8; 2]\n" +
+				"[3]     04                       Transfer.amount Compact<u128> = 1\n",
+		},
+		{
+			args:  []string{"-type", "Option<u16>"},
+			stdin: "0x01 05",
+			code:  1,
+			expected: "" +
+				"[0]  01                       tag = Option::Some\n" +
+				"[1]  05                       undecoded\n",
+			stderr: "decoding Some u16 at offset 1:",
+		},
+		{
+			args: []string{"-type", "Call", "0x00"},
+			code: 2,
+		},
+	}
+
+	for _, tt := range cases {
+		var stdout, stderr bytes.Buffer
+		code := runExplain(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+		if code != tt.code {
+			t.Fatalf("%v: expected exit code %d, got %d: %s", tt.args, tt.code, code, stderr.String())
+		}
+
+		if stdout.String() != tt.expected {
+			t.Fatalf("\nexpected:\n%v\ngot:\n%v", tt.expected, stdout.String())
+		}
+
+		if !strings.Contains(stderr.String(), tt.stderr) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.stderr, stderr.String())
+		}
+	}
+}
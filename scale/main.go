@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+const usage = "Usage: scale decode -type <expr> [-schema file.scale] [-json] [-raw] [-in file] [0x...]\n" +
+	"       scale encode -type <expr> [-schema file.scale] [-raw] [-in file] ['<json>']\n" +
+	"       scale explain -type <expr> [-schema file.scale] [-raw] [-in file] [0x...]\n"
+
+func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "decode":
+			os.Exit(runDecode(os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+		case "encode":
+			os.Exit(runEncode(os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+		case "explain":
+			os.Exit(runExplain(os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+		}
+	}
+
+	fmt.Fprint(os.Stderr, usage)
+	os.Exit(2)
+}
+
+// typeFlags are the flags shared by the subcommands, the type to decode or
+// encode, the schema declaring its named types and where the input is read
+type typeFlags struct {
+	typeExpr string
+	schema   string
+	input    string
+	raw      bool
+}
+
+func (f *typeFlags) register(flags *flag.FlagSet) {
+	flags.StringVar(&f.typeExpr, "type", "", "type `expression` of the payload, as written in a .scale file")
+	flags.StringVar(&f.schema, "schema", "", "`.scale` file declaring the named types of -type")
+	flags.StringVar(&f.input, "in", "", "read the input from `file`, - for stdin, instead of the argument")
+	flags.BoolVar(&f.raw, "raw", false, "binary payloads instead of hex")
+}
+
+// typeDesc describes -type, resolving its named types in -schema
+func (f *typeFlags) typeDesc() (*scale_codec.TypeDesc, error) {
+	var schema *scale_codec.Schema
+	if f.schema != "" {
+		contents, err := os.ReadFile(f.schema)
+		if err != nil {
+			return nil, err
+		}
+
+		if schema, err = scale_codec.ParseSchema(f.schema, bytes.NewReader(contents)); err != nil {
+			return nil, err
+		}
+	}
+
+	return scale_codec.ParseTypeDesc(f.typeExpr, schema)
+}
+
+// readInput returns the positional argument when there is one, and
+// otherwise the contents of -in or of stdin when -in is empty or -
+func (f *typeFlags) readInput(args []string, stdin io.Reader) ([]byte, error) {
+	switch {
+	case len(args) > 1:
+		return nil, errors.New("too many arguments")
+	case len(args) == 1 && f.input != "":
+		return nil, errors.New("an argument and -in cannot be both given")
+	case len(args) == 1:
+		return []byte(args[0]), nil
+	case f.input == "" || f.input == "-":
+		return io.ReadAll(stdin)
+	default:
+		return os.ReadFile(f.input)
+	}
+}
+
+// readPayload reads the input as readInput does, decoded from hex unless -raw is set
+func (f *typeFlags) readPayload(args []string, stdin io.Reader) ([]byte, error) {
+	input, err := f.readInput(args, stdin)
+	if err != nil || f.raw {
+		return input, err
+	}
+
+	return decodeHex(input)
+}
+
+// decodeHex decodes a payload written in hex, with or without
+// its 0x prefix, spaces and line breaks are ignored
+func decodeHex(input []byte) ([]byte, error) {
+	text := strings.Join(strings.Fields(string(input)), "")
+	text = strings.TrimPrefix(strings.TrimPrefix(text, "0x"), "0X")
+
+	payload, err := hex.DecodeString(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex input, use -raw for binary input: %w", err)
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"strings"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+const treeIndent = "    "
+
+// formatTree formats value, of the type described by desc, like Rust's
+// `{:#?}`, a field or an item per line, except the types whose children
+// are all scalars, or that wrap such a type, which are kept on a single
+// line, so byte vectors do not take a line per byte. The layout depends
+// on the type, or on the variant for enums, never on the decoded values
+func formatTree(desc *scale_codec.TypeDesc, value *scale_codec.Value, indent string) string {
+	var fields []*scale_codec.FieldDesc
+	switch value.Kind {
+	case scale_codec.SequenceValue:
+		if len(value.Items) == 0 || isLeaf(desc, nil) {
+			return value.String()
+		}
+	case scale_codec.CompositeValue, scale_codec.VariantValue:
+		fields = desc.Fields
+		if value.Kind == scale_codec.VariantValue {
+			fields = variantFields(desc, value.Name)
+		}
+
+		if len(value.Fields) == 0 || isLeafFields(fields, nil) {
+			return value.String()
+		}
+	default:
+		return value.String()
+	}
+
+	inner := indent + treeIndent
+	var lines []string
+	var open, close string
+	switch value.Kind {
+	case scale_codec.SequenceValue:
+		open, close = "[", "]"
+		for _, item := range value.Items {
+			lines = append(lines, inner+formatTree(desc.Elem, item, inner)+",")
+		}
+	default:
+		if value.Fields[0].Name == "" {
+			open, close = value.Name+"(", ")"
+			for idx, field := range value.Fields {
+				lines = append(lines, inner+formatTree(fields[idx].Type, field.Value, inner)+",")
+			}
+		} else {
+			open, close = strings.TrimPrefix(value.Name+" {", " "), "}"
+			for idx, field := range value.Fields {
+				lines = append(lines, inner+field.Name+": "+formatTree(fields[idx].Type, field.Value, inner)+",")
+			}
+		}
+	}
+
+	return open + "\n" + strings.Join(lines, "\n") + "\n" + indent + close
+}
+
+// variantFields returns the fields of the variant of desc named name
+func variantFields(desc *scale_codec.TypeDesc, name string) []*scale_codec.FieldDesc {
+	for _, variant := range desc.Variants {
+		if variant.Name == name {
+			return variant.Fields
+		}
+	}
+
+	return nil
+}
+
+// isLeaf reports whether the values of desc are formatted on a single
+// line, seen holds the types being checked so recursive types expand
+func isLeaf(desc *scale_codec.TypeDesc, seen map[*scale_codec.TypeDesc]bool) bool {
+	switch desc.Kind {
+	case scale_codec.SequenceDesc, scale_codec.SetDesc, scale_codec.ArrayDesc, scale_codec.MapDesc:
+		return isScalar(desc.Elem)
+	case scale_codec.CompositeDesc, scale_codec.EnumDesc:
+		if seen[desc] {
+			return false
+		}
+
+		if seen == nil {
+			seen = make(map[*scale_codec.TypeDesc]bool)
+		}
+		seen[desc] = true
+		defer delete(seen, desc)
+
+		if desc.Kind == scale_codec.CompositeDesc {
+			return isLeafFields(desc.Fields, seen)
+		}
+
+		for _, variant := range desc.Variants {
+			if !isLeafFields(variant.Fields, seen) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return true
+	}
+}
+
+// isLeafFields reports whether a composite or a variant with the given
+// fields is formatted on a single line
+func isLeafFields(fields []*scale_codec.FieldDesc, seen map[*scale_codec.TypeDesc]bool) bool {
+	if len(fields) == 1 {
+		return isLeaf(fields[0].Type, seen)
+	}
+
+	for _, field := range fields {
+		if !isScalar(field.Type) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isScalar reports whether the values of desc have no children, the
+// primitives, compact integers, bit sequences, the composites without
+// fields and the enums whose variants have none
+func isScalar(desc *scale_codec.TypeDesc) bool {
+	switch desc.Kind {
+	case scale_codec.PrimitiveDesc, scale_codec.CompactDesc, scale_codec.BitSequenceDesc:
+		return true
+	case scale_codec.CompositeDesc:
+		return len(desc.Fields) == 0
+	case scale_codec.EnumDesc:
+		for _, variant := range desc.Variants {
+			if len(variant.Fields) > 0 {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,319 @@
+package scale_codec
+
+import (
+	"fmt"
+	"strings"
+	"text/scanner"
+)
+
+// Schema is the syntax tree of a .scale file
+type Schema struct {
+	Filename string
+	Imports  []*ImportDecl
+	Enums    []*EnumDecl
+	Structs  []*StructDecl
+	Aliases  []*AliasDecl
+	Newtypes []*NewtypeDecl
+
+	// Comments are the comments of the file in source order
+	Comments []*Comment
+}
+
+// Comment is a `// line` or `/* block */` comment, Text includes the delimiters
+type Comment struct {
+	Pos  scanner.Position
+	Text string
+}
+
+// EnumDecl is an `enum Name { ... }` declaration, generic enums
+// declare their type parameters as `enum Name<T, U> { ... }`
+type EnumDecl struct {
+	Pos        scanner.Position
+	Name       string
+	TypeParams []*TypeParam
+	Variants   []*VariantDecl
+	// End is the position of the closing brace
+	End scanner.Position
+	// Doc is the text of the `///` comments before the declaration
+	Doc string
+}
+
+// VariantDecl is a single enum variant: a unit variant `X`, a variant with
+// a single payload `X(T)` or a variant with several fields, either unnamed
+// `X(A, B)` or named `X { a: A, b: B }`.
+//
+// Index is the variant encoded tag, like parity-scale-codec it is the
+// variant position unless set explicitly with `X = 5` or `@index(5) X`
+type VariantDecl struct {
+	Pos     scanner.Position
+	Name    string
+	Payload *TypeExpr
+	Fields  []*FieldDecl
+	Named   bool
+	// End is the position of the closing parenthesis or brace
+	// of the fields, unit variants have no End
+	End scanner.Position
+
+	Index         int
+	IndexPos      scanner.Position
+	ExplicitIndex bool
+
+	Doc string
+}
+
+// StructDecl is either a `struct Name { field: Type }` declaration or a
+// tuple struct `struct Name(Type)`, whose fields have no names
+type StructDecl struct {
+	Pos        scanner.Position
+	Name       string
+	TypeParams []*TypeParam
+	Tuple      bool
+	Fields     []*FieldDecl
+	// End is the position of the closing brace or parenthesis
+	End scanner.Position
+	Doc string
+}
+
+// ImportDecl is an `import "common.scale"` declaration, the types of the
+// imported schema are referenced qualified by Name, `common.AccountId`,
+// which is the file name unless it is set with `import "x.scale" as name`
+type ImportDecl struct {
+	Pos    scanner.Position
+	Path   string
+	Name   string
+	Schema *Schema
+}
+
+// AliasDecl is a `type Name = Type;` alias, it is expanded wherever it is used
+type AliasDecl struct {
+	Pos  scanner.Position
+	Name string
+	Type *TypeExpr
+}
+
+// NewtypeDecl is a `newtype Name(Type)` declaration, a distinct
+// type that is encoded exactly as its inner type
+type NewtypeDecl struct {
+	Pos  scanner.Position
+	Name string
+	Type *TypeExpr
+	Doc  string
+}
+
+// TypeParam is a type parameter of a generic enum or struct
+type TypeParam struct {
+	Pos  scanner.Position
+	Name string
+}
+
+// FieldDecl is a field of a struct or variant, only named fields have a Doc
+type FieldDecl struct {
+	Pos  scanner.Position
+	Name string
+	Type *TypeExpr
+	Doc  string
+}
+
+// indexPos returns the position of the explicit index of
+// the variant, or the position of the variant when it has none
+func (v *VariantDecl) indexPos() scanner.Position {
+	if v.ExplicitIndex {
+		return v.IndexPos
+	}
+
+	return v.Pos
+}
+
+// tupleFields wraps the types of a tuple struct or variant into unnamed fields
+func tupleFields(types []*TypeExpr) []*FieldDecl {
+	fields := make([]*FieldDecl, len(types))
+	for idx, fieldType := range types {
+		fields[idx] = &FieldDecl{Pos: fieldType.Pos, Type: fieldType}
+	}
+
+	return fields
+}
+
+// unitOnly reports whether no variant of a non generic enum carries data,
+// those enums are encoded as a single byte and generated as an uint8
+func (e *EnumDecl) unitOnly() bool {
+	if len(e.TypeParams) > 0 || len(e.Variants) == 0 {
+		return false
+	}
+
+	for _, variant := range e.Variants {
+		if variant.Payload != nil || len(variant.Fields) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func typeParamNames(params []*TypeParam) []string {
+	if len(params) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(params))
+	for idx, param := range params {
+		names[idx] = param.Name
+	}
+
+	return names
+}
+
+type TypeKind int
+
+const (
+	PrimitiveType TypeKind = iota
+	NamedType
+	OptionType
+	ResultType
+	TupleType
+	VecType
+	ArrayType
+	CompactType
+	MapType
+	SetType
+	UnitType
+	ParamType
+)
+
+// TypeExpr is a type as written in the schema, Args holds the inner
+// types of Option, Vec, arrays, Compact and BTreeSet (1), Result and
+// BTreeMap (2), tuples (n) and the type arguments of a generic named
+// type. Len is the length of `[T; N]` arrays and Package qualifies the
+// named types declared in an imported schema
+type TypeExpr struct {
+	Pos     scanner.Position
+	Kind    TypeKind
+	Package string
+	Name    string
+	Args    []*TypeExpr
+	Len     int
+}
+
+// String returns the type as written in the schema
+func (t *TypeExpr) String() string {
+	args := make([]string, len(t.Args))
+	for idx, arg := range t.Args {
+		args[idx] = arg.String()
+	}
+
+	switch t.Kind {
+	case OptionType:
+		return "Option<" + args[0] + ">"
+	case ResultType:
+		return "Result<" + strings.Join(args, ", ") + ">"
+	case TupleType:
+		return "(" + strings.Join(args, ", ") + ")"
+	case VecType:
+		return "Vec<" + args[0] + ">"
+	case ArrayType:
+		return fmt.Sprintf("[%s; %d]", args[0], t.Len)
+	case CompactType:
+		return "Compact<" + args[0] + ">"
+	case MapType:
+		return "BTreeMap<" + strings.Join(args, ", ") + ">"
+	case SetType:
+		return "BTreeSet<" + args[0] + ">"
+	case UnitType:
+		return "()"
+	case NamedType:
+		name := t.Name
+		if t.Package != "" {
+			name = t.Package + "." + name
+		}
+
+		if len(args) > 0 {
+			return name + "<" + strings.Join(args, ", ") + ">"
+		}
+		return name
+	default:
+		return t.Name
+	}
+}
+
+// alias returns the alias declared with name, or nil
+func (s *Schema) alias(name string) *AliasDecl {
+	for _, alias := range s.Aliases {
+		if alias.Name == name {
+			return alias
+		}
+	}
+
+	return nil
+}
+
+// enum returns the enum declared with name, or nil
+func (s *Schema) enum(name string) *EnumDecl {
+	for _, enum := range s.Enums {
+		if enum.Name == name {
+			return enum
+		}
+	}
+
+	return nil
+}
+
+// structNamed returns the struct declared with name, or nil
+func (s *Schema) structNamed(name string) *StructDecl {
+	for _, structDecl := range s.Structs {
+		if structDecl.Name == name {
+			return structDecl
+		}
+	}
+
+	return nil
+}
+
+// newtype returns the newtype declared with name, or nil
+func (s *Schema) newtype(name string) *NewtypeDecl {
+	for _, newtype := range s.Newtypes {
+		if newtype.Name == name {
+			return newtype
+		}
+	}
+
+	return nil
+}
+
+// importNamed returns the import whose qualifier is name, or nil
+func (s *Schema) importNamed(name string) *ImportDecl {
+	for _, imp := range s.Imports {
+		if imp.Name == name {
+			return imp
+		}
+	}
+
+	return nil
+}
+
+// expandAlias follows the aliases, including the ones of imported
+// schemas, until t is not an alias. Recursive aliases stop the
+// expansion at the alias seen twice
+func (s *Schema) expandAlias(t *TypeExpr) *TypeExpr {
+	scope := s
+	seen := make(map[*AliasDecl]bool)
+	for t.Kind == NamedType && len(t.Args) == 0 {
+		target := scope
+		if t.Package != "" {
+			imp := scope.importNamed(t.Package)
+			if imp == nil || imp.Schema == nil {
+				return t
+			}
+			target = imp.Schema
+		}
+
+		alias := target.alias(t.Name)
+		if alias == nil || seen[alias] {
+			return t
+		}
+
+		seen[alias] = true
+		scope, t = target, alias.Type
+	}
+
+	return t
+}
@@ -0,0 +1,270 @@
+package scale_codec
+
+import "text/scanner"
+
+// MaxEnumVariants is the amount of variants a single byte enum tag can index
+const MaxEnumVariants = 256
+
+// checkSchema reports semantic errors that the grammar alone cannot catch:
+// duplicated declarations, variants, fields or variant indexes, too many
+// variants, references to types that are not declared in the schema or
+// with the wrong number of type arguments, recursive aliases and Compact
+// of anything other than an unsigned integer
+func checkSchema(schema *Schema) SchemaErrors {
+	var errs SchemaErrors
+
+	imported := make(map[string]map[string]declaration, len(schema.Imports))
+	for _, imp := range schema.Imports {
+		if !isValidImportName(imp.Name) {
+			errs.add(imp.Pos, "invalid import name %s, use `import %q as name`", imp.Name, imp.Path)
+			continue
+		}
+
+		if previous := schema.importNamed(imp.Name); previous != imp {
+			errs.add(imp.Pos, "import %s redeclared, previous import at %s", imp.Name, previous.Pos)
+			continue
+		}
+
+		imported[imp.Name] = declarations(imp.Schema)
+	}
+
+	declared := make(map[string]declaration, len(schema.Enums)+len(schema.Structs))
+	declare := func(pos scanner.Position, kind, name string, params []*TypeParam) {
+		if previous, ok := declared[name]; ok {
+			errs.add(pos, "%s %s redeclared, previous declaration at %s", kind, name, previous.pos)
+			return
+		}
+		declared[name] = declaration{pos: pos, params: params}
+		checkTypeParams(&errs, kind+" "+name, params)
+	}
+
+	for _, enum := range schema.Enums {
+		declare(enum.Pos, "enum", enum.Name, enum.TypeParams)
+	}
+
+	for _, structDecl := range schema.Structs {
+		declare(structDecl.Pos, "struct", structDecl.Name, structDecl.TypeParams)
+	}
+
+	for _, alias := range schema.Aliases {
+		declare(alias.Pos, "type", alias.Name, nil)
+	}
+
+	for _, newtype := range schema.Newtypes {
+		declare(newtype.Pos, "newtype", newtype.Name, nil)
+	}
+
+	checkRefs := func(t *TypeExpr) {
+		walkTypes(t, func(t *TypeExpr) {
+			switch t.Kind {
+			case NamedType:
+				name, scope := t.Name, declared
+				if t.Package != "" {
+					name = t.Package + "." + t.Name
+					if scope = imported[t.Package]; scope == nil {
+						errs.add(t.Pos, "undefined: %s", t.Package)
+						return
+					}
+				}
+
+				decl, ok := scope[t.Name]
+				if !ok {
+					errs.add(t.Pos, "undefined: %s", name)
+					return
+				}
+
+				if len(t.Args) != len(decl.params) {
+					errs.add(t.Pos, "wrong number of type arguments for %s: got %d, want %d",
+						name, len(t.Args), len(decl.params))
+				}
+			case CompactType:
+				if inner := t.Args[0]; !isUnsignedInteger(schema.expandAlias(inner)) {
+					errs.add(inner.Pos, "Compact is only defined for unsigned integers, found %s", inner)
+				}
+			}
+		})
+	}
+
+	for _, enum := range schema.Enums {
+		if len(enum.Variants) > MaxEnumVariants {
+			errs.add(enum.Pos, "enum %s has %d variants, at most %d are allowed",
+				enum.Name, len(enum.Variants), MaxEnumVariants)
+		}
+
+		variants := make(map[string]*VariantDecl, len(enum.Variants))
+		indexes := make(map[int]*VariantDecl, len(enum.Variants))
+		for _, variant := range enum.Variants {
+			checkVariantIndex(&errs, enum, variant, indexes)
+
+			if previous, ok := variants[variant.Name]; ok {
+				errs.add(variant.Pos, "duplicate variant %s in enum %s, previous declaration at %s",
+					variant.Name, enum.Name, previous.Pos)
+			} else {
+				variants[variant.Name] = variant
+			}
+
+			checkRefs(variant.Payload)
+			checkFields(&errs, "variant "+enum.Name+"::"+variant.Name,
+				variant.Fields, !variant.Named, checkRefs)
+		}
+	}
+
+	for _, structDecl := range schema.Structs {
+		checkFields(&errs, "struct "+structDecl.Name,
+			structDecl.Fields, structDecl.Tuple, checkRefs)
+	}
+
+	for _, alias := range schema.Aliases {
+		checkRefs(alias.Type)
+		if aliasRefersTo(schema, alias.Type, alias.Name, make(map[string]bool)) {
+			errs.add(alias.Pos, "invalid recursive type alias %s", alias.Name)
+		}
+	}
+
+	for _, newtype := range schema.Newtypes {
+		checkRefs(newtype.Type)
+	}
+
+	return errs
+}
+
+// declaration is the position and type parameters of a declared type
+type declaration struct {
+	pos    scanner.Position
+	params []*TypeParam
+}
+
+// declarations maps the types declared by an imported schema, which is
+// already checked, to their declaration
+func declarations(schema *Schema) map[string]declaration {
+	declared := make(map[string]declaration)
+	for _, enum := range schema.Enums {
+		declared[enum.Name] = declaration{pos: enum.Pos, params: enum.TypeParams}
+	}
+
+	for _, structDecl := range schema.Structs {
+		declared[structDecl.Name] = declaration{pos: structDecl.Pos, params: structDecl.TypeParams}
+	}
+
+	for _, alias := range schema.Aliases {
+		declared[alias.Name] = declaration{pos: alias.Pos}
+	}
+
+	for _, newtype := range schema.Newtypes {
+		declared[newtype.Name] = declaration{pos: newtype.Pos}
+	}
+
+	return declared
+}
+
+func checkTypeParams(errs *SchemaErrors, owner string, params []*TypeParam) {
+	names := make(map[string]*TypeParam, len(params))
+	for _, param := range params {
+		if previous, ok := names[param.Name]; ok {
+			errs.add(param.Pos, "duplicate type parameter %s in %s, previous declaration at %s",
+				param.Name, owner, previous.Pos)
+			continue
+		}
+		names[param.Name] = param
+	}
+}
+
+// checkVariantIndex ensures the variant index fits in the enum tag byte
+// and that no other variant of the enum is encoded with the same tag
+func checkVariantIndex(errs *SchemaErrors, enum *EnumDecl, variant *VariantDecl,
+	indexes map[int]*VariantDecl) {
+	pos := variant.Pos
+	if variant.ExplicitIndex {
+		pos = variant.IndexPos
+	}
+
+	// positional indexes out of range are already
+	// reported as an enum with too many variants
+	if variant.Index < 0 || variant.Index >= MaxEnumVariants {
+		if variant.ExplicitIndex {
+			errs.add(pos, "index %d of variant %s::%s does not fit in a byte",
+				variant.Index, enum.Name, variant.Name)
+		}
+		return
+	}
+
+	if previous, ok := indexes[variant.Index]; ok {
+		errs.add(pos, "index %d of variant %s::%s is already used by %s::%s",
+			variant.Index, enum.Name, variant.Name, enum.Name, previous.Name)
+		return
+	}
+
+	indexes[variant.Index] = variant
+}
+
+func checkFields(errs *SchemaErrors, owner string, fields []*FieldDecl,
+	tuple bool, checkRefs func(*TypeExpr)) {
+	names := make(map[string]*FieldDecl, len(fields))
+	for _, field := range fields {
+		checkRefs(field.Type)
+		if tuple {
+			continue
+		}
+
+		if previous, ok := names[field.Name]; ok {
+			errs.add(field.Pos, "duplicate field %s in %s, previous declaration at %s",
+				field.Name, owner, previous.Pos)
+			continue
+		}
+		names[field.Name] = field
+	}
+}
+
+// walkTypes calls visit for t and every type nested in it
+func walkTypes(t *TypeExpr, visit func(*TypeExpr)) {
+	if t == nil {
+		return
+	}
+
+	visit(t)
+	for _, arg := range t.Args {
+		walkTypes(arg, visit)
+	}
+}
+
+// aliasRefersTo reports whether t refers to the alias name, either directly
+// or through the other aliases it uses, visited avoids following twice the
+// aliases of a cycle that does not include name
+func aliasRefersTo(schema *Schema, t *TypeExpr, name string, visited map[string]bool) bool {
+	found := false
+	walkTypes(t, func(t *TypeExpr) {
+		if found || t.Kind != NamedType || t.Package != "" {
+			return
+		}
+
+		alias := schema.alias(t.Name)
+		if alias == nil {
+			return
+		}
+
+		if t.Name == name {
+			found = true
+			return
+		}
+
+		if !visited[t.Name] {
+			visited[t.Name] = true
+			found = aliasRefersTo(schema, alias.Type, name, visited)
+		}
+	})
+
+	return found
+}
+
+func isUnsignedInteger(t *TypeExpr) bool {
+	if t.Kind != PrimitiveType {
+		return false
+	}
+
+	switch primitiveName(t.Name) {
+	case "uint8", "uint16", "uint32", "uint64", "uint128":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,435 @@
+package scale_codec
+
+import (
+	"fmt"
+	"strings"
+	"text/scanner"
+)
+
+// SchemaChange is a difference between two versions of a schema, a breaking
+// change makes the data encoded with the previous version undecodable, or
+// decoded as something else, with the current one
+type SchemaChange struct {
+	Pos      scanner.Position
+	Breaking bool
+	Msg      string
+}
+
+func (c *SchemaChange) String() string {
+	if !c.Pos.IsValid() {
+		return c.Msg
+	}
+
+	return fmt.Sprintf("%s: %s", c.Pos, c.Msg)
+}
+
+// DiffSchemas compares two versions of a schema by their encoding: variant
+// indexes that are shifted, removed or reused, payloads and fields whose
+// types change, fields that are reordered, added or removed and removed
+// declarations are breaking, while new declarations, variants appended
+// with a new index and renames keeping the encoding are compatible.
+//
+// Aliases are expanded, the types declared in imported schemas are
+// compared by name and should be diffed on their own
+func DiffSchemas(previous, current *Schema) []*SchemaChange {
+	d := &schemaDiff{previous: previous, current: current}
+	previousDecls, currentDecls := declarationKinds(previous), declarationKinds(current)
+
+	for _, enum := range previous.Enums {
+		if d.checkKind("enum", enum.Name, enum.Pos, currentDecls) {
+			d.diffEnum(enum, current.enum(enum.Name))
+		}
+	}
+
+	for _, structDecl := range previous.Structs {
+		if d.checkKind("struct", structDecl.Name, structDecl.Pos, currentDecls) {
+			d.diffStruct(structDecl, current.structNamed(structDecl.Name))
+		}
+	}
+
+	for _, newtype := range previous.Newtypes {
+		if d.checkKind("newtype", newtype.Name, newtype.Pos, currentDecls) {
+			d.diffNewtype(newtype, current.newtype(newtype.Name))
+		}
+	}
+
+	for _, enum := range current.Enums {
+		d.checkAdded("enum", enum.Name, enum.Pos, previousDecls)
+	}
+
+	for _, structDecl := range current.Structs {
+		d.checkAdded("struct", structDecl.Name, structDecl.Pos, previousDecls)
+	}
+
+	for _, newtype := range current.Newtypes {
+		d.checkAdded("newtype", newtype.Name, newtype.Pos, previousDecls)
+	}
+
+	return d.changes
+}
+
+type schemaDiff struct {
+	previous, current *Schema
+	changes           []*SchemaChange
+}
+
+func (d *schemaDiff) breaking(pos scanner.Position, format string, args ...any) {
+	d.changes = append(d.changes, &SchemaChange{Pos: pos, Breaking: true, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (d *schemaDiff) compatible(pos scanner.Position, format string, args ...any) {
+	d.changes = append(d.changes, &SchemaChange{Pos: pos, Msg: fmt.Sprintf(format, args...)})
+}
+
+// declarationKinds maps the encoded declarations of a schema to their kind,
+// aliases are left out as they are compared wherever they are used
+func declarationKinds(schema *Schema) map[string]string {
+	kinds := make(map[string]string)
+	for _, enum := range schema.Enums {
+		kinds[enum.Name] = "enum"
+	}
+
+	for _, structDecl := range schema.Structs {
+		kinds[structDecl.Name] = "struct"
+	}
+
+	for _, newtype := range schema.Newtypes {
+		kinds[newtype.Name] = "newtype"
+	}
+
+	return kinds
+}
+
+// checkKind reports the previous declarations that are removed or whose
+// kind changes, it returns true when both versions can be compared
+func (d *schemaDiff) checkKind(kind, name string, pos scanner.Position, currentDecls map[string]string) bool {
+	currentKind, ok := currentDecls[name]
+	switch {
+	case !ok:
+		d.breaking(pos, "%s %s removed", kind, name)
+		return false
+	case currentKind != kind:
+		d.breaking(pos, "%s %s is now declared as %s", kind, name, currentKind)
+		return false
+	default:
+		return true
+	}
+}
+
+func (d *schemaDiff) checkAdded(kind, name string, pos scanner.Position, previousDecls map[string]string) {
+	if _, ok := previousDecls[name]; !ok {
+		d.compatible(pos, "%s %s added", kind, name)
+	}
+}
+
+// diffScope is a declaration in one of the versions, the type parameters
+// are compared by position so renaming them keeps the encoding
+type diffScope struct {
+	schema *Schema
+	params []*TypeParam
+}
+
+func (s diffScope) paramIndex(name string) int {
+	for idx, param := range s.params {
+		if param.Name == name {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+func (d *schemaDiff) scopes(previousParams, currentParams []*TypeParam) (diffScope, diffScope) {
+	return diffScope{d.previous, previousParams}, diffScope{d.current, currentParams}
+}
+
+func (d *schemaDiff) diffTypeParams(owner string, pos scanner.Position, previous, current []*TypeParam) {
+	if len(previous) != len(current) {
+		d.breaking(pos, "type parameters of %s changed from %s to %s", owner,
+			typeParamsString(previous), typeParamsString(current))
+	}
+}
+
+func typeParamsString(params []*TypeParam) string {
+	if len(params) == 0 {
+		return "none"
+	}
+
+	return "<" + strings.Join(typeParamNames(params), ", ") + ">"
+}
+
+func (d *schemaDiff) diffEnum(previous, current *EnumDecl) {
+	d.diffTypeParams("enum "+current.Name, current.Pos, previous.TypeParams, current.TypeParams)
+	previousScope, currentScope := d.scopes(previous.TypeParams, current.TypeParams)
+
+	previousByName := make(map[string]*VariantDecl, len(previous.Variants))
+	previousByIndex := make(map[int]*VariantDecl, len(previous.Variants))
+	for _, variant := range previous.Variants {
+		previousByName[variant.Name] = variant
+		previousByIndex[variant.Index] = variant
+	}
+
+	currentByName := make(map[string]*VariantDecl, len(current.Variants))
+	currentByIndex := make(map[int]*VariantDecl, len(current.Variants))
+	for _, variant := range current.Variants {
+		currentByName[variant.Name] = variant
+		currentByIndex[variant.Index] = variant
+	}
+
+	renamed := make(map[*VariantDecl]bool)
+	for _, previousVariant := range previous.Variants {
+		name := current.Name + "::" + previousVariant.Name
+		currentVariant := currentByName[previousVariant.Name]
+		if currentVariant == nil {
+			// a variant keeping its index and payload under a new name
+			// is still decoded from the same bytes
+			candidate := currentByIndex[previousVariant.Index]
+			if candidate != nil && previousByName[candidate.Name] == nil &&
+				sameEncoding(previousScope, currentScope, variantTypes(previousVariant), variantTypes(candidate)) {
+				renamed[candidate] = true
+				d.compatible(candidate.Pos, "variant %s renamed to %s", name, candidate.Name)
+				continue
+			}
+
+			d.breaking(previousVariant.Pos, "variant %s with index %d removed", name, previousVariant.Index)
+			continue
+		}
+
+		if currentVariant.Index != previousVariant.Index {
+			d.breaking(currentVariant.indexPos(), "variant %s index changed from %d to %d",
+				name, previousVariant.Index, currentVariant.Index)
+		}
+
+		d.diffVariant(previousScope, currentScope, name, previousVariant, currentVariant)
+	}
+
+	for _, currentVariant := range current.Variants {
+		if previousByName[currentVariant.Name] != nil || renamed[currentVariant] {
+			continue
+		}
+
+		name := current.Name + "::" + currentVariant.Name
+		if previousVariant := previousByIndex[currentVariant.Index]; previousVariant != nil {
+			d.breaking(currentVariant.indexPos(), "variant %s added with index %d, which was the index of %s::%s",
+				name, currentVariant.Index, current.Name, previousVariant.Name)
+			continue
+		}
+
+		d.compatible(currentVariant.Pos, "variant %s added with index %d", name, currentVariant.Index)
+	}
+}
+
+// variantTypes returns the types encoded by a variant, its payload or fields
+func variantTypes(variant *VariantDecl) []*TypeExpr {
+	if variant.Payload != nil {
+		return []*TypeExpr{variant.Payload}
+	}
+
+	types := make([]*TypeExpr, len(variant.Fields))
+	for idx, field := range variant.Fields {
+		types[idx] = field.Type
+	}
+
+	return types
+}
+
+func (d *schemaDiff) diffVariant(previousScope, currentScope diffScope, name string, previous, current *VariantDecl) {
+	if previous.Named && current.Named {
+		d.diffFields("variant "+name, current.Pos, previousScope, currentScope, previous.Fields, current.Fields)
+		return
+	}
+
+	if !sameEncoding(previousScope, currentScope, variantTypes(previous), variantTypes(current)) {
+		d.breaking(current.Pos, "payload of variant %s changed from %s to %s", name,
+			typesString(d.previous, variantTypes(previous)), typesString(d.current, variantTypes(current)))
+	}
+}
+
+func (d *schemaDiff) diffStruct(previous, current *StructDecl) {
+	owner := "struct " + current.Name
+	d.diffTypeParams(owner, current.Pos, previous.TypeParams, current.TypeParams)
+	previousScope, currentScope := d.scopes(previous.TypeParams, current.TypeParams)
+	if !previous.Tuple && !current.Tuple {
+		d.diffFields(owner, current.Pos, previousScope, currentScope, previous.Fields, current.Fields)
+		return
+	}
+
+	previousTypes, currentTypes := fieldTypes(previous.Fields), fieldTypes(current.Fields)
+	if !sameEncoding(previousScope, currentScope, previousTypes, currentTypes) {
+		d.breaking(current.Pos, "fields of %s changed from %s to %s", owner,
+			typesString(d.previous, previousTypes), typesString(d.current, currentTypes))
+	}
+}
+
+func (d *schemaDiff) diffNewtype(previous, current *NewtypeDecl) {
+	previousScope, currentScope := d.scopes(nil, nil)
+	if !sameEncoding(previousScope, currentScope, []*TypeExpr{previous.Type}, []*TypeExpr{current.Type}) {
+		d.breaking(current.Type.Pos, "newtype %s changed from %s to %s", current.Name,
+			typeString(d.previous, previous.Type), typeString(d.current, current.Type))
+	}
+}
+
+// diffFields compares named fields, which are encoded in order: fields
+// that move, change their type or are added or removed are breaking, a
+// field renamed in place keeping its type is compatible
+func (d *schemaDiff) diffFields(owner string, pos scanner.Position, previousScope, currentScope diffScope,
+	previous, current []*FieldDecl) {
+	previousIdx := fieldIndexes(previous)
+	currentIdx := fieldIndexes(current)
+
+	renamed := make(map[string]bool)
+	for idx, field := range previous {
+		currentAt, ok := currentIdx[field.Name]
+		if !ok {
+			if idx < len(current) {
+				candidate := current[idx]
+				if _, existed := previousIdx[candidate.Name]; !existed &&
+					sameEncoding(previousScope, currentScope, []*TypeExpr{field.Type}, []*TypeExpr{candidate.Type}) {
+					renamed[candidate.Name] = true
+					d.compatible(candidate.Pos, "field %s of %s renamed to %s", field.Name, owner, candidate.Name)
+					continue
+				}
+			}
+
+			d.breaking(pos, "field %s of %s removed", field.Name, owner)
+			continue
+		}
+
+		currentField := current[currentAt]
+		if currentAt != idx {
+			d.breaking(currentField.Pos, "field %s of %s moved from position %d to %d", field.Name, owner, idx, currentAt)
+		}
+
+		if !sameEncoding(previousScope, currentScope, []*TypeExpr{field.Type}, []*TypeExpr{currentField.Type}) {
+			d.breaking(currentField.Type.Pos, "type of field %s of %s changed from %s to %s",
+				field.Name, owner, typeString(d.previous, field.Type), typeString(d.current, currentField.Type))
+		}
+	}
+
+	for _, field := range current {
+		if _, ok := previousIdx[field.Name]; !ok && !renamed[field.Name] {
+			d.breaking(field.Pos, "field %s of %s added", field.Name, owner)
+		}
+	}
+}
+
+func fieldIndexes(fields []*FieldDecl) map[string]int {
+	indexes := make(map[string]int, len(fields))
+	for idx, field := range fields {
+		indexes[field.Name] = idx
+	}
+
+	return indexes
+}
+
+func fieldTypes(fields []*FieldDecl) []*TypeExpr {
+	types := make([]*TypeExpr, len(fields))
+	for idx, field := range fields {
+		types[idx] = field.Type
+	}
+
+	return types
+}
+
+func typesString(schema *Schema, types []*TypeExpr) string {
+	if len(types) == 0 {
+		return "nothing"
+	}
+
+	if len(types) == 1 {
+		return typeString(schema, types[0])
+	}
+
+	items := make([]string, len(types))
+	for idx, t := range types {
+		items[idx] = typeString(schema, t)
+	}
+
+	return "(" + strings.Join(items, ", ") + ")"
+}
+
+// typeString writes t as in the schema followed by its expansion when t is
+// an alias, `Balance = u64`
+func typeString(schema *Schema, t *TypeExpr) string {
+	if expanded := schema.expandAlias(t); expanded != t {
+		return t.String() + " = " + expanded.String()
+	}
+
+	return t.String()
+}
+
+// sameEncoding reports whether two sequences of types are encoded the same
+// way, the tuples are flattened as they are encoded as their items and the
+// unit, which encodes to nothing, is dropped
+func sameEncoding(previousScope, currentScope diffScope, previous, current []*TypeExpr) bool {
+	previous = flattenEncoding(previousScope.schema, previous, nil)
+	current = flattenEncoding(currentScope.schema, current, nil)
+	if len(previous) != len(current) {
+		return false
+	}
+
+	for idx := range previous {
+		if !sameType(previousScope, currentScope, previous[idx], current[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenEncoding(schema *Schema, types []*TypeExpr, flat []*TypeExpr) []*TypeExpr {
+	for _, t := range types {
+		t = schema.expandAlias(t)
+		switch t.Kind {
+		case UnitType:
+		case TupleType:
+			flat = flattenEncoding(schema, t.Args, flat)
+		default:
+			flat = append(flat, t)
+		}
+	}
+
+	return flat
+}
+
+func sameType(previousScope, currentScope diffScope, previous, current *TypeExpr) bool {
+	previous = previousScope.schema.expandAlias(previous)
+	current = currentScope.schema.expandAlias(current)
+	if isBytesType(previous) && isBytesType(current) {
+		return true
+	}
+
+	if previous.Kind != current.Kind || len(previous.Args) != len(current.Args) {
+		return false
+	}
+
+	switch previous.Kind {
+	case PrimitiveType:
+		return primitiveName(previous.Name) == primitiveName(current.Name)
+	case ParamType:
+		return previousScope.paramIndex(previous.Name) == currentScope.paramIndex(current.Name)
+	case NamedType:
+		if previous.Package != current.Package || previous.Name != current.Name {
+			return false
+		}
+	case ArrayType:
+		if previous.Len != current.Len {
+			return false
+		}
+	case TupleType:
+		return sameEncoding(previousScope, currentScope, previous.Args, current.Args)
+	}
+
+	for idx := range previous.Args {
+		if !sameType(previousScope, currentScope, previous.Args[idx], current.Args[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isBytesType reports whether t is Bytes or Vec<u8>, which are encoded alike
+func isBytesType(t *TypeExpr) bool {
+	return (t.Kind == PrimitiveType && t.Name == "Bytes") || (t.Kind == VecType && isByte(t.Args[0]))
+}
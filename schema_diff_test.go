@@ -0,0 +1,115 @@
+package scale_codec
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiffSchemas(t *testing.T) {
+	cases := []struct {
+		previous   string
+		current    string
+		breaking   []string
+		compatible []string
+	}{
+		{
+			previous: "enum Call {\n\tRemark\n\tSend(u64)\n}",
+			current:  "enum Call {\n\tRemark\n\tSend(uint64)\n\tBurn(Bytes)\n}",
+			compatible: []string{
+				"new.scale:4:2: variant Call::Burn added with index 2",
+			},
+		},
+		{
+			previous: "enum Call {\n\tRemark\n\tSend(u64)\n\tBurn\n}",
+			current:  "enum Call {\n\tRemark\n\tMint(bool)\n\tSend(u64)\n}",
+			breaking: []string{
+				"new.scale:4:2: variant Call::Send index changed from 1 to 2",
+				"old.scale:4:2: variant Call::Burn with index 2 removed",
+				"new.scale:3:2: variant Call::Mint added with index 1, which was the index of Call::Send",
+			},
+		},
+		{
+			previous: "enum Call {\n\tRemark\n\t@index(4) Send(u64, bool)\n}",
+			current:  "enum Call {\n\tNoop\n\t@index(4) Transfer((u64, bool))\n\tSend(u32)\n}",
+			breaking: []string{
+				"new.scale:4:2: variant Call::Send index changed from 4 to 2",
+				"new.scale:4:2: payload of variant Call::Send changed from (u64, bool) to u32",
+				"new.scale:3:9: variant Call::Transfer added with index 4, which was the index of Call::Send",
+			},
+			compatible: []string{
+				"new.scale:2:2: variant Call::Remark renamed to Noop",
+			},
+		},
+		{
+			previous: "struct Transfer {\n\tto: u32,\n\tamount: u64,\n\tmemo: Bytes,\n}",
+			current:  "struct Transfer {\n\tamount: u64,\n\tto: u32,\n\tnote: Vec<u8>,\n\tfee: u8,\n}",
+			breaking: []string{
+				"new.scale:3:2: field to of struct Transfer moved from position 0 to 1",
+				"new.scale:2:2: field amount of struct Transfer moved from position 1 to 0",
+				"new.scale:5:2: field fee of struct Transfer added",
+			},
+			compatible: []string{
+				"new.scale:4:2: field memo of struct Transfer renamed to note",
+			},
+		},
+		{
+			previous: "type Balance = u64;\n\nnewtype AccountId([u8; 32])\n\nenum Event {\n\tPaid { who: AccountId, amount: Balance }\n}",
+			current:  "type Balance = u128;\n\nnewtype AccountId([u8; 20])\n\nenum Event {\n\tPaid { who: AccountId, amount: Balance }\n}\n\nstruct Fee(u8)",
+			breaking: []string{
+				"new.scale:6:33: type of field amount of variant Event::Paid changed from Balance = u64 to Balance = u128",
+				"new.scale:3:19: newtype AccountId changed from [u8; 32] to [u8; 20]",
+			},
+			compatible: []string{
+				"new.scale:9:8: struct Fee added",
+			},
+		},
+		{
+			previous: "enum MaybeRef<T> {\n\tInline(T)\n}\n\nstruct Pair<A, B>(A, B)",
+			current:  "enum MaybeRef<U> {\n\tInline(U)\n}\n\nenum Pair<A, B> {\n\tBoth(A, B)\n}",
+			breaking: []string{
+				"old.scale:5:8: struct Pair is now declared as enum",
+			},
+		},
+		{
+			previous: "enum Call {\n\tRemark\n}\n\nstruct Empty {}",
+			current:  "enum Call<T> {\n\tRemark\n\tInline(T)\n}",
+			breaking: []string{
+				"new.scale:1:6: type parameters of enum Call changed from none to <T>",
+				"old.scale:5:8: struct Empty removed",
+			},
+			compatible: []string{
+				"new.scale:3:2: variant Call::Inline added with index 1",
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		previous, err := ParseSchema("old.scale", strings.NewReader(tt.previous))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		current, err := ParseSchema("new.scale", strings.NewReader(tt.current))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var breaking, compatible []string
+		for _, change := range DiffSchemas(previous, current) {
+			if change.Breaking {
+				breaking = append(breaking, change.String())
+			} else {
+				compatible = append(compatible, change.String())
+			}
+		}
+
+		if !reflect.DeepEqual(tt.breaking, breaking) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.breaking, breaking)
+		}
+
+		if !reflect.DeepEqual(tt.compatible, compatible) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.compatible, compatible)
+		}
+	}
+}
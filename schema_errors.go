@@ -0,0 +1,46 @@
+package scale_codec
+
+import (
+	"fmt"
+	"strings"
+	"text/scanner"
+)
+
+// SchemaError is a syntax or semantic error found in a .scale file,
+// it prints as `file.scale:line:column: message`
+type SchemaError struct {
+	Pos scanner.Position
+	Msg string
+}
+
+func (e *SchemaError) Error() string {
+	if !e.Pos.IsValid() {
+		return e.Msg
+	}
+
+	return fmt.Sprintf("%s: %s", e.Pos, e.Msg)
+}
+
+// SchemaErrors holds every error collected while processing a schema
+type SchemaErrors []*SchemaError
+
+func (e SchemaErrors) Error() string {
+	messages := make([]string, len(e))
+	for idx, schemaErr := range e {
+		messages[idx] = schemaErr.Error()
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+func (e *SchemaErrors) add(pos scanner.Position, format string, args ...any) {
+	*e = append(*e, &SchemaError{Pos: pos, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (e SchemaErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
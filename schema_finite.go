@@ -0,0 +1,132 @@
+package scale_codec
+
+// finiteVariants returns the enum variants, of schema and of the schemas it
+// imports, whose values can be built without nesting their enum in itself.
+// The tests generated by enum_script -tests only pick these variants once
+// the values reach their maximum depth, so the generated values are finite.
+//
+// Options, sequences and maps are finite as they can be None or empty, type
+// parameters are assumed finite, a struct is finite when all its fields are,
+// a Result when one of its sides is and an enum when one of its variants is
+func finiteVariants(schema *Schema) map[*VariantDecl]bool {
+	schemas := reachableSchemas(schema, nil, make(map[*Schema]bool))
+	finite := make(map[any]bool)
+	variants := make(map[*VariantDecl]bool)
+
+	var isFinite func(scope *Schema, t *TypeExpr) bool
+	isFinite = func(scope *Schema, t *TypeExpr) bool {
+		switch t.Kind {
+		case NamedType:
+			owner := scope
+			if t.Package != "" {
+				imp := scope.importNamed(t.Package)
+				if imp == nil || imp.Schema == nil {
+					return false
+				}
+				owner = imp.Schema
+			}
+
+			if alias := owner.alias(t.Name); alias != nil {
+				return isFinite(owner, alias.Type)
+			}
+
+			if enum := owner.enum(t.Name); enum != nil {
+				return finite[enum]
+			}
+
+			if structDecl := owner.structNamed(t.Name); structDecl != nil {
+				return finite[structDecl]
+			}
+
+			if newtype := owner.newtype(t.Name); newtype != nil {
+				return finite[newtype]
+			}
+
+			return false
+		case ArrayType:
+			return t.Len == 0 || isFinite(scope, t.Args[0])
+		case TupleType:
+			for _, arg := range t.Args {
+				if !isFinite(scope, arg) {
+					return false
+				}
+			}
+			return true
+		case ResultType:
+			return isFinite(scope, t.Args[0]) || isFinite(scope, t.Args[1])
+		default:
+			return true
+		}
+	}
+
+	fieldsFinite := func(scope *Schema, fields []*FieldDecl) bool {
+		for _, field := range fields {
+			if !isFinite(scope, field.Type) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// a declaration becomes finite once the declarations it references are,
+	// so the sets grow round after round until they stop changing. Every
+	// round only uses the declarations of the previous rounds, and the
+	// variants of an enum are the ones of the round the enum became finite,
+	// so the values of these variants do not nest their enum in themselves
+	for changed := true; changed; {
+		changed = false
+		var marked []any
+		mark := func(decl any, isDeclFinite bool) {
+			if isDeclFinite && !finite[decl] {
+				marked = append(marked, decl)
+			}
+		}
+
+		for _, scope := range schemas {
+			for _, enum := range scope.Enums {
+				if finite[enum] {
+					continue
+				}
+
+				for _, variant := range enum.Variants {
+					if fieldsFinite(scope, variant.Fields) &&
+						(variant.Payload == nil || isFinite(scope, variant.Payload)) {
+						variants[variant] = true
+						mark(enum, true)
+					}
+				}
+			}
+
+			for _, structDecl := range scope.Structs {
+				mark(structDecl, fieldsFinite(scope, structDecl.Fields))
+			}
+
+			for _, newtype := range scope.Newtypes {
+				mark(newtype, isFinite(scope, newtype.Type))
+			}
+		}
+
+		for _, decl := range marked {
+			changed = changed || !finite[decl]
+			finite[decl] = true
+		}
+	}
+
+	return variants
+}
+
+// reachableSchemas appends schema and the schemas it imports, directly
+// or not, to schemas, every schema is appended once
+func reachableSchemas(schema *Schema, schemas []*Schema, seen map[*Schema]bool) []*Schema {
+	if schema == nil || seen[schema] {
+		return schemas
+	}
+
+	seen[schema] = true
+	schemas = append(schemas, schema)
+	for _, imp := range schema.Imports {
+		schemas = reachableSchemas(imp.Schema, schemas, seen)
+	}
+
+	return schemas
+}
@@ -0,0 +1,57 @@
+package scale_codec
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFiniteVariants(t *testing.T) {
+	const src = `
+struct Node {
+	value: u32,
+	next: Tree,
+}
+
+enum Tree {
+	Leaf
+	Branch(Node)
+	Forest(Vec<Tree>)
+	Pair(Tree, Tree)
+	Either(Result<Tree, u8>)
+	Empty([Tree; 0])
+	Stuck(Node, Loop)
+}
+
+enum Loop {
+	Again(Loop)
+}
+
+enum Wrapped<T> {
+	Value(T)
+	Looped(Loop)
+}`
+
+	schema, err := ParseSchema("test.scale", strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	finite := finiteVariants(schema)
+	expected := map[string][]bool{
+		"Tree":    {true, false, true, false, true, true, false},
+		"Loop":    {false},
+		"Wrapped": {true, false},
+	}
+
+	for _, enum := range schema.Enums {
+		actual := make([]bool, len(enum.Variants))
+		for idx, variant := range enum.Variants {
+			actual[idx] = finite[variant]
+		}
+
+		if !reflect.DeepEqual(expected[enum.Name], actual) {
+			t.Fatalf("%s\nexpected: %v\ngot: %v", enum.Name, expected[enum.Name], actual)
+		}
+	}
+}
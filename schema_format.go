@@ -0,0 +1,255 @@
+package scale_codec
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/scanner"
+)
+
+// FormatSchema returns the .scale file src in its canonical format: one
+// tab per indentation level, a blank line around enums and structs, one
+// variant or field per line, unit variant indexes written as `X = N` and
+// the other indexes as `@index(N) X(...)`. Comments are kept next to the
+// declaration, variant or field they precede or follow, blank lines of
+// the source are kept but never more than one in a row
+func FormatSchema(filename string, src []byte) ([]byte, error) {
+	schema, err := ParseSchemaSyntax(filename, bytes.NewReader(src))
+	if err != nil {
+		return nil, err
+	}
+
+	f := &schemaFormatter{comments: schema.Comments}
+	var previousBlock bool
+	for idx, decl := range sourceDecls(schema) {
+		// enums and structs are always separated from their neighbours
+		f.blank = idx > 0 && (decl.block || previousBlock)
+		decl.format(f)
+		previousBlock = decl.block
+	}
+
+	f.flushComments(-1, 0)
+	if len(f.lines) == 0 {
+		return []byte{}, nil
+	}
+
+	return []byte(strings.Join(f.lines, "\n") + "\n"), nil
+}
+
+// schemaFormatter writes the formatted lines of a schema, the comments are
+// written before the first line that follows them in the source or at the
+// end of the line they share with the previous written line
+type schemaFormatter struct {
+	lines    []string
+	comments []*Comment
+
+	// lastLine is the source line where the last written line ends and
+	// blank asks for a blank line before the next written line
+	lastLine int
+	blank    bool
+}
+
+// line writes text at depth, it starts at pos and ends at endLine in the
+// source. The comments before pos are written first, at commentDepth
+func (f *schemaFormatter) line(pos scanner.Position, endLine, depth, commentDepth int, text string) {
+	f.flushComments(pos.Offset, commentDepth)
+	f.write(pos.Line, depth, text)
+	f.lastLine = endLine
+}
+
+// closer writes the closing delimiter of a block, the comments left
+// before it are written inside the block
+func (f *schemaFormatter) closer(pos scanner.Position, depth int, text string) {
+	f.flushComments(pos.Offset, depth+1)
+	f.blank = false
+	f.lines = append(f.lines, strings.Repeat("\t", depth)+text)
+	f.lastLine = pos.Line
+}
+
+// flushComments writes the comments found before offset, or
+// every comment left when offset is negative
+func (f *schemaFormatter) flushComments(offset, depth int) {
+	for len(f.comments) > 0 && (offset < 0 || f.comments[0].Pos.Offset < offset) {
+		comment := f.comments[0]
+		f.comments = f.comments[1:]
+
+		endLine := comment.Pos.Line + strings.Count(comment.Text, "\n")
+		if len(f.lines) > 0 && comment.Pos.Line == f.lastLine {
+			f.lines[len(f.lines)-1] += " " + comment.Text
+		} else {
+			f.write(comment.Pos.Line, depth, comment.Text)
+		}
+		f.lastLine = endLine
+	}
+}
+
+func (f *schemaFormatter) write(line, depth int, text string) {
+	if len(f.lines) > 0 && (f.blank || line-f.lastLine > 1) {
+		previous := f.lines[len(f.lines)-1]
+		if previous != "" && !strings.HasSuffix(previous, "{") {
+			f.lines = append(f.lines, "")
+		}
+	}
+
+	f.blank = false
+	f.lines = append(f.lines, strings.Repeat("\t", depth)+text)
+}
+
+// formattedDecl is a top level declaration of the schema
+type formattedDecl struct {
+	pos    scanner.Position
+	block  bool
+	format func(f *schemaFormatter)
+}
+
+// sourceDecls returns the declarations of schema in source order
+func sourceDecls(schema *Schema) []formattedDecl {
+	var decls []formattedDecl
+
+	for _, imp := range schema.Imports {
+		imp := imp
+		decls = append(decls, formattedDecl{pos: imp.Pos, format: func(f *schemaFormatter) {
+			text := "import " + fmt.Sprintf("%q", imp.Path)
+			if imp.Name != importName(imp.Path) {
+				text += " as " + imp.Name
+			}
+			f.line(imp.Pos, imp.Pos.Line, 0, 0, text)
+		}})
+	}
+
+	for _, alias := range schema.Aliases {
+		alias := alias
+		decls = append(decls, formattedDecl{pos: alias.Pos, format: func(f *schemaFormatter) {
+			f.line(alias.Pos, typeEndLine(alias.Type), 0, 0,
+				fmt.Sprintf("type %s = %s;", alias.Name, alias.Type))
+		}})
+	}
+
+	for _, newtype := range schema.Newtypes {
+		newtype := newtype
+		decls = append(decls, formattedDecl{pos: newtype.Pos, format: func(f *schemaFormatter) {
+			f.line(newtype.Pos, typeEndLine(newtype.Type), 0, 0,
+				fmt.Sprintf("newtype %s(%s)", newtype.Name, newtype.Type))
+		}})
+	}
+
+	for _, structDecl := range schema.Structs {
+		decls = append(decls, formattedDecl{
+			pos:    structDecl.Pos,
+			block:  !structDecl.Tuple && len(structDecl.Fields) > 0,
+			format: structDecl.format,
+		})
+	}
+
+	for _, enum := range schema.Enums {
+		decls = append(decls, formattedDecl{pos: enum.Pos, block: true, format: enum.format})
+	}
+
+	sort.SliceStable(decls, func(i, j int) bool {
+		return decls[i].pos.Offset < decls[j].pos.Offset
+	})
+	return decls
+}
+
+func (e *EnumDecl) format(f *schemaFormatter) {
+	f.line(e.Pos, e.Pos.Line, 0, 0, "enum "+e.Name+typeParamsDecl(e.TypeParams)+" {")
+	for _, variant := range e.Variants {
+		variant.format(f, 1)
+	}
+	f.closer(e.End, 0, "}")
+}
+
+func (v *VariantDecl) format(f *schemaFormatter, depth int) {
+	start := v.Pos
+	if v.ExplicitIndex && v.IndexPos.Offset < start.Offset {
+		start = v.IndexPos
+	}
+
+	endLine := v.Pos.Line
+	if v.End.IsValid() {
+		endLine = v.End.Line
+	}
+
+	text := v.Name
+	if v.ExplicitIndex && v.Payload == nil && len(v.Fields) == 0 && !v.Named {
+		text += fmt.Sprintf(" = %d", v.Index)
+	} else if v.ExplicitIndex {
+		text = fmt.Sprintf("@index(%d) %s", v.Index, v.Name)
+	}
+
+	switch {
+	case v.Payload != nil:
+		text += "(" + v.Payload.String() + ")"
+	case v.Named && len(v.Fields) == 0:
+		text += " {}"
+	case v.Named && v.End.Line > v.Pos.Line:
+		// multi-line named variants keep a field per line
+		f.line(start, v.Pos.Line, depth, depth, text+" {")
+		for _, field := range v.Fields {
+			field.format(f, depth+1)
+		}
+		f.closer(v.End, depth, "}")
+		return
+	case v.Named:
+		text += " { " + strings.Join(fieldStrings(v.Fields), ", ") + " }"
+	case len(v.Fields) > 0:
+		text += "(" + strings.Join(fieldStrings(v.Fields), ", ") + ")"
+	}
+
+	f.line(start, endLine, depth, depth, text)
+}
+
+func (s *StructDecl) format(f *schemaFormatter) {
+	head := "struct " + s.Name + typeParamsDecl(s.TypeParams)
+	switch {
+	case s.Tuple:
+		f.line(s.Pos, s.End.Line, 0, 0, head+"("+strings.Join(fieldStrings(s.Fields), ", ")+")")
+	case len(s.Fields) == 0:
+		f.line(s.Pos, s.End.Line, 0, 0, head+" {}")
+	default:
+		f.line(s.Pos, s.Pos.Line, 0, 0, head+" {")
+		for _, field := range s.Fields {
+			field.format(f, 1)
+		}
+		f.closer(s.End, 0, "}")
+	}
+}
+
+func (d *FieldDecl) format(f *schemaFormatter, depth int) {
+	f.line(d.Pos, typeEndLine(d.Type), depth, depth, d.Name+": "+d.Type.String()+",")
+}
+
+// fieldStrings returns the fields as written on a single line,
+// `name: Type` for named fields and `Type` for unnamed ones
+func fieldStrings(fields []*FieldDecl) []string {
+	output := make([]string, len(fields))
+	for idx, field := range fields {
+		output[idx] = field.Type.String()
+		if field.Name != "" {
+			output[idx] = field.Name + ": " + output[idx]
+		}
+	}
+
+	return output
+}
+
+func typeParamsDecl(params []*TypeParam) string {
+	if len(params) == 0 {
+		return ""
+	}
+
+	return "<" + strings.Join(typeParamNames(params), ", ") + ">"
+}
+
+// typeEndLine returns the last source line holding a part of t
+func typeEndLine(t *TypeExpr) int {
+	line := t.Pos.Line
+	walkTypes(t, func(t *TypeExpr) {
+		if t.Pos.Line > line {
+			line = t.Pos.Line
+		}
+	})
+
+	return line
+}
@@ -0,0 +1,58 @@
+package scale_codec
+
+import (
+	"testing"
+)
+
+func TestFormatSchema(t *testing.T) {
+	cases := []struct {
+		src      string
+		expected string
+	}{
+		{
+			src:      "enum Error {\n    FailureX\n\tFailureY = 3\n}\n",
+			expected: "enum Error {\n\tFailureX\n\tFailureY = 3\n}\n",
+		},
+		{
+			src:      "import \"common.scale\";\nimport \"other.scale\" as o\ntype Balance = u64\nnewtype Nonce( u32 );\nstruct S { a: u8, b: Vec< u8 > }\nstruct Empty {}\nstruct Pair(u8,bool)\n",
+			expected: "import \"common.scale\"\nimport \"other.scale\" as o\ntype Balance = u64;\nnewtype Nonce(u32)\n\nstruct S {\n\ta: u8,\n\tb: Vec<u8>,\n}\n\nstruct Empty {}\nstruct Pair(u8, bool)\n",
+		},
+		{
+			src:      "enum Call<T> {\n  Remark   @index( 3 ) Send(T)\n  Move { to: u32,\n    amount: u64 }\n  Pay { amount: u64 }\n  Split(u8, bool)\n}\nenum Empty {}",
+			expected: "enum Call<T> {\n\tRemark\n\t@index(3) Send(T)\n\tMove {\n\t\tto: u32,\n\t\tamount: u64,\n\t}\n\tPay { amount: u64 }\n\tSplit(u8, bool)\n}\n\nenum Empty {\n}\n",
+		},
+		{
+			src:      "// header\n\n\n// Call doc\nenum Call { // calls\n  Remark // no data\n\n\n  /* payload */ Send(u64)\n  // last\n}\n// trailing\n",
+			expected: "// header\n\n// Call doc\nenum Call { // calls\n\tRemark // no data\n\n\t/* payload */\n\tSend(u64)\n\t// last\n}\n// trailing\n",
+		},
+		{
+			src:      "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range cases {
+		output, err := FormatSchema("test.scale", []byte(tt.src))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if string(output) != tt.expected {
+			t.Fatalf("\nexpected: %q\ngot: %q", tt.expected, output)
+		}
+
+		// formatting is idempotent
+		again, err := FormatSchema("test.scale", output)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if string(again) != string(output) {
+			t.Fatalf("\nexpected: %q\ngot: %q", output, again)
+		}
+	}
+
+	if _, err := FormatSchema("test.scale", []byte("enum Call {\n\tSend(u64\n}")); err == nil {
+		t.Fatalf("expected a syntax error")
+	}
+}
@@ -0,0 +1,152 @@
+package scale_codec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// schemaLoader parses a schema and the schemas it imports, every file
+// is parsed once and import cycles are reported at the import declaration
+type schemaLoader struct {
+	loaded  map[string]*Schema
+	loading map[string]bool
+}
+
+func newSchemaLoader() *schemaLoader {
+	return &schemaLoader{
+		loaded:  make(map[string]*Schema),
+		loading: make(map[string]bool),
+	}
+}
+
+func (l *schemaLoader) parse(filename string, src io.Reader) (*Schema, error) {
+	lexer := newLexer(filename, src)
+	yyParse(lexer)
+
+	if len(lexer.errs) > 0 {
+		return lexer.schema, lexer.errs
+	}
+
+	if errs := l.loadImports(lexer.schema); len(errs) > 0 {
+		return lexer.schema, errs
+	}
+
+	return lexer.schema, checkSchema(lexer.schema).err()
+}
+
+// loadImports parses the imported schemas, their paths are
+// relative to the directory of the importing schema
+func (l *schemaLoader) loadImports(schema *Schema) SchemaErrors {
+	var errs SchemaErrors
+	for _, imp := range schema.Imports {
+		path := imp.Path
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(schema.Filename), path)
+		}
+
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			errs.add(imp.Pos, "cannot import %q: %v", imp.Path, err)
+			continue
+		}
+
+		if l.loading[absPath] {
+			errs.add(imp.Pos, "import cycle not allowed: %s imports %s", schema.Filename, imp.Path)
+			continue
+		}
+
+		if loaded, ok := l.loaded[absPath]; ok {
+			imp.Schema = loaded
+			continue
+		}
+
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			var pathErr *os.PathError
+			if errors.As(err, &pathErr) {
+				err = pathErr.Err
+			}
+			errs.add(imp.Pos, "cannot import %q: %v", imp.Path, err)
+			continue
+		}
+
+		l.loading[absPath] = true
+		imported, err := l.parse(path, bytes.NewReader(contents))
+		delete(l.loading, absPath)
+
+		var importedErrs SchemaErrors
+		if errors.As(err, &importedErrs) {
+			errs = append(errs, importedErrs...)
+			continue
+		}
+
+		l.loaded[absPath] = imported
+		imp.Schema = imported
+	}
+
+	return errs
+}
+
+// importName is the default qualifier of an import, the file name without extension
+func importName(path string) string {
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+func isValidImportName(name string) bool {
+	return token.IsIdentifier(name)
+}
+
+// goImportPath maps a schema file to the import path of the Go package
+// generated next to it, using the module declared by the closest go.mod
+func goImportPath(schemaFile string) (string, error) {
+	dir, err := filepath.Abs(filepath.Dir(schemaFile))
+	if err != nil {
+		return "", err
+	}
+
+	for moduleDir := dir; ; moduleDir = filepath.Dir(moduleDir) {
+		contents, err := os.ReadFile(filepath.Join(moduleDir, "go.mod"))
+		if err == nil {
+			modulePath := modulePath(contents)
+			if modulePath == "" {
+				return "", fmt.Errorf("no module declared in %s", filepath.Join(moduleDir, "go.mod"))
+			}
+
+			rel, err := filepath.Rel(moduleDir, dir)
+			if err != nil {
+				return "", err
+			}
+
+			if rel == "." {
+				return modulePath, nil
+			}
+			return modulePath + "/" + filepath.ToSlash(rel), nil
+		}
+
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+
+		if parent := filepath.Dir(moduleDir); parent == moduleDir {
+			return "", fmt.Errorf("cannot find the Go package of %s: no go.mod found", schemaFile)
+		}
+	}
+}
+
+func modulePath(goMod []byte) string {
+	for _, line := range strings.Split(string(goMod), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`)
+		}
+	}
+
+	return ""
+}
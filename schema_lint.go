@@ -0,0 +1,187 @@
+package scale_codec
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/scanner"
+	"unicode"
+)
+
+// lintVariantsMargin is how close to MaxEnumVariants the indexes of an
+// enum must get before the linter warns that the enum is almost full
+const lintVariantsMargin = 16
+
+// builtinTypes are the type names the schema language defines, a
+// declaration named after one of them cannot be referenced
+var builtinTypes = map[string]bool{
+	"Option": true, "Result": true, "Vec": true, "Compact": true,
+	"BTreeMap": true, "BTreeSet": true, "bool": true, "String": true, "Bytes": true,
+	"int8": true, "uint8": true, "int16": true, "uint16": true, "int32": true, "uint32": true,
+	"int64": true, "uint64": true, "int128": true, "uint128": true,
+	"i8": true, "u8": true, "i16": true, "u16": true, "i32": true, "u32": true,
+	"i64": true, "u64": true, "i128": true, "u128": true,
+}
+
+// LintSchemas reports the style issues and likely mistakes of valid schemas:
+// enums that no other declaration of the schemas uses, unless they are
+// listed in roots, declarations named after a built-in type, variants
+// whose name is not PascalCase and enums whose indexes get close to the
+// MaxEnumVariants limit. References through imports are resolved by file,
+// so an enum of a common schema used by another linted schema is used
+func LintSchemas(schemas []*Schema, roots []string) SchemaErrors {
+	var errs SchemaErrors
+
+	isRoot := make(map[string]bool, len(roots))
+	for _, root := range roots {
+		isRoot[root] = true
+	}
+
+	used := make(map[lintRef]bool)
+	for _, schema := range schemas {
+		markUsed(schema, used)
+	}
+
+	for _, schema := range schemas {
+		file := absFilename(schema)
+		shadows := func(pos scanner.Position, kind, name string) {
+			if builtinTypes[name] {
+				errs.add(pos, "%s %s shadows the built-in type %s", kind, name, name)
+			}
+		}
+
+		for _, structDecl := range schema.Structs {
+			shadows(structDecl.Pos, "struct", structDecl.Name)
+		}
+
+		for _, alias := range schema.Aliases {
+			shadows(alias.Pos, "type", alias.Name)
+		}
+
+		for _, newtype := range schema.Newtypes {
+			shadows(newtype.Pos, "newtype", newtype.Name)
+		}
+
+		for _, enum := range schema.Enums {
+			shadows(enum.Pos, "enum", enum.Name)
+			if !used[lintRef{file, enum.Name}] && !isRoot[enum.Name] {
+				errs.add(enum.Pos, "enum %s is not used by any declaration", enum.Name)
+			}
+
+			lintVariants(&errs, enum)
+		}
+	}
+
+	sort.SliceStable(errs, func(i, j int) bool {
+		if errs[i].Pos.Filename != errs[j].Pos.Filename {
+			return errs[i].Pos.Filename < errs[j].Pos.Filename
+		}
+		return errs[i].Pos.Offset < errs[j].Pos.Offset
+	})
+	return errs
+}
+
+func lintVariants(errs *SchemaErrors, enum *EnumDecl) {
+	used := len(enum.Variants)
+	for _, variant := range enum.Variants {
+		if !isPascalCase(variant.Name) {
+			errs.add(variant.Pos, "variant %s::%s should be PascalCase, like %s",
+				enum.Name, variant.Name, pascalCase(variant.Name))
+		}
+
+		if variant.Index+1 > used {
+			used = variant.Index + 1
+		}
+	}
+
+	if used >= MaxEnumVariants-lintVariantsMargin {
+		errs.add(enum.Pos, "enum %s uses %d of the %d variant indexes",
+			enum.Name, used, MaxEnumVariants)
+	}
+}
+
+// lintRef is a type declared by the schema file
+type lintRef struct {
+	file string
+	name string
+}
+
+// markUsed marks the types referenced by the declarations of schema,
+// an enum referencing itself does not count as using it
+func markUsed(schema *Schema, used map[lintRef]bool) {
+	file := absFilename(schema)
+	mark := func(owner string, t *TypeExpr) {
+		walkTypes(t, func(t *TypeExpr) {
+			if t.Kind != NamedType {
+				return
+			}
+
+			if t.Package == "" {
+				if t.Name != owner {
+					used[lintRef{file, t.Name}] = true
+				}
+				return
+			}
+
+			if imp := schema.importNamed(t.Package); imp != nil && imp.Schema != nil {
+				used[lintRef{absFilename(imp.Schema), t.Name}] = true
+			}
+		})
+	}
+
+	for _, enum := range schema.Enums {
+		for _, variant := range enum.Variants {
+			mark(enum.Name, variant.Payload)
+			for _, field := range variant.Fields {
+				mark(enum.Name, field.Type)
+			}
+		}
+	}
+
+	for _, structDecl := range schema.Structs {
+		for _, field := range structDecl.Fields {
+			mark(structDecl.Name, field.Type)
+		}
+	}
+
+	for _, alias := range schema.Aliases {
+		mark(alias.Name, alias.Type)
+	}
+
+	for _, newtype := range schema.Newtypes {
+		mark(newtype.Name, newtype.Type)
+	}
+}
+
+func absFilename(schema *Schema) string {
+	file, err := filepath.Abs(schema.Filename)
+	if err != nil {
+		return schema.Filename
+	}
+
+	return file
+}
+
+// isPascalCase reports whether name starts with an upper case
+// letter and has no underscores, acronyms like `XCM` are accepted
+func isPascalCase(name string) bool {
+	return name != "" && unicode.IsUpper(rune(name[0])) && !strings.Contains(name, "_")
+}
+
+// pascalCase converts a snake_case or SCREAMING_CASE name, `transfer_all`
+// and `TRANSFER_ALL` become `TransferAll`
+func pascalCase(name string) string {
+	words := strings.Split(name, "_")
+	for idx, word := range words {
+		if word == "" {
+			continue
+		}
+
+		if strings.ToUpper(word) == word && len(words) > 1 {
+			word = strings.ToLower(word)
+		}
+		words[idx] = strings.ToUpper(word[:1]) + word[1:]
+	}
+
+	return strings.Join(words, "")
+}
@@ -0,0 +1,75 @@
+package scale_codec
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLintSchemas(t *testing.T) {
+	var large strings.Builder
+	large.WriteString("enum Large {\n")
+	for idx := 0; idx < 200; idx++ {
+		fmt.Fprintf(&large, "\tV%d\n", idx)
+	}
+	large.WriteString("\t@index(239) Last\n}\n")
+
+	cases := []struct {
+		src      string
+		roots    []string
+		expected []string
+	}{
+		{
+			src:   "enum Call {\n\tRemark\n\tPay(Reason)\n\tBatch(Vec<Call>)\n}\n\nenum Reason {\n\tFee\n}",
+			roots: []string{"Call"},
+		},
+		{
+			src: "enum Call {\n\tRemark\n\tBatch(Vec<Call>)\n}\n\nenum Reason {\n\tFee\n}\n\nstruct Used {\n\treason: Reason,\n}",
+			expected: []string{
+				"test.scale:1:6: enum Call is not used by any declaration",
+			},
+		},
+		{
+			src:   "enum Option<T> {\n\tNone\n\tSome(T)\n}\n\ntype u8 = u16;\nnewtype Vec(Bytes)",
+			roots: []string{"Option"},
+			expected: []string{
+				"test.scale:1:6: enum Option shadows the built-in type Option",
+				"test.scale:6:6: type u8 shadows the built-in type u8",
+				"test.scale:7:9: newtype Vec shadows the built-in type Vec",
+			},
+		},
+		{
+			src:   "enum Call {\n\tremark\n\ttransfer_all(u64)\n\tSET_CODE\n\tXCM\n}",
+			roots: []string{"Call"},
+			expected: []string{
+				"test.scale:2:2: variant Call::remark should be PascalCase, like Remark",
+				"test.scale:3:2: variant Call::transfer_all should be PascalCase, like TransferAll",
+				"test.scale:4:2: variant Call::SET_CODE should be PascalCase, like SetCode",
+			},
+		},
+		{
+			src:   large.String(),
+			roots: []string{"Large"},
+			expected: []string{
+				"test.scale:1:6: enum Large uses 240 of the 256 variant indexes",
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		schema, err := ParseSchema("test.scale", strings.NewReader(tt.src))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var issues []string
+		for _, issue := range LintSchemas([]*Schema{schema}, tt.roots) {
+			issues = append(issues, issue.Error())
+		}
+
+		if !reflect.DeepEqual(tt.expected, issues) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expected, issues)
+		}
+	}
+}
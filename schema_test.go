@@ -0,0 +1,319 @@
+package scale_codec
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSchemaErrors(t *testing.T) {
+	cases := []struct {
+		input          string
+		expectedErrors []string
+	}{
+		{
+			input: "enum A {\n\tX(Option<bool, uint8>)\n}",
+			expectedErrors: []string{
+				"simple_enum.scale:2:15: expected '>' after Option type, found ','",
+			},
+		},
+		{
+			input: "enum A {\n\tX((bool, uint8)\n}\n\nenum B {\n\tY(Result<bool uint8>)\n}",
+			expectedErrors: []string{
+				"simple_enum.scale:3:1: expected ',' or ')' after variant payload, found '}'",
+				"simple_enum.scale:6:16: expected ',', found 'uint8'",
+			},
+		},
+		{
+			input: "enum A {\n\tX(bool)",
+			expectedErrors: []string{
+				"simple_enum.scale:2:9: expected identifier or '}' or '@' after enum variants, found end of file",
+			},
+		},
+		{
+			input: "enum A {\n\tX(Option<Nested>)\n\tY((uint8, Error))\n}",
+			expectedErrors: []string{
+				"simple_enum.scale:2:11: undefined: Nested",
+				"simple_enum.scale:3:12: undefined: Error",
+			},
+		},
+		{
+			input: "enum A {\n\tX\n\tY(bool)\n\tX(uint8)\n}",
+			expectedErrors: []string{
+				"simple_enum.scale:4:2: duplicate variant X in enum A, previous declaration at simple_enum.scale:2:2",
+			},
+		},
+		{
+			input: "struct A {\n\tx: uint8\n\ty: bool\n}\n\nstruct B(uint8 bool)",
+			expectedErrors: []string{
+				"simple_enum.scale:3:2: expected '}' after struct fields, found 'y'",
+				"simple_enum.scale:6:16: expected ',' or ')' after tuple struct fields, found 'bool'",
+			},
+		},
+		{
+			input: "struct A {\n\tx: uint8,\n\tx: Missing\n}",
+			expectedErrors: []string{
+				"simple_enum.scale:3:5: undefined: Missing",
+				"simple_enum.scale:3:2: duplicate field x in struct A, previous declaration at simple_enum.scale:2:2",
+			},
+		},
+		{
+			input: "enum A {\n\tX { a: uint8, a: bool }\n}",
+			expectedErrors: []string{
+				"simple_enum.scale:2:16: duplicate field a in variant A::X, previous declaration at simple_enum.scale:2:6",
+			},
+		},
+		{
+			input: "enum A {\n\t@index(1) W = 3\n\t@tag(4) V\n}",
+			expectedErrors: []string{
+				"simple_enum.scale:2:16: variant W has both an @index attribute and a discriminant",
+				"simple_enum.scale:3:3: unknown attribute @tag",
+			},
+		},
+		{
+			input: "enum A {\n\tX = 2\n\t@index(2) Y\n\tZ = 256\n}",
+			expectedErrors: []string{
+				"simple_enum.scale:3:9: index 2 of variant A::Y is already used by A::X",
+				"simple_enum.scale:4:6: index 256 of variant A::Z does not fit in a byte",
+			},
+		},
+		{
+			input: "enum A {\n\tX\n}\n\nstruct A {}",
+			expectedErrors: []string{
+				"simple_enum.scale:5:8: struct A redeclared, previous declaration at simple_enum.scale:1:6",
+			},
+		},
+		{
+			input: "enum A {\n\tX\n}\n\nenum A {\n\tY\n}",
+			expectedErrors: []string{
+				"simple_enum.scale:5:6: enum A redeclared, previous declaration at simple_enum.scale:1:6",
+			},
+		},
+		{
+			input: "struct A {\n\tx: [u8 32],\n}\n\nstruct B(Vec<u8)",
+			expectedErrors: []string{
+				"simple_enum.scale:2:9: expected ';', found '32'",
+				"simple_enum.scale:5:16: expected '>' after Vec type, found ')'",
+			},
+		},
+		{
+			input: "struct A {\n\tx: Compact<i32>,\n\ty: Vec<Compact<(u8, u8)>>,\n\tz: Compact<u128>,\n}",
+			expectedErrors: []string{
+				"simple_enum.scale:2:13: Compact is only defined for unsigned integers, found i32",
+				"simple_enum.scale:3:17: Compact is only defined for unsigned integers, found (u8, u8)",
+			},
+		},
+		{
+			input: "struct P<A, B, A>(A)\n\nenum E<T> {\n\tX(P<u8>)\n\tY(E)\n\tZ(Vec<T>, U)\n}",
+			expectedErrors: []string{
+				"simple_enum.scale:1:16: duplicate type parameter A in struct P, previous declaration at simple_enum.scale:1:10",
+				"simple_enum.scale:4:4: wrong number of type arguments for P: got 1, want 3",
+				"simple_enum.scale:5:4: wrong number of type arguments for E: got 0, want 1",
+				"simple_enum.scale:6:12: undefined: U",
+			},
+		},
+		{
+			input: "enum E<T {\n\tX(T<u8>)\n}",
+			expectedErrors: []string{
+				"simple_enum.scale:1:10: expected '>' or ',' after type parameters, found '{'",
+			},
+		},
+		{
+			input: "enum E<T> {\n\tX(T<u8>)\n}",
+			expectedErrors: []string{
+				"simple_enum.scale:2:4: type parameter T cannot have type arguments",
+			},
+		},
+		{
+			input: "type A = Vec<B>;\ntype B = Option<A>;\ntype C = C\nnewtype D(Missing)\nstruct E(Compact<F>)\ntype F = i64",
+			expectedErrors: []string{
+				"simple_enum.scale:5:18: Compact is only defined for unsigned integers, found F",
+				"simple_enum.scale:1:6: invalid recursive type alias A",
+				"simple_enum.scale:2:6: invalid recursive type alias B",
+				"simple_enum.scale:3:6: invalid recursive type alias C",
+				"simple_enum.scale:4:11: undefined: Missing",
+			},
+		},
+		{
+			input: "type A = u8\nnewtype A(u8)\nnewtype B u8",
+			expectedErrors: []string{
+				"simple_enum.scale:3:11: expected '(', found 'u8'",
+			},
+		},
+		{
+			input: "type A = u8\nnewtype A(u8)",
+			expectedErrors: []string{
+				"simple_enum.scale:2:9: newtype A redeclared, previous declaration at simple_enum.scale:1:6",
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		_, err := ParseSchema("simple_enum.scale", strings.NewReader(tt.input))
+
+		var schemaErrors SchemaErrors
+		if !errors.As(err, &schemaErrors) {
+			t.Fatalf("expected SchemaErrors, got: %v", err)
+		}
+
+		if len(schemaErrors) != len(tt.expectedErrors) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedErrors, schemaErrors)
+		}
+
+		for idx, expected := range tt.expectedErrors {
+			if schemaErrors[idx].Error() != expected {
+				t.Fatalf("\nexpected: %v\ngot: %v", expected, schemaErrors[idx])
+			}
+		}
+	}
+}
+
+func TestSchemaTooManyVariants(t *testing.T) {
+	input := new(strings.Builder)
+	input.WriteString("enum Big {\n")
+	for idx := 0; idx <= MaxEnumVariants; idx++ {
+		fmt.Fprintf(input, "\tV%d\n", idx)
+	}
+	input.WriteString("}")
+
+	_, err := ParseSchema("big.scale", strings.NewReader(input.String()))
+	expected := "big.scale:1:6: enum Big has 257 variants, at most 256 are allowed"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("\nexpected: %v\ngot: %v", expected, err)
+	}
+}
+
+func TestImportErrors(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"common.scale": "type Balance = u128\n\nenum Kind<T> {\n\tA(T)\n}",
+		"a.scale":      "import \"b.scale\"\n\nstruct A(b.B)",
+		"b.scale":      "import \"a.scale\"\n\nstruct B(u8)",
+		"broken.scale": "struct X(",
+	}
+	for name, contents := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	cases := []struct {
+		input          string
+		expectedErrors []string
+	}{
+		{
+			input: "import \"common.scale\"\n\nstruct S(common.Missing, other.X, common.Kind, Compact<common.Balance>)",
+			expectedErrors: []string{
+				"main.scale:3:10: undefined: common.Missing",
+				"main.scale:3:26: undefined: other",
+				"main.scale:3:35: wrong number of type arguments for common.Kind: got 0, want 1",
+			},
+		},
+		{
+			input: "import \"missing.scale\"\nimport \"common.scale\" as x\nimport \"common.scale\" as x",
+			expectedErrors: []string{
+				"main.scale:1:8: cannot import \"missing.scale\": no such file or directory",
+			},
+		},
+		{
+			input: "import \"common.scale\" as x\nimport \"common.scale\" as x",
+			expectedErrors: []string{
+				"main.scale:2:8: import x redeclared, previous import at main.scale:1:8",
+			},
+		},
+		{
+			input: "import \"a.scale\"",
+			expectedErrors: []string{
+				"b.scale:1:8: import cycle not allowed: b.scale imports a.scale",
+			},
+		},
+		{
+			input: "import \"broken.scale\"",
+			expectedErrors: []string{
+				"broken.scale:1:10: unexpected end of file",
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		_, err := ParseSchema(filepath.Join(dir, "main.scale"), strings.NewReader(tt.input))
+
+		var schemaErrors SchemaErrors
+		if !errors.As(err, &schemaErrors) {
+			t.Fatalf("expected SchemaErrors, got: %v", err)
+		}
+
+		if len(schemaErrors) != len(tt.expectedErrors) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedErrors, schemaErrors)
+		}
+
+		for idx, expected := range tt.expectedErrors {
+			actual := strings.ReplaceAll(schemaErrors[idx].Error(), dir+string(filepath.Separator), "")
+			if actual != expected {
+				t.Fatalf("\nexpected: %v\ngot: %v", expected, actual)
+			}
+		}
+	}
+}
+
+func TestDocComments(t *testing.T) {
+	const src = `
+// a regular comment is not documentation
+/// Call is dispatched
+/// by the runtime
+enum Call {
+	/// Remark does nothing
+	Remark // trailing
+	/// Send moves funds
+	@index(3) Send(Transfer)
+	@index(4)
+	/// Burn destroys funds
+	Burn { /// amount burnt
+		amount: u64 }
+	//// not a doc comment
+	Mint = 9
+}
+
+/// Transfer moves value
+struct Transfer {
+	///   indented text is kept
+	value: u64,
+	to: u32,
+}
+
+///
+/// Nonce counts calls
+newtype Nonce(u32)`
+
+	schema, err := ParseSchema("test.scale", strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	call, transfer := schema.Enums[0], schema.Structs[0]
+	cases := []struct {
+		name     string
+		actual   string
+		expected string
+	}{
+		{"Call", call.Doc, "Call is dispatched\nby the runtime"},
+		{"Remark", call.Variants[0].Doc, "Remark does nothing"},
+		{"Send", call.Variants[1].Doc, "Send moves funds"},
+		{"Burn", call.Variants[2].Doc, "Burn destroys funds"},
+		{"Burn.amount", call.Variants[2].Fields[0].Doc, "amount burnt"},
+		{"Mint", call.Variants[3].Doc, ""},
+		{"Transfer", transfer.Doc, "Transfer moves value"},
+		{"Transfer.value", transfer.Fields[0].Doc, "  indented text is kept"},
+		{"Transfer.to", transfer.Fields[1].Doc, ""},
+		{"Nonce", schema.Newtypes[0].Doc, "\nNonce counts calls"},
+	}
+
+	for _, tt := range cases {
+		if tt.actual != tt.expected {
+			t.Fatalf("%s\nexpected: %q\ngot: %q", tt.name, tt.expected, tt.actual)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package scale_codec
+
+import (
+	"io"
+)
+
+// SetG is encoded as BTreeSet, the sequence of its items prefixed by its
+// compact encoded length. The items are encoded sorted, as the Rust Ord
+// of their type orders them, and without duplicates, and are sorted once
+// decoded
+type SetG[T Marshaler] struct {
+	Items []T
+}
+
+func UnmarshalSetFromRawBytes[T Marshaler](
+	f func(io.Reader) (T, error)) func(reader io.Reader) (*SetG[T], error) {
+	return func(reader io.Reader) (*SetG[T], error) {
+		set := &SetG[T]{}
+		err := set.UnmarshalSCALE(reader, f)
+		if err != nil {
+			return nil, err
+		}
+		return set, nil
+	}
+}
+
+func (s SetG[T]) MarshalSCALE() ([]byte, error) {
+	return VecG[T]{Items: s.sortedItems()}.MarshalSCALE()
+}
+
+func (s *SetG[T]) UnmarshalSCALE(reader io.Reader, f func(io.Reader) (T, error)) error {
+	vec := new(VecG[T])
+	if err := vec.UnmarshalSCALE(reader, f); err != nil {
+		return err
+	}
+
+	s.Items = SetG[T]{Items: vec.Items}.sortedItems()
+	return nil
+}
+
+// sortedItems returns the items sorted without duplicates
+func (s SetG[T]) sortedItems() []T {
+	return sortUnique(s.Items, func(item T) any { return item })
+}
+
+func UnmarshalSetFromJSON[T Marshaler](
+	f func([]byte) (T, error)) func(data []byte) (*SetG[T], error) {
+	return func(data []byte) (*SetG[T], error) {
+		set := &SetG[T]{}
+		err := set.UnmarshalJSONWith(data, f)
+		if err != nil {
+			return nil, err
+		}
+		return set, nil
+	}
+}
+
+func (s SetG[T]) String() string {
+	return debugList(s.Items)
+}
+
+func (s SetG[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONItems(s.Items)
+}
+
+func (s *SetG[T]) UnmarshalJSON(data []byte) error {
+	return s.UnmarshalJSONWith(data, decodeJSON[T])
+}
+
+func (s *SetG[T]) UnmarshalJSONWith(data []byte, f func([]byte) (T, error)) (err error) {
+	s.Items, err = unmarshalJSONItems(data, -1, f)
+	return err
+}
@@ -0,0 +1,57 @@
+package scale_codec_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+func TestSet(t *testing.T) {
+	set := scale_codec.SetG[*scale_codec.Integer[uint8]]{
+		Items: []*scale_codec.Integer[uint8]{{Value: 3}, {Value: 1}, {Value: 3}},
+	}
+
+	expected := []byte{8, 1, 3}
+	output, err := set.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(expected, output) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expected, output)
+	}
+
+	unmarshal := scale_codec.UnmarshalSetFromRawBytes(scale_codec.IntegerFromRawBytes[uint8])
+	decoded, err := unmarshal(bytes.NewReader([]byte{12, 3, 1, 3}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedItems := []*scale_codec.Integer[uint8]{{Value: 1}, {Value: 3}}
+	if !reflect.DeepEqual(expectedItems, decoded.Items) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expectedItems, decoded.Items)
+	}
+}
+
+func TestSetOfOptions(t *testing.T) {
+	// None orders before Some, as Rust's Option does
+	set := scale_codec.SetG[*scale_codec.OptionG[*scale_codec.Integer[uint8]]]{
+		Items: []*scale_codec.OptionG[*scale_codec.Integer[uint8]]{
+			scale_codec.SomeG(&scale_codec.Integer[uint8]{Value: 2}),
+			scale_codec.NoneG[*scale_codec.Integer[uint8]](),
+			scale_codec.SomeG(&scale_codec.Integer[uint8]{Value: 1}),
+		},
+	}
+
+	expected := []byte{12, 0, 1, 1, 1, 2}
+	output, err := set.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(expected, output) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expected, output)
+	}
+}
@@ -0,0 +1,537 @@
+// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+
+
+type Call interface {
+	scale_codec.Encodable
+	IsCall()
+}
+
+func UnmarshalCall(reader io.Reader) (Call, error) {
+	enumTag := make([]byte, 1)
+	n, err := reader.Read(enumTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("%w: got %v", scale_codec.ErrExpectedOneByteRead, n)
+	}
+
+	switch enumTag[0] {
+	
+	case SendIndex:
+		unmarshaler := NewSend()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case PruneIndex:
+		unmarshaler := NewPrune()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case BumpIndex:
+		unmarshaler := NewBump()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	default:
+		return nil, fmt.Errorf("unexpected enum tag: %v", enumTag[0])
+	}
+}
+
+func UnmarshalCallJSON(data []byte) (Call, error) {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	
+	case "Send":
+		unmarshaler := NewSend()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Prune":
+		unmarshaler := NewPrune()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Bump":
+		unmarshaler := NewBump()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	default:
+		return nil, fmt.Errorf("%w: unexpected Call variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+}
+
+// CallVisitor has a method per Call variant, a visitor given
+// to VisitCall stops compiling when a variant is added
+type CallVisitor interface {
+	VisitSend(*Send) error
+	VisitPrune(*Prune) error
+	VisitBump(*Bump) error
+}
+
+// VisitCall calls the visitor method of the variant of value
+func VisitCall(value Call, visitor CallVisitor) error {
+	switch variant := value.(type) {
+	case *Send:
+		return visitor.VisitSend(variant)
+	case *Prune:
+		return visitor.VisitPrune(variant)
+	case *Bump:
+		return visitor.VisitBump(variant)
+	default:
+		return fmt.Errorf("unexpected Call variant: %T", value)
+	}
+}
+
+// CallCases holds a func per Call variant for MatchCall,
+// it is built by NewCallCases so a variant added breaks the build
+type CallCases[R any] struct {
+	onSend func(*Send) R
+	onPrune func(*Prune) R
+	onBump func(*Bump) R
+}
+
+// NewCallCases returns the cases of MatchCall, taking
+// a func per Call variant in declaration order
+func NewCallCases[R any](onSend func(*Send) R, onPrune func(*Prune) R, onBump func(*Bump) R) CallCases[R] {
+	return CallCases[R]{
+		onSend: onSend,
+		onPrune: onPrune,
+		onBump: onBump,
+	}
+}
+
+// MatchCall returns the result of the case of the variant of value,
+// it panics if that case is nil
+func MatchCall[R any](value Call, cases CallCases[R]) R {
+	switch variant := value.(type) {
+	case *Send:
+		if cases.onSend == nil {
+			panic("MatchCall: missing case for Send")
+		}
+		return cases.onSend(variant)
+	case *Prune:
+		if cases.onPrune == nil {
+			panic("MatchCall: missing case for Prune")
+		}
+		return cases.onPrune(variant)
+	case *Bump:
+		if cases.onBump == nil {
+			panic("MatchCall: missing case for Bump")
+		}
+		return cases.onBump(variant)
+	default:
+		panic(fmt.Sprintf("MatchCall: unexpected variant %T", value))
+	}
+}
+
+
+var SendIndex byte = 0
+
+var _ Call = (*Send)(nil)
+
+type Send struct {
+	Inner *Transfer
+}
+
+func NewSend() *Send {
+	return &Send{
+		Inner: new(Transfer),
+	}
+}
+
+func (Send) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Send) VariantPosition() int {
+	return 0
+}
+
+func (i Send) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := SendIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Send) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i Send) String() string {
+	return scale_codec.DebugTuple("Send", i.Inner)
+}
+
+func (i Send) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Send", i.Inner)
+}
+
+func (i *Send) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Send" {
+		return fmt.Errorf("%w: expected Send variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.FromJSON[Transfer](value)
+	return err
+}
+var PruneIndex byte = 1
+
+var _ Call = (*Prune)(nil)
+
+type Prune struct {
+	Inner *scale_codec.VecG[*scale_codec.ByteArray]
+}
+
+func NewPrune() *Prune {
+	return &Prune{
+		Inner: new(scale_codec.VecG[*scale_codec.ByteArray]),
+	}
+}
+
+func (Prune) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Prune) VariantPosition() int {
+	return 1
+}
+
+func (i Prune) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := PruneIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Prune) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader, scale_codec.UnmarshalByteArrayFromRawBytes(32))
+}
+
+func (i Prune) String() string {
+	return scale_codec.DebugTuple("Prune", i.Inner)
+}
+
+func (i Prune) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Prune", i.Inner)
+}
+
+func (i *Prune) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Prune" {
+		return fmt.Errorf("%w: expected Prune variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.UnmarshalVecFromJSON[*scale_codec.ByteArray](scale_codec.UnmarshalByteArrayFromJSON(32))(value)
+	return err
+}
+var BumpIndex byte = 2
+
+var _ Call = (*Bump)(nil)
+
+type Bump struct {
+	Inner *Nonce
+}
+
+func NewBump() *Bump {
+	return &Bump{
+		Inner: new(Nonce),
+	}
+}
+
+func (Bump) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Bump) VariantPosition() int {
+	return 2
+}
+
+func (i Bump) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := BumpIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Bump) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i Bump) String() string {
+	return scale_codec.DebugTuple("Bump", i.Inner)
+}
+
+func (i Bump) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Bump", i.Inner)
+}
+
+func (i *Bump) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Bump" {
+		return fmt.Errorf("%w: expected Bump variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.FromJSON[Nonce](value)
+	return err
+}
+
+
+var _ scale_codec.Encodable = (*Transfer)(nil)
+
+type Transfer struct {
+	From *AccountId
+	To *AccountId
+	Amount *scale_codec.U128
+	At *scale_codec.Integer[uint32]
+}
+
+func NewTransfer() *Transfer {
+	return &Transfer{
+		From: new(AccountId),
+		To: new(AccountId),
+		Amount: new(scale_codec.U128),
+		At: new(scale_codec.Integer[uint32]),
+	}
+}
+
+func (s Transfer) MarshalSCALE() (output []byte, err error) {
+	output = make([]byte, 0)
+	var enc []byte
+	
+	enc, err = s.From.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.To.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.Amount.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.At.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (s *Transfer) UnmarshalSCALE(reader io.Reader) (err error) {
+	
+	s.From, err = UnmarshalAccountId(reader)
+	if err != nil {
+		return err
+	}
+	
+	s.To, err = UnmarshalAccountId(reader)
+	if err != nil {
+		return err
+	}
+	
+	s.Amount, err = scale_codec.U128FromRawBytes(reader)
+	if err != nil {
+		return err
+	}
+	
+	s.At, err = scale_codec.IntegerFromRawBytes[uint32](reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (s Transfer) String() string {
+	return scale_codec.DebugStruct("Transfer", []string{"from", "to", "amount", "at"}, s.From, s.To, s.Amount, s.At)
+}
+
+func (s Transfer) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONObject([]string{"from", "to", "amount", "at"}, s.From, s.To, s.Amount, s.At)
+}
+
+func (s *Transfer) UnmarshalJSON(data []byte) error {
+	items, err := scale_codec.UnmarshalJSONObject(data, []string{"from", "to", "amount", "at"})
+	if err != nil {
+		return err
+	}
+	
+	s.From, err = scale_codec.FromJSON[AccountId](items[0])
+	if err != nil {
+		return err
+	}
+	
+	s.To, err = scale_codec.FromJSON[AccountId](items[1])
+	if err != nil {
+		return err
+	}
+	
+	s.Amount, err = scale_codec.FromJSON[scale_codec.U128](items[2])
+	if err != nil {
+		return err
+	}
+	
+	s.At, err = scale_codec.FromJSON[scale_codec.Integer[uint32]](items[3])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalTransfer(reader io.Reader) (*Transfer, error) {
+	s := new(Transfer)
+	if err := s.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+
+var _ scale_codec.Encodable = (*AccountId)(nil)
+
+type AccountId struct {
+	Inner *scale_codec.ByteArray
+}
+
+func NewAccountId() *AccountId {
+	return &AccountId{
+		Inner: scale_codec.NewByteArray(32),
+	}
+}
+
+func (n AccountId) MarshalSCALE() ([]byte, error) {
+	return n.Inner.MarshalSCALE()
+}
+
+func (n *AccountId) UnmarshalSCALE(reader io.Reader) (err error) {
+	n.Inner, err = scale_codec.UnmarshalByteArrayFromRawBytes(32)(reader)
+	return err
+}
+
+func (n AccountId) String() string {
+	return scale_codec.DebugTuple("AccountId", n.Inner)
+}
+
+// MarshalJSON writes the inner value, a newtype is transparent in JSON
+func (n AccountId) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.Inner)
+}
+
+func (n *AccountId) UnmarshalJSON(data []byte) (err error) {
+	n.Inner, err = scale_codec.UnmarshalByteArrayFromJSON(32)(data)
+	return err
+}
+
+func UnmarshalAccountId(reader io.Reader) (*AccountId, error) {
+	n := new(AccountId)
+	if err := n.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+var _ scale_codec.Encodable = (*Nonce)(nil)
+
+type Nonce struct {
+	Inner *scale_codec.Compact
+}
+
+func NewNonce() *Nonce {
+	return &Nonce{
+		Inner: new(scale_codec.Compact),
+	}
+}
+
+func (n Nonce) MarshalSCALE() ([]byte, error) {
+	return n.Inner.MarshalSCALE()
+}
+
+func (n *Nonce) UnmarshalSCALE(reader io.Reader) (err error) {
+	n.Inner, err = scale_codec.CompactFromRawBytes(reader)
+	return err
+}
+
+func (n Nonce) String() string {
+	return scale_codec.DebugTuple("Nonce", n.Inner)
+}
+
+// MarshalJSON writes the inner value, a newtype is transparent in JSON
+func (n Nonce) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.Inner)
+}
+
+func (n *Nonce) UnmarshalJSON(data []byte) (err error) {
+	n.Inner, err = scale_codec.FromJSON[scale_codec.Compact](data)
+	return err
+}
+
+func UnmarshalNonce(reader io.Reader) (*Nonce, error) {
+	n := new(Nonce)
+	if err := n.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
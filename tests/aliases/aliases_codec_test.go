@@ -0,0 +1,117 @@
+// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package main
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+// assertRoundTrip checks that unmarshal decodes the encoding of value
+// back to a value deeply equal to it, without leaving bytes behind
+func assertRoundTrip[T scale_codec.Marshaler](t *testing.T, value T, unmarshal func(io.Reader) (T, error)) {
+	t.Helper()
+
+	encoded, err := value.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("encoding %v: %v", value, err)
+	}
+
+	reader := bytes.NewReader(encoded)
+	decoded, err := unmarshal(reader)
+	if err != nil {
+		t.Fatalf("decoding %v: %v", encoded, err)
+	}
+
+	if reader.Len() != 0 {
+		t.Fatalf("%d bytes left after decoding %v", reader.Len(), encoded)
+	}
+
+	if !reflect.DeepEqual(value, decoded) {
+		t.Fatalf("\nexpected: %v\ngot: %v", value, decoded)
+	}
+}
+
+func randomCall(r *scale_codec.Random, depth int) Call {
+	variants := []int{0, 1, 2}
+	if r.Deep(depth) {
+		variants = []int{1}
+	}
+
+	switch variants[r.Intn(len(variants))] {
+	case 0:
+		return &Send{Inner: randomTransfer(r, depth+1)}
+	case 1:
+		return &Prune{Inner: scale_codec.RandomVec(scale_codec.RandomByteArray(32))(r, depth+1)}
+	default:
+		return &Bump{Inner: randomNonce(r, depth+1)}
+	}
+}
+
+func randomTransfer(r *scale_codec.Random, depth int) *Transfer {
+	return &Transfer{
+		From: randomAccountId(r, depth+1),
+		To: randomAccountId(r, depth+1),
+		Amount: scale_codec.RandomU128(r, depth+1),
+		At: scale_codec.RandomInteger[uint32](r, depth+1),
+	}
+}
+
+func randomAccountId(r *scale_codec.Random, depth int) *AccountId {
+	return &AccountId{Inner: scale_codec.RandomByteArray(32)(r, depth+1)}
+}
+
+func randomNonce(r *scale_codec.Random, depth int) *Nonce {
+	return &Nonce{Inner: scale_codec.RandomCompact(r, depth+1)}
+}
+
+func TestRoundTripCall(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomCall(r, 0), UnmarshalCall)
+	}
+}
+
+func FuzzUnmarshalCall(f *testing.F) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 16; idx++ {
+		encoded, err := randomCall(r, 0).MarshalSCALE()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(encoded)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value, err := UnmarshalCall(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		assertRoundTrip(t, value, UnmarshalCall)
+	})
+}
+
+func TestRoundTripTransfer(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomTransfer(r, 0), UnmarshalTransfer)
+	}
+}
+
+func TestRoundTripAccountId(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomAccountId(r, 0), UnmarshalAccountId)
+	}
+}
+
+func TestRoundTripNonce(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomNonce(r, 0), UnmarshalNonce)
+	}
+}
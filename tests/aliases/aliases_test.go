@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+func TestAliasesAndNewtypes(t *testing.T) {
+	alice, bob := bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32)
+
+	cases := []struct {
+		value         Call
+		expectedBytes []byte
+	}{
+		{
+			value: &Send{
+				Inner: &Transfer{
+					From:   &AccountId{Inner: &scale_codec.ByteArray{Value: alice}},
+					To:     &AccountId{Inner: &scale_codec.ByteArray{Value: bob}},
+					Amount: scale_codec.U128FromUpperLower(0, 10),
+					At:     &scale_codec.Integer[uint32]{Value: 99},
+				},
+			},
+			expectedBytes: bytes.Join([][]byte{
+				{0},
+				alice,
+				bob,
+				{10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+				{99, 0, 0, 0},
+			}, nil),
+		},
+		{
+			value: &Prune{
+				Inner: &scale_codec.VecG[*scale_codec.ByteArray]{
+					Items: []*scale_codec.ByteArray{{Value: alice}},
+				},
+			},
+			expectedBytes: bytes.Join([][]byte{{1, 4}, alice}, nil),
+		},
+		{
+			value: &Bump{
+				Inner: &Nonce{
+					Inner: &scale_codec.Compact{Value: &scale_codec.CompactInteger[uint8]{Value: 1}},
+				},
+			},
+			expectedBytes: []byte{2, 4},
+		},
+	}
+
+	for _, tt := range cases {
+		output, err := tt.value.MarshalSCALE()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !bytes.Equal(tt.expectedBytes, output) {
+			t.Fatalf("\nexpected: %v\nactual: %v", tt.expectedBytes, output)
+		}
+
+		decoded, err := UnmarshalCall(bytes.NewReader(tt.expectedBytes))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(tt.value, decoded) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.value, decoded)
+		}
+	}
+}
+
+func TestNewtypeEncodesAsInner(t *testing.T) {
+	account, err := UnmarshalAccountId(bytes.NewReader(bytes.Repeat([]byte{7}, 32)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected, err := account.Inner.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output, err := account.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(expected, output) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expected, output)
+	}
+}
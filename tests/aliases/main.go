@@ -0,0 +1,4 @@
+package main
+
+//go:generate enum_script -tests aliases.scale main
+func main() {}
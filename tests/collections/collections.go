@@ -0,0 +1,831 @@
+// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+type T2[A scale_codec.Marshaler,B scale_codec.Marshaler] struct {
+	F0 A
+	F1 B
+}
+
+func (t *T2[A,B]) MarshalSCALE() (output []byte, err error) {
+	output = make([]byte, 0)
+	var enc []byte
+	
+	enc, err = t.F0.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = t.F1.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (t *T2[A,B]) UnmarshalSCALE(reader io.Reader, funcA func (io.Reader) (A, error),funcB func (io.Reader) (B, error)) (err error) {
+	
+	t.F0, err =  funcA(reader)
+	if err != nil {
+		return err
+	}
+	
+	t.F1, err =  funcB(reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalT2FromRawBytes[A scale_codec.Marshaler,B scale_codec.Marshaler](
+	funcA func (io.Reader) (A, error),funcB func (io.Reader) (B, error)) func(io.Reader) (*T2[A,B], error) {
+	return func(reader io.Reader) (*T2[A,B], error) {
+		tuple := new(T2[A,B])
+		err := tuple.UnmarshalSCALE(reader,
+			funcA,
+			funcB,)
+		
+		if err != nil {
+			return nil, err
+		}
+		return tuple, nil
+	}
+}
+
+func (t *T2[A,B]) String() string {
+	return scale_codec.DebugTuple("", t.F0, t.F1)
+}
+
+func (t *T2[A,B]) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONTuple(t.F0, t.F1)
+}
+
+func (t *T2[A,B]) UnmarshalJSONWith(data []byte, jsonA func([]byte) (A, error),jsonB func([]byte) (B, error)) error {
+	items, err := scale_codec.UnmarshalJSONTuple(data, 2)
+	if err != nil {
+		return err
+	}
+	
+	t.F0, err = jsonA(items[0])
+	if err != nil {
+		return err
+	}
+	
+	t.F1, err = jsonB(items[1])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalT2FromJSON[A scale_codec.Marshaler,B scale_codec.Marshaler](
+	jsonA func([]byte) (A, error),jsonB func([]byte) (B, error)) func([]byte) (*T2[A,B], error) {
+	return func(data []byte) (*T2[A,B], error) {
+		tuple := new(T2[A,B])
+		err := tuple.UnmarshalJSONWith(data, jsonA, jsonB)
+		if err != nil {
+			return nil, err
+		}
+		return tuple, nil
+	}
+}
+
+
+type Event interface {
+	scale_codec.Encodable
+	IsEvent()
+}
+
+func UnmarshalEvent(reader io.Reader) (Event, error) {
+	enumTag := make([]byte, 1)
+	n, err := reader.Read(enumTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("%w: got %v", scale_codec.ErrExpectedOneByteRead, n)
+	}
+
+	switch enumTag[0] {
+	
+	case CreatedIndex:
+		unmarshaler := NewCreated()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case ImportedIndex:
+		unmarshaler := NewImported()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case VotedIndex:
+		unmarshaler := NewVoted()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case NoopIndex:
+		unmarshaler := NewNoop()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	default:
+		return nil, fmt.Errorf("unexpected enum tag: %v", enumTag[0])
+	}
+}
+
+func UnmarshalEventJSON(data []byte) (Event, error) {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	
+	case "Created":
+		unmarshaler := NewCreated()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Imported":
+		unmarshaler := NewImported()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Voted":
+		unmarshaler := NewVoted()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Noop":
+		unmarshaler := NewNoop()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	default:
+		return nil, fmt.Errorf("%w: unexpected Event variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+}
+
+// EventVisitor has a method per Event variant, a visitor given
+// to VisitEvent stops compiling when a variant is added
+type EventVisitor interface {
+	VisitCreated(*Created) error
+	VisitImported(*Imported) error
+	VisitVoted(*Voted) error
+	VisitNoop(*Noop) error
+}
+
+// VisitEvent calls the visitor method of the variant of value
+func VisitEvent(value Event, visitor EventVisitor) error {
+	switch variant := value.(type) {
+	case *Created:
+		return visitor.VisitCreated(variant)
+	case *Imported:
+		return visitor.VisitImported(variant)
+	case *Voted:
+		return visitor.VisitVoted(variant)
+	case *Noop:
+		return visitor.VisitNoop(variant)
+	default:
+		return fmt.Errorf("unexpected Event variant: %T", value)
+	}
+}
+
+// EventCases holds a func per Event variant for MatchEvent,
+// it is built by NewEventCases so a variant added breaks the build
+type EventCases[R any] struct {
+	onCreated func(*Created) R
+	onImported func(*Imported) R
+	onVoted func(*Voted) R
+	onNoop func(*Noop) R
+}
+
+// NewEventCases returns the cases of MatchEvent, taking
+// a func per Event variant in declaration order
+func NewEventCases[R any](onCreated func(*Created) R, onImported func(*Imported) R, onVoted func(*Voted) R, onNoop func(*Noop) R) EventCases[R] {
+	return EventCases[R]{
+		onCreated: onCreated,
+		onImported: onImported,
+		onVoted: onVoted,
+		onNoop: onNoop,
+	}
+}
+
+// MatchEvent returns the result of the case of the variant of value,
+// it panics if that case is nil
+func MatchEvent[R any](value Event, cases EventCases[R]) R {
+	switch variant := value.(type) {
+	case *Created:
+		if cases.onCreated == nil {
+			panic("MatchEvent: missing case for Created")
+		}
+		return cases.onCreated(variant)
+	case *Imported:
+		if cases.onImported == nil {
+			panic("MatchEvent: missing case for Imported")
+		}
+		return cases.onImported(variant)
+	case *Voted:
+		if cases.onVoted == nil {
+			panic("MatchEvent: missing case for Voted")
+		}
+		return cases.onVoted(variant)
+	case *Noop:
+		if cases.onNoop == nil {
+			panic("MatchEvent: missing case for Noop")
+		}
+		return cases.onNoop(variant)
+	default:
+		panic(fmt.Sprintf("MatchEvent: unexpected variant %T", value))
+	}
+}
+
+
+var CreatedIndex byte = 0
+
+var _ Event = (*Created)(nil)
+
+type Created struct {
+	Inner *Account
+}
+
+func NewCreated() *Created {
+	return &Created{
+		Inner: new(Account),
+	}
+}
+
+func (Created) IsEvent() {}
+
+// VariantPosition orders the Event variants by declaration, as Rust's Ord
+func (Created) VariantPosition() int {
+	return 0
+}
+
+func (i Created) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := CreatedIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Created) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i Created) String() string {
+	return scale_codec.DebugTuple("Created", i.Inner)
+}
+
+func (i Created) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Created", i.Inner)
+}
+
+func (i *Created) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Created" {
+		return fmt.Errorf("%w: expected Created variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.FromJSON[Account](value)
+	return err
+}
+var ImportedIndex byte = 1
+
+var _ Event = (*Imported)(nil)
+
+type Imported struct {
+	F0 *Header
+	F1 *scale_codec.Bytes
+}
+
+func NewImported() *Imported {
+	return &Imported{
+		F0: new(Header),
+		F1: new(scale_codec.Bytes),
+	}
+}
+
+func (Imported) IsEvent() {}
+
+// VariantPosition orders the Event variants by declaration, as Rust's Ord
+func (Imported) VariantPosition() int {
+	return 1
+}
+
+func (i Imported) MarshalSCALE() (output []byte, err error) {
+	output = []byte{ImportedIndex}
+	var enc []byte
+	
+	enc, err = i.F0.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = i.F1.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (i *Imported) UnmarshalSCALE(reader io.Reader) (err error) {
+	
+	i.F0, err = UnmarshalHeader(reader)
+	if err != nil {
+		return err
+	}
+	
+	i.F1, err = scale_codec.BytesFromRawBytes(reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (i Imported) String() string {
+	return scale_codec.DebugTuple("Imported", i.F0, i.F1)
+}
+
+func (i Imported) MarshalJSON() ([]byte, error) {
+	value, err := scale_codec.MarshalJSONTuple(i.F0, i.F1)
+	if err != nil {
+		return nil, err
+	}
+	return scale_codec.MarshalJSONVariant("Imported", json.RawMessage(value))
+}
+
+func (i *Imported) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Imported" {
+		return fmt.Errorf("%w: expected Imported variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	items, err := scale_codec.UnmarshalJSONTuple(value, 2)
+	if err != nil {
+		return err
+	}
+	
+	i.F0, err = scale_codec.FromJSON[Header](items[0])
+	if err != nil {
+		return err
+	}
+	
+	i.F1, err = scale_codec.FromJSON[scale_codec.Bytes](items[1])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+// NewImportedFrom builds a Imported variant from Go values
+func NewImportedFrom(a *Header, b []byte) *Imported {
+	return &Imported{
+		F0: a,
+		F1: &scale_codec.Bytes{Value: b},
+	}
+}
+var VotedIndex byte = 2
+
+var _ Event = (*Voted)(nil)
+
+type Voted struct {
+	Inner *scale_codec.OptionG[*scale_codec.VecG[*T2[*scale_codec.Integer[uint32],*scale_codec.ByteArray]]]
+}
+
+func NewVoted() *Voted {
+	return &Voted{
+		Inner: new(scale_codec.OptionG[*scale_codec.VecG[*T2[*scale_codec.Integer[uint32],*scale_codec.ByteArray]]]),
+	}
+}
+
+func (Voted) IsEvent() {}
+
+// VariantPosition orders the Event variants by declaration, as Rust's Ord
+func (Voted) VariantPosition() int {
+	return 2
+}
+
+func (i Voted) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := VotedIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Voted) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader,scale_codec.UnmarshalVecFromRawBytes[*T2[*scale_codec.Integer[uint32],*scale_codec.ByteArray]](UnmarshalT2FromRawBytes[*scale_codec.Integer[uint32],*scale_codec.ByteArray](scale_codec.IntegerFromRawBytes[uint32],scale_codec.UnmarshalByteArrayFromRawBytes(32))))
+}
+
+func (i Voted) String() string {
+	return scale_codec.DebugTuple("Voted", i.Inner)
+}
+
+func (i Voted) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Voted", i.Inner)
+}
+
+func (i *Voted) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Voted" {
+		return fmt.Errorf("%w: expected Voted variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.UnmarshalOptionFromJSON[*scale_codec.VecG[*T2[*scale_codec.Integer[uint32],*scale_codec.ByteArray]]](scale_codec.UnmarshalVecFromJSON[*T2[*scale_codec.Integer[uint32],*scale_codec.ByteArray]](UnmarshalT2FromJSON[*scale_codec.Integer[uint32],*scale_codec.ByteArray](scale_codec.FromJSON[scale_codec.Integer[uint32]],scale_codec.UnmarshalByteArrayFromJSON(32))))(value)
+	return err
+}
+
+// NewVotedNone builds a Voted variant from Go values
+func NewVotedNone() *Voted {
+	return &Voted{
+		Inner: scale_codec.NoneG[*scale_codec.VecG[*T2[*scale_codec.Integer[uint32],*scale_codec.ByteArray]]](),
+	}
+}
+
+// NewVotedSome builds a Voted variant from Go values
+func NewVotedSome(v *scale_codec.VecG[*T2[*scale_codec.Integer[uint32],*scale_codec.ByteArray]]) *Voted {
+	return &Voted{
+		Inner: scale_codec.SomeG[*scale_codec.VecG[*T2[*scale_codec.Integer[uint32],*scale_codec.ByteArray]]](v),
+	}
+}
+
+func (variant Voted) Some() (v *scale_codec.VecG[*T2[*scale_codec.Integer[uint32],*scale_codec.ByteArray]], ok bool) {
+	inner, ok := variant.Inner.Unwrap()
+	if !ok {
+		return v, false
+	}
+	return inner, true
+}
+var NoopIndex byte = 3
+
+var _ Event = (*Noop)(nil)
+
+type Noop struct {
+	Inner *scale_codec.Unit
+}
+
+func NewNoop() *Noop {
+	return &Noop{
+		Inner: new(scale_codec.Unit),
+	}
+}
+
+func (Noop) IsEvent() {}
+
+// VariantPosition orders the Event variants by declaration, as Rust's Ord
+func (Noop) VariantPosition() int {
+	return 3
+}
+
+func (i Noop) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := NoopIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Noop) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i Noop) String() string {
+	return scale_codec.DebugTuple("Noop", i.Inner)
+}
+
+func (i Noop) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Noop", i.Inner)
+}
+
+func (i *Noop) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Noop" {
+		return fmt.Errorf("%w: expected Noop variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.FromJSON[scale_codec.Unit](value)
+	return err
+}
+
+
+var _ scale_codec.Encodable = (*Header)(nil)
+
+type Header struct {
+	ParentHash *scale_codec.ByteArray
+	Number *scale_codec.Compact
+	Digest *scale_codec.VecG[*scale_codec.Bytes]
+}
+
+func NewHeader() *Header {
+	return &Header{
+		ParentHash: scale_codec.NewByteArray(32),
+		Number: new(scale_codec.Compact),
+		Digest: new(scale_codec.VecG[*scale_codec.Bytes]),
+	}
+}
+
+func (s Header) MarshalSCALE() (output []byte, err error) {
+	output = make([]byte, 0)
+	var enc []byte
+	
+	enc, err = s.ParentHash.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.Number.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.Digest.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (s *Header) UnmarshalSCALE(reader io.Reader) (err error) {
+	
+	s.ParentHash, err = scale_codec.UnmarshalByteArrayFromRawBytes(32)(reader)
+	if err != nil {
+		return err
+	}
+	
+	s.Number, err = scale_codec.CompactFromRawBytes(reader)
+	if err != nil {
+		return err
+	}
+	
+	s.Digest, err = scale_codec.UnmarshalVecFromRawBytes[*scale_codec.Bytes](scale_codec.BytesFromRawBytes)(reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (s Header) String() string {
+	return scale_codec.DebugStruct("Header", []string{"parent_hash", "number", "digest"}, s.ParentHash, s.Number, s.Digest)
+}
+
+func (s Header) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONObject([]string{"parentHash", "number", "digest"}, s.ParentHash, s.Number, s.Digest)
+}
+
+func (s *Header) UnmarshalJSON(data []byte) error {
+	items, err := scale_codec.UnmarshalJSONObject(data, []string{"parentHash", "number", "digest"})
+	if err != nil {
+		return err
+	}
+	
+	s.ParentHash, err = scale_codec.UnmarshalByteArrayFromJSON(32)(items[0])
+	if err != nil {
+		return err
+	}
+	
+	s.Number, err = scale_codec.FromJSON[scale_codec.Compact](items[1])
+	if err != nil {
+		return err
+	}
+	
+	s.Digest, err = scale_codec.UnmarshalVecFromJSON[*scale_codec.Bytes](scale_codec.FromJSON[scale_codec.Bytes])(items[2])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalHeader(reader io.Reader) (*Header, error) {
+	s := new(Header)
+	if err := s.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+var _ scale_codec.Encodable = (*Account)(nil)
+
+type Account struct {
+	Nonce *scale_codec.Integer[uint32]
+	Free *scale_codec.U128
+	Debt *scale_codec.I128
+	Name *scale_codec.String
+	Roles *scale_codec.SetG[*scale_codec.Integer[uint8]]
+	Limits *scale_codec.MapG[*scale_codec.Integer[uint16],*scale_codec.ArrayG[*scale_codec.Integer[uint64]]]
+}
+
+func NewAccount() *Account {
+	return &Account{
+		Nonce: new(scale_codec.Integer[uint32]),
+		Free: new(scale_codec.U128),
+		Debt: new(scale_codec.I128),
+		Name: new(scale_codec.String),
+		Roles: new(scale_codec.SetG[*scale_codec.Integer[uint8]]),
+		Limits: new(scale_codec.MapG[*scale_codec.Integer[uint16],*scale_codec.ArrayG[*scale_codec.Integer[uint64]]]),
+	}
+}
+
+func (s Account) MarshalSCALE() (output []byte, err error) {
+	output = make([]byte, 0)
+	var enc []byte
+	
+	enc, err = s.Nonce.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.Free.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.Debt.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.Name.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.Roles.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.Limits.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (s *Account) UnmarshalSCALE(reader io.Reader) (err error) {
+	
+	s.Nonce, err = scale_codec.IntegerFromRawBytes[uint32](reader)
+	if err != nil {
+		return err
+	}
+	
+	s.Free, err = scale_codec.U128FromRawBytes(reader)
+	if err != nil {
+		return err
+	}
+	
+	s.Debt, err = scale_codec.I128FromRawBytes(reader)
+	if err != nil {
+		return err
+	}
+	
+	s.Name, err = scale_codec.StringFromRawBytes(reader)
+	if err != nil {
+		return err
+	}
+	
+	s.Roles, err = scale_codec.UnmarshalSetFromRawBytes[*scale_codec.Integer[uint8]](scale_codec.IntegerFromRawBytes[uint8])(reader)
+	if err != nil {
+		return err
+	}
+	
+	s.Limits, err = scale_codec.UnmarshalMapFromRawBytes[*scale_codec.Integer[uint16],*scale_codec.ArrayG[*scale_codec.Integer[uint64]]](scale_codec.IntegerFromRawBytes[uint16],scale_codec.UnmarshalArrayFromRawBytes[*scale_codec.Integer[uint64]](2, scale_codec.IntegerFromRawBytes[uint64]))(reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (s Account) String() string {
+	return scale_codec.DebugStruct("Account", []string{"nonce", "free", "debt", "name", "roles", "limits"}, s.Nonce, s.Free, s.Debt, s.Name, s.Roles, s.Limits)
+}
+
+func (s Account) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONObject([]string{"nonce", "free", "debt", "name", "roles", "limits"}, s.Nonce, s.Free, s.Debt, s.Name, s.Roles, s.Limits)
+}
+
+func (s *Account) UnmarshalJSON(data []byte) error {
+	items, err := scale_codec.UnmarshalJSONObject(data, []string{"nonce", "free", "debt", "name", "roles", "limits"})
+	if err != nil {
+		return err
+	}
+	
+	s.Nonce, err = scale_codec.FromJSON[scale_codec.Integer[uint32]](items[0])
+	if err != nil {
+		return err
+	}
+	
+	s.Free, err = scale_codec.FromJSON[scale_codec.U128](items[1])
+	if err != nil {
+		return err
+	}
+	
+	s.Debt, err = scale_codec.FromJSON[scale_codec.I128](items[2])
+	if err != nil {
+		return err
+	}
+	
+	s.Name, err = scale_codec.FromJSON[scale_codec.String](items[3])
+	if err != nil {
+		return err
+	}
+	
+	s.Roles, err = scale_codec.UnmarshalSetFromJSON[*scale_codec.Integer[uint8]](scale_codec.FromJSON[scale_codec.Integer[uint8]])(items[4])
+	if err != nil {
+		return err
+	}
+	
+	s.Limits, err = scale_codec.UnmarshalMapFromJSON[*scale_codec.Integer[uint16],*scale_codec.ArrayG[*scale_codec.Integer[uint64]]](scale_codec.FromJSON[scale_codec.Integer[uint16]],scale_codec.UnmarshalArrayFromJSON[*scale_codec.Integer[uint64]](2, scale_codec.FromJSON[scale_codec.Integer[uint64]]))(items[5])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalAccount(reader io.Reader) (*Account, error) {
+	s := new(Account)
+	if err := s.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
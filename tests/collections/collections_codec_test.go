@@ -0,0 +1,123 @@
+// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package main
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+// assertRoundTrip checks that unmarshal decodes the encoding of value
+// back to a value deeply equal to it, without leaving bytes behind
+func assertRoundTrip[T scale_codec.Marshaler](t *testing.T, value T, unmarshal func(io.Reader) (T, error)) {
+	t.Helper()
+
+	encoded, err := value.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("encoding %v: %v", value, err)
+	}
+
+	reader := bytes.NewReader(encoded)
+	decoded, err := unmarshal(reader)
+	if err != nil {
+		t.Fatalf("decoding %v: %v", encoded, err)
+	}
+
+	if reader.Len() != 0 {
+		t.Fatalf("%d bytes left after decoding %v", reader.Len(), encoded)
+	}
+
+	if !reflect.DeepEqual(value, decoded) {
+		t.Fatalf("\nexpected: %v\ngot: %v", value, decoded)
+	}
+}
+
+func randomT2[A scale_codec.Marshaler, B scale_codec.Marshaler](randomA scale_codec.RandomFunc[A], randomB scale_codec.RandomFunc[B]) scale_codec.RandomFunc[*T2[A, B]] {
+	return func(r *scale_codec.Random, depth int) *T2[A, B] {
+		return &T2[A, B]{F0: randomA(r, depth+1), F1: randomB(r, depth+1)}
+	}
+}
+
+func randomEvent(r *scale_codec.Random, depth int) Event {
+	variants := []int{0, 1, 2, 3}
+	if r.Deep(depth) {
+		variants = []int{2, 3}
+	}
+
+	switch variants[r.Intn(len(variants))] {
+	case 0:
+		return &Created{Inner: randomAccount(r, depth+1)}
+	case 1:
+		return &Imported{
+			F0: randomHeader(r, depth+1),
+			F1: scale_codec.RandomBytes(r, depth+1),
+		}
+	case 2:
+		return &Voted{Inner: scale_codec.RandomOption(scale_codec.RandomVec(randomT2(scale_codec.RandomInteger[uint32], scale_codec.RandomByteArray(32))))(r, depth+1)}
+	default:
+		return &Noop{Inner: scale_codec.RandomUnit(r, depth+1)}
+	}
+}
+
+func randomHeader(r *scale_codec.Random, depth int) *Header {
+	return &Header{
+		ParentHash: scale_codec.RandomByteArray(32)(r, depth+1),
+		Number: scale_codec.RandomCompact(r, depth+1),
+		Digest: scale_codec.RandomVec(scale_codec.RandomBytes)(r, depth+1),
+	}
+}
+
+func randomAccount(r *scale_codec.Random, depth int) *Account {
+	return &Account{
+		Nonce: scale_codec.RandomInteger[uint32](r, depth+1),
+		Free: scale_codec.RandomU128(r, depth+1),
+		Debt: scale_codec.RandomI128(r, depth+1),
+		Name: scale_codec.RandomString(r, depth+1),
+		Roles: scale_codec.RandomSet(scale_codec.RandomInteger[uint8])(r, depth+1),
+		Limits: scale_codec.RandomMap(scale_codec.RandomInteger[uint16], scale_codec.RandomArray(2, scale_codec.RandomInteger[uint64]))(r, depth+1),
+	}
+}
+
+func TestRoundTripEvent(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomEvent(r, 0), UnmarshalEvent)
+	}
+}
+
+func FuzzUnmarshalEvent(f *testing.F) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 16; idx++ {
+		encoded, err := randomEvent(r, 0).MarshalSCALE()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(encoded)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value, err := UnmarshalEvent(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		assertRoundTrip(t, value, UnmarshalEvent)
+	})
+}
+
+func TestRoundTripHeader(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomHeader(r, 0), UnmarshalHeader)
+	}
+}
+
+func TestRoundTripAccount(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomAccount(r, 0), UnmarshalAccount)
+	}
+}
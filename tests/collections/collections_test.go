@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+func TestCollections(t *testing.T) {
+	hash := bytes.Repeat([]byte{0xaa}, 32)
+
+	header := &Header{
+		ParentHash: &scale_codec.ByteArray{Value: hash},
+		Number: &scale_codec.Compact{
+			Value: &scale_codec.CompactInteger[uint8]{Value: 5},
+		},
+		Digest: &scale_codec.VecG[*scale_codec.Bytes]{
+			Items: []*scale_codec.Bytes{{Value: []byte{1, 2}}},
+		},
+	}
+	encodedHeader := append(append([]byte{}, hash...), 20, 4, 8, 1, 2)
+
+	limits := scale_codec.NewArrayG[*scale_codec.Integer[uint64]](2)
+	limits.Items[0] = &scale_codec.Integer[uint64]{Value: 1}
+	limits.Items[1] = &scale_codec.Integer[uint64]{Value: 2}
+
+	account := &Account{
+		Nonce: &scale_codec.Integer[uint32]{Value: 1},
+		Free:  scale_codec.U128FromUpperLower(0, 1000),
+		Debt:  scale_codec.I128FromBigInt(big.NewInt(-2)),
+		Name:  &scale_codec.String{Value: "ab"},
+		Roles: &scale_codec.SetG[*scale_codec.Integer[uint8]]{
+			Items: []*scale_codec.Integer[uint8]{{Value: 1}, {Value: 2}},
+		},
+		Limits: &scale_codec.MapG[*scale_codec.Integer[uint16], *scale_codec.ArrayG[*scale_codec.Integer[uint64]]]{
+			Entries: []scale_codec.MapEntry[*scale_codec.Integer[uint16], *scale_codec.ArrayG[*scale_codec.Integer[uint64]]]{
+				{Key: &scale_codec.Integer[uint16]{Value: 7}, Value: limits},
+			},
+		},
+	}
+	encodedAccount := bytes.Join([][]byte{
+		{1, 0, 0, 0},
+		{232, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		{254, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+		{8, 'a', 'b'},
+		{8, 1, 2},
+		{4, 7, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0},
+	}, nil)
+
+	vote := &T2[*scale_codec.Integer[uint32], *scale_codec.ByteArray]{
+		F0: &scale_codec.Integer[uint32]{Value: 9},
+		F1: &scale_codec.ByteArray{Value: hash},
+	}
+
+	cases := []struct {
+		value         Event
+		expectedBytes []byte
+	}{
+		{
+			value:         &Created{Inner: account},
+			expectedBytes: append([]byte{0}, encodedAccount...),
+		},
+		{
+			value: &Imported{
+				F0: header,
+				F1: &scale_codec.Bytes{Value: []byte{3}},
+			},
+			expectedBytes: bytes.Join([][]byte{{1}, encodedHeader, {4, 3}}, nil),
+		},
+		{
+			value: &Voted{
+				Inner: scale_codec.SomeG(&scale_codec.VecG[*T2[*scale_codec.Integer[uint32], *scale_codec.ByteArray]]{
+					Items: []*T2[*scale_codec.Integer[uint32], *scale_codec.ByteArray]{vote},
+				}),
+			},
+			expectedBytes: bytes.Join([][]byte{{2, 1, 4, 9, 0, 0, 0}, hash}, nil),
+		},
+		{
+			value:         &Noop{Inner: &scale_codec.Unit{}},
+			expectedBytes: []byte{3},
+		},
+	}
+
+	for _, tt := range cases {
+		output, err := tt.value.MarshalSCALE()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !bytes.Equal(tt.expectedBytes, output) {
+			t.Fatalf("\nexpected: %v\nactual: %v", tt.expectedBytes, output)
+		}
+
+		decoded, err := UnmarshalEvent(bytes.NewReader(tt.expectedBytes))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(tt.value, decoded) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.value, decoded)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+	"golang.org/x/exp/constraints"
+)
+
+// goldenVersion is the version of the golden vectors file this test reads
+const goldenVersion = 1
+
+// goldenFile is written by the golden module of tests/rust-scale-codec,
+// the encodings come from parity-scale-codec
+const goldenFile = "../golden/vectors.json"
+
+type goldenVector struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+	Hex   string          `json:"hex"`
+}
+
+type goldenVectors struct {
+	Version int            `json:"version"`
+	Vectors []goldenVector `json:"vectors"`
+}
+
+// goldenCodec decodes a golden vector type from SCALE and from JSON
+type goldenCodec struct {
+	fromSCALE func(io.Reader) (scale_codec.Marshaler, error)
+	fromJSON  func([]byte) (scale_codec.Marshaler, error)
+}
+
+func newGoldenCodec[T scale_codec.Marshaler](fromSCALE func(io.Reader) (T, error),
+	fromJSON func([]byte) (T, error)) goldenCodec {
+	return goldenCodec{
+		fromSCALE: func(reader io.Reader) (scale_codec.Marshaler, error) { return fromSCALE(reader) },
+		fromJSON:  func(data []byte) (scale_codec.Marshaler, error) { return fromJSON(data) },
+	}
+}
+
+func integerCodec[T constraints.Integer]() goldenCodec {
+	return newGoldenCodec(scale_codec.IntegerFromRawBytes[T], scale_codec.FromJSON[scale_codec.Integer[T]])
+}
+
+// goldenCodecs are the decoders of the types written by the Rust crate,
+// keyed by their Rust type
+var goldenCodecs = map[string]goldenCodec{
+	"u8":            integerCodec[uint8](),
+	"u16":           integerCodec[uint16](),
+	"u32":           integerCodec[uint32](),
+	"u64":           integerCodec[uint64](),
+	"i8":            integerCodec[int8](),
+	"i16":           integerCodec[int16](),
+	"i32":           integerCodec[int32](),
+	"i64":           integerCodec[int64](),
+	"u128":          newGoldenCodec(scale_codec.U128FromRawBytes, scale_codec.FromJSON[scale_codec.U128]),
+	"i128":          newGoldenCodec(scale_codec.I128FromRawBytes, scale_codec.FromJSON[scale_codec.I128]),
+	"bool":          newGoldenCodec(scale_codec.BoolFromRawBytes, scale_codec.FromJSON[scale_codec.Bool]),
+	"String":        newGoldenCodec(scale_codec.StringFromRawBytes, scale_codec.FromJSON[scale_codec.String]),
+	"Vec<u8>":       newGoldenCodec(scale_codec.BytesFromRawBytes, scale_codec.FromJSON[scale_codec.Bytes]),
+	"Compact<u32>":  newGoldenCodec(scale_codec.CompactFromRawBytes, scale_codec.FromJSON[scale_codec.Compact]),
+	"Compact<u64>":  newGoldenCodec(scale_codec.CompactFromRawBytes, scale_codec.FromJSON[scale_codec.Compact]),
+	"Compact<u128>": newGoldenCodec(scale_codec.CompactFromRawBytes, scale_codec.FromJSON[scale_codec.Compact]),
+	"[u8; 4]":       newGoldenCodec(scale_codec.UnmarshalByteArrayFromRawBytes(4), scale_codec.UnmarshalByteArrayFromJSON(4)),
+	"Vec<u16>": newGoldenCodec(
+		scale_codec.UnmarshalVecFromRawBytes(scale_codec.IntegerFromRawBytes[uint16]),
+		scale_codec.UnmarshalVecFromJSON(scale_codec.FromJSON[scale_codec.Integer[uint16]])),
+	"Option<bool>": newGoldenCodec(
+		scale_codec.UnmarshalOptionFromRawBytes(scale_codec.BoolFromRawBytes),
+		scale_codec.UnmarshalOptionFromJSON(scale_codec.FromJSON[scale_codec.Bool])),
+	"Option<u32>": newGoldenCodec(
+		scale_codec.UnmarshalOptionFromRawBytes(scale_codec.IntegerFromRawBytes[uint32]),
+		scale_codec.UnmarshalOptionFromJSON(scale_codec.FromJSON[scale_codec.Integer[uint32]])),
+	"Option<String>": newGoldenCodec(
+		scale_codec.UnmarshalOptionFromRawBytes(scale_codec.StringFromRawBytes),
+		scale_codec.UnmarshalOptionFromJSON(scale_codec.FromJSON[scale_codec.String])),
+	"Result<u64, bool>": newGoldenCodec(
+		scale_codec.UnmarshalResultFromRawBytes(scale_codec.IntegerFromRawBytes[uint64], scale_codec.BoolFromRawBytes),
+		scale_codec.UnmarshalResultFromJSON(scale_codec.FromJSON[scale_codec.Integer[uint64]], scale_codec.FromJSON[scale_codec.Bool])),
+	"Result<Result<u64, u8>, bool>": newGoldenCodec(
+		scale_codec.UnmarshalResultFromRawBytes(
+			scale_codec.UnmarshalResultFromRawBytes(scale_codec.IntegerFromRawBytes[uint64], scale_codec.IntegerFromRawBytes[uint8]),
+			scale_codec.BoolFromRawBytes),
+		scale_codec.UnmarshalResultFromJSON(
+			scale_codec.UnmarshalResultFromJSON(scale_codec.FromJSON[scale_codec.Integer[uint64]], scale_codec.FromJSON[scale_codec.Integer[uint8]]),
+			scale_codec.FromJSON[scale_codec.Bool])),
+	"(Option<u64>, Result<bool, u64>)": newGoldenCodec(
+		UnmarshalT2FromRawBytes(
+			scale_codec.UnmarshalOptionFromRawBytes(scale_codec.IntegerFromRawBytes[uint64]),
+			scale_codec.UnmarshalResultFromRawBytes(scale_codec.BoolFromRawBytes, scale_codec.IntegerFromRawBytes[uint64])),
+		UnmarshalT2FromJSON(
+			scale_codec.UnmarshalOptionFromJSON(scale_codec.FromJSON[scale_codec.Integer[uint64]]),
+			scale_codec.UnmarshalResultFromJSON(scale_codec.FromJSON[scale_codec.Bool], scale_codec.FromJSON[scale_codec.Integer[uint64]]))),
+	"(u8, bool, u16)": newGoldenCodec(
+		UnmarshalT3FromRawBytes(scale_codec.IntegerFromRawBytes[uint8], scale_codec.BoolFromRawBytes, scale_codec.IntegerFromRawBytes[uint16]),
+		UnmarshalT3FromJSON(scale_codec.FromJSON[scale_codec.Integer[uint8]], scale_codec.FromJSON[scale_codec.Bool], scale_codec.FromJSON[scale_codec.Integer[uint16]])),
+	"MyScaleEncodedEnum": newGoldenCodec(UnmarshalMyScaleEncodedEnum, UnmarshalMyScaleEncodedEnumJSON),
+	"Pallet":             newGoldenCodec(UnmarshalPallet, UnmarshalPalletJSON),
+	"Status":             newGoldenCodec(UnmarshalStatus, scale_codec.FromJSON[Status]),
+	"BTreeMap<u16, bool>": newGoldenCodec(
+		scale_codec.UnmarshalMapFromRawBytes(scale_codec.IntegerFromRawBytes[uint16], scale_codec.BoolFromRawBytes),
+		scale_codec.UnmarshalMapFromJSON(scale_codec.FromJSON[scale_codec.Integer[uint16]], scale_codec.FromJSON[scale_codec.Bool])),
+	"BTreeSet<u8>": newGoldenCodec(
+		scale_codec.UnmarshalSetFromRawBytes(scale_codec.IntegerFromRawBytes[uint8]),
+		scale_codec.UnmarshalSetFromJSON(scale_codec.FromJSON[scale_codec.Integer[uint8]])),
+	"BTreeSet<Status>": newGoldenCodec(
+		scale_codec.UnmarshalSetFromRawBytes(UnmarshalStatus),
+		scale_codec.UnmarshalSetFromJSON(scale_codec.FromJSON[Status])),
+	"BTreeSet<Pallet>": newGoldenCodec(
+		scale_codec.UnmarshalSetFromRawBytes(UnmarshalPallet),
+		scale_codec.UnmarshalSetFromJSON(UnmarshalPalletJSON)),
+}
+
+func TestGoldenVectors(t *testing.T) {
+	contents, err := os.ReadFile(goldenFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var golden goldenVectors
+	if err := json.Unmarshal(contents, &golden); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if golden.Version != goldenVersion {
+		t.Fatalf("\nexpected: version %d\ngot: version %d", goldenVersion, golden.Version)
+	}
+
+	if len(golden.Vectors) == 0 {
+		t.Fatalf("no golden vectors in %s", goldenFile)
+	}
+
+	for _, vector := range golden.Vectors {
+		name := vector.Type + " " + string(vector.Value)
+		codec, ok := goldenCodecs[vector.Type]
+		if !ok {
+			t.Fatalf("%s: no decoder for type %s", name, vector.Type)
+		}
+
+		expected, err := hex.DecodeString(strings.TrimPrefix(vector.Hex, "0x"))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		// JSON -> SCALE
+		value, err := codec.fromJSON(vector.Value)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		assertGoldenEncoding(t, name, expected, value)
+
+		// SCALE -> value -> SCALE, JSON -> SCALE
+		reader := bytes.NewReader(expected)
+		decoded, err := codec.fromSCALE(reader)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		if reader.Len() != 0 {
+			t.Fatalf("%s: %d bytes left after decoding", name, reader.Len())
+		}
+		assertGoldenEncoding(t, name, expected, decoded)
+
+		output, err := json.Marshal(decoded)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		fromOutput, err := codec.fromJSON(output)
+		if err != nil {
+			t.Fatalf("%s: decoding %s: unexpected error: %v", name, output, err)
+		}
+		assertGoldenEncoding(t, name, expected, fromOutput)
+	}
+}
+
+func assertGoldenEncoding(t *testing.T, name string, expected []byte, value scale_codec.Marshaler) {
+	t.Helper()
+
+	output, err := value.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("%s: unexpected error: %v", name, err)
+	}
+
+	if !bytes.Equal(expected, output) {
+		t.Fatalf("%s\nexpected: %v\ngot: %v", name, expected, output)
+	}
+}
+
+// TestGoldenVectorsDynamic checks the dynamic values described from the
+// schema against the golden vectors and the JSON of the generated types
+func TestGoldenVectorsDynamic(t *testing.T) {
+	contents, err := os.ReadFile(goldenFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var golden goldenVectors
+	if err := json.Unmarshal(contents, &golden); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	source, err := os.ReadFile("simple_enum.scale")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	schema, err := scale_codec.ParseSchema("simple_enum.scale", bytes.NewReader(source))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, vector := range golden.Vectors {
+		name := vector.Type + " " + string(vector.Value)
+		desc, err := scale_codec.ParseTypeDesc(vector.Type, schema)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		expected, err := hex.DecodeString(strings.TrimPrefix(vector.Hex, "0x"))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		// JSON -> SCALE
+		value, err := scale_codec.UnmarshalValueJSON(desc, vector.Value)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		assertGoldenValueEncoding(t, name, expected, desc, value)
+
+		// SCALE -> value -> SCALE, value -> JSON as written by the generated types
+		reader := bytes.NewReader(expected)
+		decoded, err := scale_codec.DecodeValue(desc, reader)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		if reader.Len() != 0 {
+			t.Fatalf("%s: %d bytes left after decoding", name, reader.Len())
+		}
+		assertGoldenValueEncoding(t, name, expected, desc, decoded)
+
+		output, err := scale_codec.MarshalValueJSON(desc, decoded)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		generated, err := goldenCodecs[vector.Type].fromSCALE(bytes.NewReader(expected))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		expectedJSON, err := json.Marshal(generated)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		if !bytes.Equal(expectedJSON, output) {
+			t.Fatalf("%s\nexpected: %s\ngot: %s", name, expectedJSON, output)
+		}
+
+		// SCALE -> JSON -> SCALE through the streaming transcoders
+		transcoded := new(bytes.Buffer)
+		if err := scale_codec.TranscodeToJSON(desc, bytes.NewReader(expected), transcoded); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		if !bytes.Equal(expectedJSON, transcoded.Bytes()) {
+			t.Fatalf("%s\nexpected: %s\ngot: %s", name, expectedJSON, transcoded)
+		}
+
+		encoded := new(bytes.Buffer)
+		if err := scale_codec.TranscodeFromJSON(desc, bytes.NewReader(vector.Value), encoded); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		if !bytes.Equal(expected, encoded.Bytes()) {
+			t.Fatalf("%s\nexpected: %v\ngot: %v", name, expected, encoded.Bytes())
+		}
+	}
+}
+
+func assertGoldenValueEncoding(t *testing.T, name string, expected []byte,
+	desc *scale_codec.TypeDesc, value *scale_codec.Value) {
+	t.Helper()
+
+	output, err := scale_codec.EncodeValue(desc, value)
+	if err != nil {
+		t.Fatalf("%s: unexpected error: %v", name, err)
+	}
+
+	if !bytes.Equal(expected, output) {
+		t.Fatalf("%s\nexpected: %v\ngot: %v", name, expected, output)
+	}
+}
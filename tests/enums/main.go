@@ -1,4 +1,5 @@
 package main
 
-//go:generate enum_script simple_enum.scale main
+//go:generate enum_script -tests simple_enum.scale main
+//go:generate enum_script -q -in simple_enum.scale -lang rust -out ../rust-scale-codec/src
 func main() {}
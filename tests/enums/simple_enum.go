@@ -62,6 +62,45 @@ func UnmarshalT2FromRawBytes[A scale_codec.Marshaler,B scale_codec.Marshaler](
 		return tuple, nil
 	}
 }
+
+func (t *T2[A,B]) String() string {
+	return scale_codec.DebugTuple("", t.F0, t.F1)
+}
+
+func (t *T2[A,B]) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONTuple(t.F0, t.F1)
+}
+
+func (t *T2[A,B]) UnmarshalJSONWith(data []byte, jsonA func([]byte) (A, error),jsonB func([]byte) (B, error)) error {
+	items, err := scale_codec.UnmarshalJSONTuple(data, 2)
+	if err != nil {
+		return err
+	}
+	
+	t.F0, err = jsonA(items[0])
+	if err != nil {
+		return err
+	}
+	
+	t.F1, err = jsonB(items[1])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalT2FromJSON[A scale_codec.Marshaler,B scale_codec.Marshaler](
+	jsonA func([]byte) (A, error),jsonB func([]byte) (B, error)) func([]byte) (*T2[A,B], error) {
+	return func(data []byte) (*T2[A,B], error) {
+		tuple := new(T2[A,B])
+		err := tuple.UnmarshalJSONWith(data, jsonA, jsonB)
+		if err != nil {
+			return nil, err
+		}
+		return tuple, nil
+	}
+}
 type T3[A scale_codec.Marshaler,B scale_codec.Marshaler,C scale_codec.Marshaler] struct {
 	F0 A
 	F1 B
@@ -129,6 +168,50 @@ func UnmarshalT3FromRawBytes[A scale_codec.Marshaler,B scale_codec.Marshaler,C s
 	}
 }
 
+func (t *T3[A,B,C]) String() string {
+	return scale_codec.DebugTuple("", t.F0, t.F1, t.F2)
+}
+
+func (t *T3[A,B,C]) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONTuple(t.F0, t.F1, t.F2)
+}
+
+func (t *T3[A,B,C]) UnmarshalJSONWith(data []byte, jsonA func([]byte) (A, error),jsonB func([]byte) (B, error),jsonC func([]byte) (C, error)) error {
+	items, err := scale_codec.UnmarshalJSONTuple(data, 3)
+	if err != nil {
+		return err
+	}
+	
+	t.F0, err = jsonA(items[0])
+	if err != nil {
+		return err
+	}
+	
+	t.F1, err = jsonB(items[1])
+	if err != nil {
+		return err
+	}
+	
+	t.F2, err = jsonC(items[2])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalT3FromJSON[A scale_codec.Marshaler,B scale_codec.Marshaler,C scale_codec.Marshaler](
+	jsonA func([]byte) (A, error),jsonB func([]byte) (B, error),jsonC func([]byte) (C, error)) func([]byte) (*T3[A,B,C], error) {
+	return func(data []byte) (*T3[A,B,C], error) {
+		tuple := new(T3[A,B,C])
+		err := tuple.UnmarshalJSONWith(data, jsonA, jsonB, jsonC)
+		if err != nil {
+			return nil, err
+		}
+		return tuple, nil
+	}
+}
+
 
 type Nested interface {
 	scale_codec.Encodable
@@ -160,35 +243,167 @@ func UnmarshalNested(reader io.Reader) (Nested, error) {
 		return nil, fmt.Errorf("unexpected enum tag: %v", enumTag[0])
 	}
 }
-type Error interface {
-	scale_codec.Encodable
-	IsError()
-}
 
-func UnmarshalError(reader io.Reader) (Error, error) {
-	enumTag := make([]byte, 1)
-	n, err := reader.Read(enumTag)
+func UnmarshalNestedJSON(data []byte) (Nested, error) {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
 	if err != nil {
 		return nil, err
 	}
 
-	if n != 1 {
-		return nil, fmt.Errorf("%w: got %v", scale_codec.ErrExpectedOneByteRead, n)
-	}
-
-	switch enumTag[0] {
+	switch name {
 	
-	case FailureXIndex:
-		unmarshaler := NewFailureX()
-		err := unmarshaler.UnmarshalSCALE(reader)
+	case "Number":
+		unmarshaler := NewNumber()
+		err := unmarshaler.UnmarshalJSON(data)
 		if err != nil {
 			return nil, err
 		}
-		return unmarshaler, err
+		return unmarshaler, nil
 	
 	default:
-		return nil, fmt.Errorf("unexpected enum tag: %v", enumTag[0])
+		return nil, fmt.Errorf("%w: unexpected Nested variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+}
+
+// NestedVisitor has a method per Nested variant, a visitor given
+// to VisitNested stops compiling when a variant is added
+type NestedVisitor interface {
+	VisitNumber(*Number) error
+}
+
+// VisitNested calls the visitor method of the variant of value
+func VisitNested(value Nested, visitor NestedVisitor) error {
+	switch variant := value.(type) {
+	case *Number:
+		return visitor.VisitNumber(variant)
+	default:
+		return fmt.Errorf("unexpected Nested variant: %T", value)
+	}
+}
+
+// NestedCases holds a func per Nested variant for MatchNested,
+// it is built by NewNestedCases so a variant added breaks the build
+type NestedCases[R any] struct {
+	onNumber func(*Number) R
+}
+
+// NewNestedCases returns the cases of MatchNested, taking
+// a func per Nested variant in declaration order
+func NewNestedCases[R any](onNumber func(*Number) R) NestedCases[R] {
+	return NestedCases[R]{
+		onNumber: onNumber,
+	}
+}
+
+// MatchNested returns the result of the case of the variant of value,
+// it panics if that case is nil
+func MatchNested[R any](value Nested, cases NestedCases[R]) R {
+	switch variant := value.(type) {
+	case *Number:
+		if cases.onNumber == nil {
+			panic("MatchNested: missing case for Number")
+		}
+		return cases.onNumber(variant)
+	default:
+		panic(fmt.Sprintf("MatchNested: unexpected variant %T", value))
+	}
+}
+type Error uint8
+
+const (
+	FailureX Error = 0
+)
+
+var _ scale_codec.Encodable = (*Error)(nil)
+
+// Ptr returns a pointer to a copy of e, as expected by the fields of type Error
+func (e Error) Ptr() *Error {
+	return &e
+}
+
+// VariantPosition orders the Error variants by declaration, as Rust's Ord
+func (e Error) VariantPosition() int {
+	switch e {
+	case FailureX:
+		return 0
+	default:
+		return -1
+	}
+}
+
+func (e Error) IsValid() bool {
+	switch e {
+	case FailureX:
+		return true
+	default:
+		return false
+	}
+}
+
+func (e Error) String() string {
+	switch e {
+	case FailureX:
+		return "FailureX"
+	default:
+		return fmt.Sprintf("Error(%d)", uint8(e))
+	}
+}
+
+func (e Error) MarshalJSON() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("unexpected Error value: %d", uint8(e))
+	}
+	return []byte(`"` + e.String() + `"`), nil
+}
+
+func (e *Error) UnmarshalJSON(data []byte) error {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "FailureX":
+		*e = FailureX
+	default:
+		return fmt.Errorf("%w: unexpected Error variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+	return nil
+}
+
+func (e Error) MarshalSCALE() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("unexpected Error value: %d", uint8(e))
+	}
+	return []byte{byte(e)}, nil
+}
+
+func (e *Error) UnmarshalSCALE(reader io.Reader) error {
+	enumTag := make([]byte, 1)
+	n, err := reader.Read(enumTag)
+	if err != nil {
+		return err
+	}
+
+	if n != 1 {
+		return fmt.Errorf("%w: got %v", scale_codec.ErrExpectedOneByteRead, n)
+	}
+
+	value := Error(enumTag[0])
+	if !value.IsValid() {
+		return fmt.Errorf("unexpected enum tag: %v", enumTag[0])
+	}
+
+	*e = value
+	return nil
+}
+
+func UnmarshalError(reader io.Reader) (*Error, error) {
+	e := new(Error)
+	if err := e.UnmarshalSCALE(reader); err != nil {
+		return nil, err
 	}
+	return e, nil
 }
 type MyScaleEncodedEnum interface {
 	scale_codec.Encodable
@@ -341,65 +556,667 @@ func UnmarshalMyScaleEncodedEnum(reader io.Reader) (MyScaleEncodedEnum, error) {
 	}
 }
 
-
-var NumberIndex byte = 0
-
-var _ Nested = (*Number)(nil)
-
-type Number struct {
-	Inner *scale_codec.Integer[uint32]
-}
-
-func NewNumber() *Number {
-	return &Number{
-		Inner: new(scale_codec.Integer[uint32]),
-	}
-}
-
-func (Number) IsNested() {}
-
-func (i Number) MarshalSCALE() ([]byte, error) {
-	innerEncode, err := i.Inner.MarshalSCALE()
+func UnmarshalMyScaleEncodedEnumJSON(data []byte) (MyScaleEncodedEnum, error) {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
 	if err != nil {
 		return nil, err
 	}
 
-	idx := NumberIndex
-	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
-}
-
-func (i *Number) UnmarshalSCALE(reader io.Reader) error {
-	return i.Inner.UnmarshalSCALE(reader)
-}
-var FailureXIndex byte = 0
-
-var _ Error = (*FailureX)(nil)
-
-type FailureX struct {
-	Inner *scale_codec.SimpleVariant
+	switch name {
+	
+	case "Single":
+		unmarshaler := NewSingle()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Int":
+		unmarshaler := NewInt()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Bool":
+		unmarshaler := NewBool()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "A":
+		unmarshaler := NewA()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "B":
+		unmarshaler := NewB()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "G":
+		unmarshaler := NewG()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "H":
+		unmarshaler := NewH()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "J":
+		unmarshaler := NewJ()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "K":
+		unmarshaler := NewK()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "L":
+		unmarshaler := NewL()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "M":
+		unmarshaler := NewM()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "N":
+		unmarshaler := NewN()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "O":
+		unmarshaler := NewO()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "P":
+		unmarshaler := NewP()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Q":
+		unmarshaler := NewQ()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "R":
+		unmarshaler := NewR()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	default:
+		return nil, fmt.Errorf("%w: unexpected MyScaleEncodedEnum variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
 }
 
-func NewFailureX() *FailureX {
-	return &FailureX{
-		Inner: new(scale_codec.SimpleVariant),
+// MyScaleEncodedEnumVisitor has a method per MyScaleEncodedEnum variant, a visitor given
+// to VisitMyScaleEncodedEnum stops compiling when a variant is added
+type MyScaleEncodedEnumVisitor interface {
+	VisitSingle(*Single) error
+	VisitInt(*Int) error
+	VisitBool(*Bool) error
+	VisitA(*A) error
+	VisitB(*B) error
+	VisitG(*G) error
+	VisitH(*H) error
+	VisitJ(*J) error
+	VisitK(*K) error
+	VisitL(*L) error
+	VisitM(*M) error
+	VisitN(*N) error
+	VisitO(*O) error
+	VisitP(*P) error
+	VisitQ(*Q) error
+	VisitR(*R) error
+}
+
+// VisitMyScaleEncodedEnum calls the visitor method of the variant of value
+func VisitMyScaleEncodedEnum(value MyScaleEncodedEnum, visitor MyScaleEncodedEnumVisitor) error {
+	switch variant := value.(type) {
+	case *Single:
+		return visitor.VisitSingle(variant)
+	case *Int:
+		return visitor.VisitInt(variant)
+	case *Bool:
+		return visitor.VisitBool(variant)
+	case *A:
+		return visitor.VisitA(variant)
+	case *B:
+		return visitor.VisitB(variant)
+	case *G:
+		return visitor.VisitG(variant)
+	case *H:
+		return visitor.VisitH(variant)
+	case *J:
+		return visitor.VisitJ(variant)
+	case *K:
+		return visitor.VisitK(variant)
+	case *L:
+		return visitor.VisitL(variant)
+	case *M:
+		return visitor.VisitM(variant)
+	case *N:
+		return visitor.VisitN(variant)
+	case *O:
+		return visitor.VisitO(variant)
+	case *P:
+		return visitor.VisitP(variant)
+	case *Q:
+		return visitor.VisitQ(variant)
+	case *R:
+		return visitor.VisitR(variant)
+	default:
+		return fmt.Errorf("unexpected MyScaleEncodedEnum variant: %T", value)
 	}
 }
 
-func (FailureX) IsError() {}
-
-func (i FailureX) MarshalSCALE() ([]byte, error) {
-	innerEncode, err := i.Inner.MarshalSCALE()
-	if err != nil {
-		return nil, err
+// MyScaleEncodedEnumCases holds a func per MyScaleEncodedEnum variant for MatchMyScaleEncodedEnum,
+// it is built by NewMyScaleEncodedEnumCases so a variant added breaks the build
+type MyScaleEncodedEnumCases[RR any] struct {
+	onSingle func(*Single) RR
+	onInt func(*Int) RR
+	onBool func(*Bool) RR
+	onA func(*A) RR
+	onB func(*B) RR
+	onG func(*G) RR
+	onH func(*H) RR
+	onJ func(*J) RR
+	onK func(*K) RR
+	onL func(*L) RR
+	onM func(*M) RR
+	onN func(*N) RR
+	onO func(*O) RR
+	onP func(*P) RR
+	onQ func(*Q) RR
+	onR func(*R) RR
+}
+
+// NewMyScaleEncodedEnumCases returns the cases of MatchMyScaleEncodedEnum, taking
+// a func per MyScaleEncodedEnum variant in declaration order
+func NewMyScaleEncodedEnumCases[RR any](onSingle func(*Single) RR, onInt func(*Int) RR, onBool func(*Bool) RR, onA func(*A) RR, onB func(*B) RR, onG func(*G) RR, onH func(*H) RR, onJ func(*J) RR, onK func(*K) RR, onL func(*L) RR, onM func(*M) RR, onN func(*N) RR, onO func(*O) RR, onP func(*P) RR, onQ func(*Q) RR, onR func(*R) RR) MyScaleEncodedEnumCases[RR] {
+	return MyScaleEncodedEnumCases[RR]{
+		onSingle: onSingle,
+		onInt: onInt,
+		onBool: onBool,
+		onA: onA,
+		onB: onB,
+		onG: onG,
+		onH: onH,
+		onJ: onJ,
+		onK: onK,
+		onL: onL,
+		onM: onM,
+		onN: onN,
+		onO: onO,
+		onP: onP,
+		onQ: onQ,
+		onR: onR,
 	}
+}
 
-	idx := FailureXIndex
+// MatchMyScaleEncodedEnum returns the result of the case of the variant of value,
+// it panics if that case is nil
+func MatchMyScaleEncodedEnum[RR any](value MyScaleEncodedEnum, cases MyScaleEncodedEnumCases[RR]) RR {
+	switch variant := value.(type) {
+	case *Single:
+		if cases.onSingle == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for Single")
+		}
+		return cases.onSingle(variant)
+	case *Int:
+		if cases.onInt == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for Int")
+		}
+		return cases.onInt(variant)
+	case *Bool:
+		if cases.onBool == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for Bool")
+		}
+		return cases.onBool(variant)
+	case *A:
+		if cases.onA == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for A")
+		}
+		return cases.onA(variant)
+	case *B:
+		if cases.onB == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for B")
+		}
+		return cases.onB(variant)
+	case *G:
+		if cases.onG == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for G")
+		}
+		return cases.onG(variant)
+	case *H:
+		if cases.onH == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for H")
+		}
+		return cases.onH(variant)
+	case *J:
+		if cases.onJ == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for J")
+		}
+		return cases.onJ(variant)
+	case *K:
+		if cases.onK == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for K")
+		}
+		return cases.onK(variant)
+	case *L:
+		if cases.onL == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for L")
+		}
+		return cases.onL(variant)
+	case *M:
+		if cases.onM == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for M")
+		}
+		return cases.onM(variant)
+	case *N:
+		if cases.onN == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for N")
+		}
+		return cases.onN(variant)
+	case *O:
+		if cases.onO == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for O")
+		}
+		return cases.onO(variant)
+	case *P:
+		if cases.onP == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for P")
+		}
+		return cases.onP(variant)
+	case *Q:
+		if cases.onQ == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for Q")
+		}
+		return cases.onQ(variant)
+	case *R:
+		if cases.onR == nil {
+			panic("MatchMyScaleEncodedEnum: missing case for R")
+		}
+		return cases.onR(variant)
+	default:
+		panic(fmt.Sprintf("MatchMyScaleEncodedEnum: unexpected variant %T", value))
+	}
+}
+type Pallet interface {
+	scale_codec.Encodable
+	IsPallet()
+}
+
+func UnmarshalPallet(reader io.Reader) (Pallet, error) {
+	enumTag := make([]byte, 1)
+	n, err := reader.Read(enumTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("%w: got %v", scale_codec.ErrExpectedOneByteRead, n)
+	}
+
+	switch enumTag[0] {
+	
+	case RemarkIndex:
+		unmarshaler := NewRemark()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case TransferIndex:
+		unmarshaler := NewTransfer()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case BurnIndex:
+		unmarshaler := NewBurn()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	default:
+		return nil, fmt.Errorf("unexpected enum tag: %v", enumTag[0])
+	}
+}
+
+func UnmarshalPalletJSON(data []byte) (Pallet, error) {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	
+	case "Remark":
+		unmarshaler := NewRemark()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Transfer":
+		unmarshaler := NewTransfer()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Burn":
+		unmarshaler := NewBurn()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	default:
+		return nil, fmt.Errorf("%w: unexpected Pallet variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+}
+
+// PalletVisitor has a method per Pallet variant, a visitor given
+// to VisitPallet stops compiling when a variant is added
+type PalletVisitor interface {
+	VisitRemark(*Remark) error
+	VisitTransfer(*Transfer) error
+	VisitBurn(*Burn) error
+}
+
+// VisitPallet calls the visitor method of the variant of value
+func VisitPallet(value Pallet, visitor PalletVisitor) error {
+	switch variant := value.(type) {
+	case *Remark:
+		return visitor.VisitRemark(variant)
+	case *Transfer:
+		return visitor.VisitTransfer(variant)
+	case *Burn:
+		return visitor.VisitBurn(variant)
+	default:
+		return fmt.Errorf("unexpected Pallet variant: %T", value)
+	}
+}
+
+// PalletCases holds a func per Pallet variant for MatchPallet,
+// it is built by NewPalletCases so a variant added breaks the build
+type PalletCases[R any] struct {
+	onRemark func(*Remark) R
+	onTransfer func(*Transfer) R
+	onBurn func(*Burn) R
+}
+
+// NewPalletCases returns the cases of MatchPallet, taking
+// a func per Pallet variant in declaration order
+func NewPalletCases[R any](onRemark func(*Remark) R, onTransfer func(*Transfer) R, onBurn func(*Burn) R) PalletCases[R] {
+	return PalletCases[R]{
+		onRemark: onRemark,
+		onTransfer: onTransfer,
+		onBurn: onBurn,
+	}
+}
+
+// MatchPallet returns the result of the case of the variant of value,
+// it panics if that case is nil
+func MatchPallet[R any](value Pallet, cases PalletCases[R]) R {
+	switch variant := value.(type) {
+	case *Remark:
+		if cases.onRemark == nil {
+			panic("MatchPallet: missing case for Remark")
+		}
+		return cases.onRemark(variant)
+	case *Transfer:
+		if cases.onTransfer == nil {
+			panic("MatchPallet: missing case for Transfer")
+		}
+		return cases.onTransfer(variant)
+	case *Burn:
+		if cases.onBurn == nil {
+			panic("MatchPallet: missing case for Burn")
+		}
+		return cases.onBurn(variant)
+	default:
+		panic(fmt.Sprintf("MatchPallet: unexpected variant %T", value))
+	}
+}
+type Status uint8
+
+const (
+	Active Status = 0
+	Frozen Status = 3
+	Closed Status = 2
+)
+
+var _ scale_codec.Encodable = (*Status)(nil)
+
+// Ptr returns a pointer to a copy of e, as expected by the fields of type Status
+func (e Status) Ptr() *Status {
+	return &e
+}
+
+// VariantPosition orders the Status variants by declaration, as Rust's Ord
+func (e Status) VariantPosition() int {
+	switch e {
+	case Active:
+		return 0
+	case Frozen:
+		return 1
+	case Closed:
+		return 2
+	default:
+		return -1
+	}
+}
+
+func (e Status) IsValid() bool {
+	switch e {
+	case Active, Frozen, Closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (e Status) String() string {
+	switch e {
+	case Active:
+		return "Active"
+	case Frozen:
+		return "Frozen"
+	case Closed:
+		return "Closed"
+	default:
+		return fmt.Sprintf("Status(%d)", uint8(e))
+	}
+}
+
+func (e Status) MarshalJSON() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("unexpected Status value: %d", uint8(e))
+	}
+	return []byte(`"` + e.String() + `"`), nil
+}
+
+func (e *Status) UnmarshalJSON(data []byte) error {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "Active":
+		*e = Active
+	case "Frozen":
+		*e = Frozen
+	case "Closed":
+		*e = Closed
+	default:
+		return fmt.Errorf("%w: unexpected Status variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+	return nil
+}
+
+func (e Status) MarshalSCALE() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("unexpected Status value: %d", uint8(e))
+	}
+	return []byte{byte(e)}, nil
+}
+
+func (e *Status) UnmarshalSCALE(reader io.Reader) error {
+	enumTag := make([]byte, 1)
+	n, err := reader.Read(enumTag)
+	if err != nil {
+		return err
+	}
+
+	if n != 1 {
+		return fmt.Errorf("%w: got %v", scale_codec.ErrExpectedOneByteRead, n)
+	}
+
+	value := Status(enumTag[0])
+	if !value.IsValid() {
+		return fmt.Errorf("unexpected enum tag: %v", enumTag[0])
+	}
+
+	*e = value
+	return nil
+}
+
+func UnmarshalStatus(reader io.Reader) (*Status, error) {
+	e := new(Status)
+	if err := e.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+
+var NumberIndex byte = 0
+
+var _ Nested = (*Number)(nil)
+
+type Number struct {
+	Inner *scale_codec.Integer[uint32]
+}
+
+func NewNumber() *Number {
+	return &Number{
+		Inner: new(scale_codec.Integer[uint32]),
+	}
+}
+
+func (Number) IsNested() {}
+
+// VariantPosition orders the Nested variants by declaration, as Rust's Ord
+func (Number) VariantPosition() int {
+	return 0
+}
+
+func (i Number) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := NumberIndex
 	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
 }
 
-func (i *FailureX) UnmarshalSCALE(reader io.Reader) error {
+func (i *Number) UnmarshalSCALE(reader io.Reader) error {
 	return i.Inner.UnmarshalSCALE(reader)
 }
+
+func (i Number) String() string {
+	return scale_codec.DebugTuple("Number", i.Inner)
+}
+
+func (i Number) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Number", i.Inner)
+}
+
+func (i *Number) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Number" {
+		return fmt.Errorf("%w: expected Number variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.FromJSON[scale_codec.Integer[uint32]](value)
+	return err
+}
+
+// NewNumberFrom builds a Number variant from Go values
+func NewNumberFrom(v uint32) *Number {
+	return &Number{
+		Inner: &scale_codec.Integer[uint32]{Value: v},
+	}
+}
+
+func (variant Number) Get() uint32 {
+	return variant.Inner.Value
+}
 var SingleIndex byte = 0
 
 var _ MyScaleEncodedEnum = (*Single)(nil)
@@ -416,6 +1233,11 @@ func NewSingle() *Single {
 
 func (Single) IsMyScaleEncodedEnum() {}
 
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (Single) VariantPosition() int {
+	return 0
+}
+
 func (i Single) MarshalSCALE() ([]byte, error) {
 	innerEncode, err := i.Inner.MarshalSCALE()
 	if err != nil {
@@ -429,6 +1251,26 @@ func (i Single) MarshalSCALE() ([]byte, error) {
 func (i *Single) UnmarshalSCALE(reader io.Reader) error {
 	return i.Inner.UnmarshalSCALE(reader)
 }
+
+func (i Single) String() string {
+	return "Single"
+}
+
+func (i Single) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Single", nil)
+}
+
+func (i *Single) UnmarshalJSON(data []byte) error {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Single" {
+		return fmt.Errorf("%w: expected Single variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+	return nil
+}
 var IntIndex byte = 1
 
 var _ MyScaleEncodedEnum = (*Int)(nil)
@@ -445,6 +1287,11 @@ func NewInt() *Int {
 
 func (Int) IsMyScaleEncodedEnum() {}
 
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (Int) VariantPosition() int {
+	return 1
+}
+
 func (i Int) MarshalSCALE() ([]byte, error) {
 	innerEncode, err := i.Inner.MarshalSCALE()
 	if err != nil {
@@ -458,6 +1305,39 @@ func (i Int) MarshalSCALE() ([]byte, error) {
 func (i *Int) UnmarshalSCALE(reader io.Reader) error {
 	return i.Inner.UnmarshalSCALE(reader)
 }
+
+func (i Int) String() string {
+	return scale_codec.DebugTuple("Int", i.Inner)
+}
+
+func (i Int) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Int", i.Inner)
+}
+
+func (i *Int) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Int" {
+		return fmt.Errorf("%w: expected Int variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.FromJSON[scale_codec.Integer[uint64]](value)
+	return err
+}
+
+// NewIntFrom builds a Int variant from Go values
+func NewIntFrom(v uint64) *Int {
+	return &Int{
+		Inner: &scale_codec.Integer[uint64]{Value: v},
+	}
+}
+
+func (variant Int) Get() uint64 {
+	return variant.Inner.Value
+}
 var BoolIndex byte = 2
 
 var _ MyScaleEncodedEnum = (*Bool)(nil)
@@ -474,6 +1354,11 @@ func NewBool() *Bool {
 
 func (Bool) IsMyScaleEncodedEnum() {}
 
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (Bool) VariantPosition() int {
+	return 2
+}
+
 func (i Bool) MarshalSCALE() ([]byte, error) {
 	innerEncode, err := i.Inner.MarshalSCALE()
 	if err != nil {
@@ -487,6 +1372,39 @@ func (i Bool) MarshalSCALE() ([]byte, error) {
 func (i *Bool) UnmarshalSCALE(reader io.Reader) error {
 	return i.Inner.UnmarshalSCALE(reader)
 }
+
+func (i Bool) String() string {
+	return scale_codec.DebugTuple("Bool", i.Inner)
+}
+
+func (i Bool) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Bool", i.Inner)
+}
+
+func (i *Bool) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Bool" {
+		return fmt.Errorf("%w: expected Bool variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.FromJSON[scale_codec.Bool](value)
+	return err
+}
+
+// NewBoolFrom builds a Bool variant from Go values
+func NewBoolFrom(v bool) *Bool {
+	return &Bool{
+		Inner: &scale_codec.Bool{Value: v},
+	}
+}
+
+func (variant Bool) Get() bool {
+	return variant.Inner.Value
+}
 var AIndex byte = 3
 
 var _ MyScaleEncodedEnum = (*A)(nil)
@@ -503,6 +1421,11 @@ func NewA() *A {
 
 func (A) IsMyScaleEncodedEnum() {}
 
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (A) VariantPosition() int {
+	return 3
+}
+
 func (i A) MarshalSCALE() ([]byte, error) {
 	innerEncode, err := i.Inner.MarshalSCALE()
 	if err != nil {
@@ -516,351 +1439,1164 @@ func (i A) MarshalSCALE() ([]byte, error) {
 func (i *A) UnmarshalSCALE(reader io.Reader) error {
 	return i.Inner.UnmarshalSCALE(reader,scale_codec.BoolFromRawBytes)
 }
-var BIndex byte = 4
 
-var _ MyScaleEncodedEnum = (*B)(nil)
+func (i A) String() string {
+	return scale_codec.DebugTuple("A", i.Inner)
+}
 
-type B struct {
-	Inner *scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Integer[uint64]]
+func (i A) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("A", i.Inner)
 }
 
-func NewB() *B {
-	return &B{
-		Inner: new(scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Integer[uint64]]),
+func (i *A) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "A" {
+		return fmt.Errorf("%w: expected A variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.UnmarshalOptionFromJSON[*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Bool])(value)
+	return err
+}
+
+// NewANone builds a A variant from Go values
+func NewANone() *A {
+	return &A{
+		Inner: scale_codec.NoneG[*scale_codec.Bool](),
+	}
+}
+
+// NewASome builds a A variant from Go values
+func NewASome(v bool) *A {
+	return &A{
+		Inner: scale_codec.SomeG[*scale_codec.Bool](&scale_codec.Bool{Value: v}),
+	}
+}
+
+func (variant A) Some() (v bool, ok bool) {
+	inner, ok := variant.Inner.Unwrap()
+	if !ok {
+		return v, false
+	}
+	return inner.Value, true
+}
+var BIndex byte = 4
+
+var _ MyScaleEncodedEnum = (*B)(nil)
+
+type B struct {
+	Inner *scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Integer[uint64]]
+}
+
+func NewB() *B {
+	return &B{
+		Inner: new(scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Integer[uint64]]),
+	}
+}
+
+func (B) IsMyScaleEncodedEnum() {}
+
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (B) VariantPosition() int {
+	return 4
+}
+
+func (i B) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := BIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *B) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader, scale_codec.IntegerFromRawBytes[uint64], scale_codec.IntegerFromRawBytes[uint64])
+}
+
+func (i B) String() string {
+	return scale_codec.DebugTuple("B", i.Inner)
+}
+
+func (i B) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("B", i.Inner)
+}
+
+func (i *B) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "B" {
+		return fmt.Errorf("%w: expected B variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.UnmarshalResultFromJSON[*scale_codec.Integer[uint64],*scale_codec.Integer[uint64]](scale_codec.FromJSON[scale_codec.Integer[uint64]],scale_codec.FromJSON[scale_codec.Integer[uint64]])(value)
+	return err
+}
+
+// NewBOk builds a B variant from Go values
+func NewBOk(v uint64) *B {
+	return &B{
+		Inner: scale_codec.OkG[*scale_codec.Integer[uint64], *scale_codec.Integer[uint64]](&scale_codec.Integer[uint64]{Value: v}),
+	}
+}
+
+func (variant B) Ok() (v uint64, ok bool) {
+	inner, ok := variant.Inner.Ok()
+	if !ok {
+		return v, false
+	}
+	return inner.Value, true
+}
+
+// NewBErr builds a B variant from Go values
+func NewBErr(v uint64) *B {
+	return &B{
+		Inner: scale_codec.ErrG[*scale_codec.Integer[uint64], *scale_codec.Integer[uint64]](&scale_codec.Integer[uint64]{Value: v}),
+	}
+}
+
+func (variant B) Err() (v uint64, ok bool) {
+	inner, ok := variant.Inner.Err()
+	if !ok {
+		return v, false
+	}
+	return inner.Value, true
+}
+var GIndex byte = 5
+
+var _ MyScaleEncodedEnum = (*G)(nil)
+
+type G struct {
+	Inner *T2[*scale_codec.Integer[uint64],*scale_codec.Bool]
+}
+
+func NewG() *G {
+	return &G{
+		Inner: new(T2[*scale_codec.Integer[uint64],*scale_codec.Bool]),
+	}
+}
+
+func (G) IsMyScaleEncodedEnum() {}
+
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (G) VariantPosition() int {
+	return 5
+}
+
+func (i G) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := GIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *G) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader,scale_codec.IntegerFromRawBytes[uint64],scale_codec.BoolFromRawBytes)
+}
+
+func (i G) String() string {
+	return scale_codec.DebugTuple("G", i.Inner)
+}
+
+func (i G) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("G", i.Inner)
+}
+
+func (i *G) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "G" {
+		return fmt.Errorf("%w: expected G variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = UnmarshalT2FromJSON[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Integer[uint64]],scale_codec.FromJSON[scale_codec.Bool])(value)
+	return err
+}
+
+// NewGFrom builds a G variant from Go values
+func NewGFrom(a uint64, b bool) *G {
+	return &G{
+		Inner: &T2[*scale_codec.Integer[uint64],*scale_codec.Bool]{F0: &scale_codec.Integer[uint64]{Value: a}, F1: &scale_codec.Bool{Value: b}},
+	}
+}
+
+func (variant G) Get() (uint64, bool) {
+	return variant.Inner.F0.Value, variant.Inner.F1.Value
+}
+var HIndex byte = 6
+
+var _ MyScaleEncodedEnum = (*H)(nil)
+
+type H struct {
+	Inner *scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]]
+}
+
+func NewH() *H {
+	return &H{
+		Inner: new(scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]]),
+	}
+}
+
+func (H) IsMyScaleEncodedEnum() {}
+
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (H) VariantPosition() int {
+	return 6
+}
+
+func (i H) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := HIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *H) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader,UnmarshalT2FromRawBytes[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.IntegerFromRawBytes[uint64],scale_codec.BoolFromRawBytes))
+}
+
+func (i H) String() string {
+	return scale_codec.DebugTuple("H", i.Inner)
+}
+
+func (i H) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("H", i.Inner)
+}
+
+func (i *H) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "H" {
+		return fmt.Errorf("%w: expected H variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.UnmarshalOptionFromJSON[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]](UnmarshalT2FromJSON[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Integer[uint64]],scale_codec.FromJSON[scale_codec.Bool]))(value)
+	return err
+}
+
+// NewHNone builds a H variant from Go values
+func NewHNone() *H {
+	return &H{
+		Inner: scale_codec.NoneG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]](),
+	}
+}
+
+// NewHSome builds a H variant from Go values
+func NewHSome(a uint64, b bool) *H {
+	return &H{
+		Inner: scale_codec.SomeG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]](&T2[*scale_codec.Integer[uint64],*scale_codec.Bool]{F0: &scale_codec.Integer[uint64]{Value: a}, F1: &scale_codec.Bool{Value: b}}),
+	}
+}
+
+func (variant H) Some() (a uint64, b bool, ok bool) {
+	inner, ok := variant.Inner.Unwrap()
+	if !ok {
+		return a, b, false
+	}
+	return inner.F0.Value, inner.F1.Value, true
+}
+var JIndex byte = 7
+
+var _ MyScaleEncodedEnum = (*J)(nil)
+
+type J struct {
+	Inner *scale_codec.ResultG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.Bool]
+}
+
+func NewJ() *J {
+	return &J{
+		Inner: new(scale_codec.ResultG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.Bool]),
+	}
+}
+
+func (J) IsMyScaleEncodedEnum() {}
+
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (J) VariantPosition() int {
+	return 7
+}
+
+func (i J) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := JIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *J) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader, UnmarshalT2FromRawBytes[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.IntegerFromRawBytes[uint64],scale_codec.BoolFromRawBytes), scale_codec.BoolFromRawBytes)
+}
+
+func (i J) String() string {
+	return scale_codec.DebugTuple("J", i.Inner)
+}
+
+func (i J) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("J", i.Inner)
+}
+
+func (i *J) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "J" {
+		return fmt.Errorf("%w: expected J variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.UnmarshalResultFromJSON[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.Bool](UnmarshalT2FromJSON[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Integer[uint64]],scale_codec.FromJSON[scale_codec.Bool]),scale_codec.FromJSON[scale_codec.Bool])(value)
+	return err
+}
+
+// NewJOk builds a J variant from Go values
+func NewJOk(a uint64, b bool) *J {
+	return &J{
+		Inner: scale_codec.OkG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool], *scale_codec.Bool](&T2[*scale_codec.Integer[uint64],*scale_codec.Bool]{F0: &scale_codec.Integer[uint64]{Value: a}, F1: &scale_codec.Bool{Value: b}}),
+	}
+}
+
+func (variant J) Ok() (a uint64, b bool, ok bool) {
+	inner, ok := variant.Inner.Ok()
+	if !ok {
+		return a, b, false
+	}
+	return inner.F0.Value, inner.F1.Value, true
+}
+
+// NewJErr builds a J variant from Go values
+func NewJErr(v bool) *J {
+	return &J{
+		Inner: scale_codec.ErrG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool], *scale_codec.Bool](&scale_codec.Bool{Value: v}),
+	}
+}
+
+func (variant J) Err() (v bool, ok bool) {
+	inner, ok := variant.Inner.Err()
+	if !ok {
+		return v, false
+	}
+	return inner.Value, true
+}
+var KIndex byte = 8
+
+var _ MyScaleEncodedEnum = (*K)(nil)
+
+type K struct {
+	Inner *T2[*scale_codec.OptionG[*scale_codec.Bool],*scale_codec.ResultG[*scale_codec.Bool,*scale_codec.Bool]]
+}
+
+func NewK() *K {
+	return &K{
+		Inner: new(T2[*scale_codec.OptionG[*scale_codec.Bool],*scale_codec.ResultG[*scale_codec.Bool,*scale_codec.Bool]]),
+	}
+}
+
+func (K) IsMyScaleEncodedEnum() {}
+
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (K) VariantPosition() int {
+	return 8
+}
+
+func (i K) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := KIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *K) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader,scale_codec.UnmarshalOptionFromRawBytes[*scale_codec.Bool](scale_codec.BoolFromRawBytes),scale_codec.UnmarshalResultFromRawBytes[*scale_codec.Bool,*scale_codec.Bool](scale_codec.BoolFromRawBytes,scale_codec.BoolFromRawBytes))
+}
+
+func (i K) String() string {
+	return scale_codec.DebugTuple("K", i.Inner)
+}
+
+func (i K) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("K", i.Inner)
+}
+
+func (i *K) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "K" {
+		return fmt.Errorf("%w: expected K variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = UnmarshalT2FromJSON[*scale_codec.OptionG[*scale_codec.Bool],*scale_codec.ResultG[*scale_codec.Bool,*scale_codec.Bool]](scale_codec.UnmarshalOptionFromJSON[*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Bool]),scale_codec.UnmarshalResultFromJSON[*scale_codec.Bool,*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Bool],scale_codec.FromJSON[scale_codec.Bool]))(value)
+	return err
+}
+
+// NewKFrom builds a K variant from Go values
+func NewKFrom(a *scale_codec.OptionG[*scale_codec.Bool], b *scale_codec.ResultG[*scale_codec.Bool,*scale_codec.Bool]) *K {
+	return &K{
+		Inner: &T2[*scale_codec.OptionG[*scale_codec.Bool],*scale_codec.ResultG[*scale_codec.Bool,*scale_codec.Bool]]{F0: a, F1: b},
+	}
+}
+
+func (variant K) Get() (*scale_codec.OptionG[*scale_codec.Bool], *scale_codec.ResultG[*scale_codec.Bool,*scale_codec.Bool]) {
+	return variant.Inner.F0, variant.Inner.F1
+}
+var LIndex byte = 9
+
+var _ MyScaleEncodedEnum = (*L)(nil)
+
+type L struct {
+	Inner *scale_codec.ResultG[*scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]],*scale_codec.Integer[uint64]]
+}
+
+func NewL() *L {
+	return &L{
+		Inner: new(scale_codec.ResultG[*scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]],*scale_codec.Integer[uint64]]),
+	}
+}
+
+func (L) IsMyScaleEncodedEnum() {}
+
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (L) VariantPosition() int {
+	return 9
+}
+
+func (i L) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := LIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *L) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader, scale_codec.UnmarshalOptionFromRawBytes[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]](UnmarshalT2FromRawBytes[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.IntegerFromRawBytes[uint64],scale_codec.BoolFromRawBytes)), scale_codec.IntegerFromRawBytes[uint64])
+}
+
+func (i L) String() string {
+	return scale_codec.DebugTuple("L", i.Inner)
+}
+
+func (i L) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("L", i.Inner)
+}
+
+func (i *L) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "L" {
+		return fmt.Errorf("%w: expected L variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.UnmarshalResultFromJSON[*scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]],*scale_codec.Integer[uint64]](scale_codec.UnmarshalOptionFromJSON[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]](UnmarshalT2FromJSON[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Integer[uint64]],scale_codec.FromJSON[scale_codec.Bool])),scale_codec.FromJSON[scale_codec.Integer[uint64]])(value)
+	return err
+}
+
+// NewLOk builds a L variant from Go values
+func NewLOk(v *scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]]) *L {
+	return &L{
+		Inner: scale_codec.OkG[*scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]], *scale_codec.Integer[uint64]](v),
+	}
+}
+
+func (variant L) Ok() (v *scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]], ok bool) {
+	inner, ok := variant.Inner.Ok()
+	if !ok {
+		return v, false
+	}
+	return inner, true
+}
+
+// NewLErr builds a L variant from Go values
+func NewLErr(v uint64) *L {
+	return &L{
+		Inner: scale_codec.ErrG[*scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]], *scale_codec.Integer[uint64]](&scale_codec.Integer[uint64]{Value: v}),
+	}
+}
+
+func (variant L) Err() (v uint64, ok bool) {
+	inner, ok := variant.Inner.Err()
+	if !ok {
+		return v, false
+	}
+	return inner.Value, true
+}
+var MIndex byte = 10
+
+var _ MyScaleEncodedEnum = (*M)(nil)
+
+type M struct {
+	Inner *scale_codec.OptionG[Nested]
+}
+
+func NewM() *M {
+	return &M{
+		Inner: new(scale_codec.OptionG[Nested]),
+	}
+}
+
+func (M) IsMyScaleEncodedEnum() {}
+
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (M) VariantPosition() int {
+	return 10
+}
+
+func (i M) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := MIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *M) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader, UnmarshalNested)
+}
+
+func (i M) String() string {
+	return scale_codec.DebugTuple("M", i.Inner)
+}
+
+func (i M) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("M", i.Inner)
+}
+
+func (i *M) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "M" {
+		return fmt.Errorf("%w: expected M variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.UnmarshalOptionFromJSON[Nested](UnmarshalNestedJSON)(value)
+	return err
+}
+
+// NewMNone builds a M variant from Go values
+func NewMNone() *M {
+	return &M{
+		Inner: scale_codec.NoneG[Nested](),
+	}
+}
+
+// NewMSome builds a M variant from Go values
+func NewMSome(v Nested) *M {
+	return &M{
+		Inner: scale_codec.SomeG[Nested](v),
+	}
+}
+
+func (variant M) Some() (v Nested, ok bool) {
+	inner, ok := variant.Inner.Unwrap()
+	if !ok {
+		return v, false
+	}
+	return inner, true
+}
+var NIndex byte = 11
+
+var _ MyScaleEncodedEnum = (*N)(nil)
+
+type N struct {
+	Inner *scale_codec.ResultG[Nested,*scale_codec.Bool]
+}
+
+func NewN() *N {
+	return &N{
+		Inner: new(scale_codec.ResultG[Nested,*scale_codec.Bool]),
+	}
+}
+
+func (N) IsMyScaleEncodedEnum() {}
+
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (N) VariantPosition() int {
+	return 11
+}
+
+func (i N) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := NIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *N) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader, UnmarshalNested, scale_codec.BoolFromRawBytes)
+}
+
+func (i N) String() string {
+	return scale_codec.DebugTuple("N", i.Inner)
+}
+
+func (i N) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("N", i.Inner)
+}
+
+func (i *N) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "N" {
+		return fmt.Errorf("%w: expected N variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.UnmarshalResultFromJSON[Nested,*scale_codec.Bool](UnmarshalNestedJSON,scale_codec.FromJSON[scale_codec.Bool])(value)
+	return err
+}
+
+// NewNOk builds a N variant from Go values
+func NewNOk(v Nested) *N {
+	return &N{
+		Inner: scale_codec.OkG[Nested, *scale_codec.Bool](v),
+	}
+}
+
+func (variant N) Ok() (v Nested, ok bool) {
+	inner, ok := variant.Inner.Ok()
+	if !ok {
+		return v, false
+	}
+	return inner, true
+}
+
+// NewNErr builds a N variant from Go values
+func NewNErr(v bool) *N {
+	return &N{
+		Inner: scale_codec.ErrG[Nested, *scale_codec.Bool](&scale_codec.Bool{Value: v}),
+	}
+}
+
+func (variant N) Err() (v bool, ok bool) {
+	inner, ok := variant.Inner.Err()
+	if !ok {
+		return v, false
+	}
+	return inner.Value, true
+}
+var OIndex byte = 12
+
+var _ MyScaleEncodedEnum = (*O)(nil)
+
+type O struct {
+	Inner *scale_codec.ResultG[*scale_codec.Bool,Nested]
+}
+
+func NewO() *O {
+	return &O{
+		Inner: new(scale_codec.ResultG[*scale_codec.Bool,Nested]),
+	}
+}
+
+func (O) IsMyScaleEncodedEnum() {}
+
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (O) VariantPosition() int {
+	return 12
+}
+
+func (i O) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := OIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *O) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader, scale_codec.BoolFromRawBytes, UnmarshalNested)
+}
+
+func (i O) String() string {
+	return scale_codec.DebugTuple("O", i.Inner)
+}
+
+func (i O) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("O", i.Inner)
+}
+
+func (i *O) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "O" {
+		return fmt.Errorf("%w: expected O variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.UnmarshalResultFromJSON[*scale_codec.Bool,Nested](scale_codec.FromJSON[scale_codec.Bool],UnmarshalNestedJSON)(value)
+	return err
+}
+
+// NewOOk builds a O variant from Go values
+func NewOOk(v bool) *O {
+	return &O{
+		Inner: scale_codec.OkG[*scale_codec.Bool, Nested](&scale_codec.Bool{Value: v}),
+	}
+}
+
+func (variant O) Ok() (v bool, ok bool) {
+	inner, ok := variant.Inner.Ok()
+	if !ok {
+		return v, false
+	}
+	return inner.Value, true
+}
+
+// NewOErr builds a O variant from Go values
+func NewOErr(v Nested) *O {
+	return &O{
+		Inner: scale_codec.ErrG[*scale_codec.Bool, Nested](v),
+	}
+}
+
+func (variant O) Err() (v Nested, ok bool) {
+	inner, ok := variant.Inner.Err()
+	if !ok {
+		return v, false
+	}
+	return inner, true
+}
+var PIndex byte = 13
+
+var _ MyScaleEncodedEnum = (*P)(nil)
+
+type P struct {
+	Inner *scale_codec.ResultG[Nested,*Error]
+}
+
+func NewP() *P {
+	return &P{
+		Inner: new(scale_codec.ResultG[Nested,*Error]),
 	}
 }
 
-func (B) IsMyScaleEncodedEnum() {}
+func (P) IsMyScaleEncodedEnum() {}
 
-func (i B) MarshalSCALE() ([]byte, error) {
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (P) VariantPosition() int {
+	return 13
+}
+
+func (i P) MarshalSCALE() ([]byte, error) {
 	innerEncode, err := i.Inner.MarshalSCALE()
 	if err != nil {
 		return nil, err
 	}
 
-	idx := BIndex
+	idx := PIndex
 	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
 }
 
-func (i *B) UnmarshalSCALE(reader io.Reader) error {
-	return i.Inner.UnmarshalSCALE(reader, scale_codec.IntegerFromRawBytes[uint64], scale_codec.IntegerFromRawBytes[uint64])
+func (i *P) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader, UnmarshalNested, UnmarshalError)
 }
-var GIndex byte = 5
 
-var _ MyScaleEncodedEnum = (*G)(nil)
+func (i P) String() string {
+	return scale_codec.DebugTuple("P", i.Inner)
+}
 
-type G struct {
-	Inner *T2[*scale_codec.Integer[uint64],*scale_codec.Bool]
+func (i P) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("P", i.Inner)
 }
 
-func NewG() *G {
-	return &G{
-		Inner: new(T2[*scale_codec.Integer[uint64],*scale_codec.Bool]),
+func (i *P) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
 	}
+
+	if name != "P" {
+		return fmt.Errorf("%w: expected P variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.UnmarshalResultFromJSON[Nested,*Error](UnmarshalNestedJSON,scale_codec.FromJSON[Error])(value)
+	return err
 }
 
-func (G) IsMyScaleEncodedEnum() {}
+// NewPOk builds a P variant from Go values
+func NewPOk(v Nested) *P {
+	return &P{
+		Inner: scale_codec.OkG[Nested, *Error](v),
+	}
+}
 
-func (i G) MarshalSCALE() ([]byte, error) {
-	innerEncode, err := i.Inner.MarshalSCALE()
-	if err != nil {
-		return nil, err
+func (variant P) Ok() (v Nested, ok bool) {
+	inner, ok := variant.Inner.Ok()
+	if !ok {
+		return v, false
 	}
+	return inner, true
+}
 
-	idx := GIndex
-	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+// NewPErr builds a P variant from Go values
+func NewPErr(v Error) *P {
+	return &P{
+		Inner: scale_codec.ErrG[Nested, *Error](v.Ptr()),
+	}
 }
 
-func (i *G) UnmarshalSCALE(reader io.Reader) error {
-	return i.Inner.UnmarshalSCALE(reader,scale_codec.IntegerFromRawBytes[uint64],scale_codec.BoolFromRawBytes)
+func (variant P) Err() (v Error, ok bool) {
+	inner, ok := variant.Inner.Err()
+	if !ok {
+		return v, false
+	}
+	return *inner, true
 }
-var HIndex byte = 6
+var QIndex byte = 14
 
-var _ MyScaleEncodedEnum = (*H)(nil)
+var _ MyScaleEncodedEnum = (*Q)(nil)
 
-type H struct {
-	Inner *scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]]
+type Q struct {
+	Inner *T3[Nested,*scale_codec.Integer[uint64],*Error]
 }
 
-func NewH() *H {
-	return &H{
-		Inner: new(scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]]),
+func NewQ() *Q {
+	return &Q{
+		Inner: new(T3[Nested,*scale_codec.Integer[uint64],*Error]),
 	}
 }
 
-func (H) IsMyScaleEncodedEnum() {}
+func (Q) IsMyScaleEncodedEnum() {}
 
-func (i H) MarshalSCALE() ([]byte, error) {
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (Q) VariantPosition() int {
+	return 14
+}
+
+func (i Q) MarshalSCALE() ([]byte, error) {
 	innerEncode, err := i.Inner.MarshalSCALE()
 	if err != nil {
 		return nil, err
 	}
 
-	idx := HIndex
+	idx := QIndex
 	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
 }
 
-func (i *H) UnmarshalSCALE(reader io.Reader) error {
-	return i.Inner.UnmarshalSCALE(reader,UnmarshalT2FromRawBytes[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.IntegerFromRawBytes[uint64],scale_codec.BoolFromRawBytes))
+func (i *Q) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader,UnmarshalNested,scale_codec.IntegerFromRawBytes[uint64],UnmarshalError)
 }
-var JIndex byte = 7
 
-var _ MyScaleEncodedEnum = (*J)(nil)
-
-type J struct {
-	Inner *scale_codec.ResultG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.Bool]
+func (i Q) String() string {
+	return scale_codec.DebugTuple("Q", i.Inner)
 }
 
-func NewJ() *J {
-	return &J{
-		Inner: new(scale_codec.ResultG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.Bool]),
-	}
+func (i Q) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Q", i.Inner)
 }
 
-func (J) IsMyScaleEncodedEnum() {}
-
-func (i J) MarshalSCALE() ([]byte, error) {
-	innerEncode, err := i.Inner.MarshalSCALE()
+func (i *Q) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	idx := JIndex
-	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+	if name != "Q" {
+		return fmt.Errorf("%w: expected Q variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = UnmarshalT3FromJSON[Nested,*scale_codec.Integer[uint64],*Error](UnmarshalNestedJSON,scale_codec.FromJSON[scale_codec.Integer[uint64]],scale_codec.FromJSON[Error])(value)
+	return err
 }
 
-func (i *J) UnmarshalSCALE(reader io.Reader) error {
-	return i.Inner.UnmarshalSCALE(reader, UnmarshalT2FromRawBytes[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.IntegerFromRawBytes[uint64],scale_codec.BoolFromRawBytes), scale_codec.BoolFromRawBytes)
+// NewQFrom builds a Q variant from Go values
+func NewQFrom(a Nested, b uint64, c Error) *Q {
+	return &Q{
+		Inner: &T3[Nested,*scale_codec.Integer[uint64],*Error]{F0: a, F1: &scale_codec.Integer[uint64]{Value: b}, F2: c.Ptr()},
+	}
 }
-var KIndex byte = 8
 
-var _ MyScaleEncodedEnum = (*K)(nil)
+func (variant Q) Get() (Nested, uint64, Error) {
+	return variant.Inner.F0, variant.Inner.F1.Value, *variant.Inner.F2
+}
+var RIndex byte = 15
 
-type K struct {
-	Inner *T2[*scale_codec.OptionG[*scale_codec.Bool],*scale_codec.ResultG[*scale_codec.Bool,*scale_codec.Bool]]
+var _ MyScaleEncodedEnum = (*R)(nil)
+
+type R struct {
+	Inner *T3[*scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.OptionG[*scale_codec.Integer[uint64]],*Error]
 }
 
-func NewK() *K {
-	return &K{
-		Inner: new(T2[*scale_codec.OptionG[*scale_codec.Bool],*scale_codec.ResultG[*scale_codec.Bool,*scale_codec.Bool]]),
+func NewR() *R {
+	return &R{
+		Inner: new(T3[*scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.OptionG[*scale_codec.Integer[uint64]],*Error]),
 	}
 }
 
-func (K) IsMyScaleEncodedEnum() {}
+func (R) IsMyScaleEncodedEnum() {}
 
-func (i K) MarshalSCALE() ([]byte, error) {
+// VariantPosition orders the MyScaleEncodedEnum variants by declaration, as Rust's Ord
+func (R) VariantPosition() int {
+	return 15
+}
+
+func (i R) MarshalSCALE() ([]byte, error) {
 	innerEncode, err := i.Inner.MarshalSCALE()
 	if err != nil {
 		return nil, err
 	}
 
-	idx := KIndex
+	idx := RIndex
 	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
 }
 
-func (i *K) UnmarshalSCALE(reader io.Reader) error {
-	return i.Inner.UnmarshalSCALE(reader,scale_codec.UnmarshalOptionFromRawBytes[*scale_codec.Bool](scale_codec.BoolFromRawBytes),scale_codec.UnmarshalResultFromRawBytes[*scale_codec.Bool,*scale_codec.Bool](scale_codec.BoolFromRawBytes,scale_codec.BoolFromRawBytes))
+func (i *R) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader,scale_codec.UnmarshalResultFromRawBytes[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.IntegerFromRawBytes[uint64],scale_codec.BoolFromRawBytes),scale_codec.UnmarshalOptionFromRawBytes[*scale_codec.Integer[uint64]](scale_codec.IntegerFromRawBytes[uint64]),UnmarshalError)
 }
-var LIndex byte = 9
-
-var _ MyScaleEncodedEnum = (*L)(nil)
 
-type L struct {
-	Inner *scale_codec.ResultG[*scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]],*scale_codec.Integer[uint64]]
+func (i R) String() string {
+	return scale_codec.DebugTuple("R", i.Inner)
 }
 
-func NewL() *L {
-	return &L{
-		Inner: new(scale_codec.ResultG[*scale_codec.OptionG[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]],*scale_codec.Integer[uint64]]),
-	}
+func (i R) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("R", i.Inner)
 }
 
-func (L) IsMyScaleEncodedEnum() {}
-
-func (i L) MarshalSCALE() ([]byte, error) {
-	innerEncode, err := i.Inner.MarshalSCALE()
+func (i *R) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	idx := LIndex
-	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+	if name != "R" {
+		return fmt.Errorf("%w: expected R variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = UnmarshalT3FromJSON[*scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.OptionG[*scale_codec.Integer[uint64]],*Error](scale_codec.UnmarshalResultFromJSON[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Integer[uint64]],scale_codec.FromJSON[scale_codec.Bool]),scale_codec.UnmarshalOptionFromJSON[*scale_codec.Integer[uint64]](scale_codec.FromJSON[scale_codec.Integer[uint64]]),scale_codec.FromJSON[Error])(value)
+	return err
 }
 
-func (i *L) UnmarshalSCALE(reader io.Reader) error {
-	return i.Inner.UnmarshalSCALE(reader, scale_codec.UnmarshalOptionFromRawBytes[*T2[*scale_codec.Integer[uint64],*scale_codec.Bool]](UnmarshalT2FromRawBytes[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.IntegerFromRawBytes[uint64],scale_codec.BoolFromRawBytes)), scale_codec.IntegerFromRawBytes[uint64])
+// NewRFrom builds a R variant from Go values
+func NewRFrom(a *scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Bool], b *scale_codec.OptionG[*scale_codec.Integer[uint64]], c Error) *R {
+	return &R{
+		Inner: &T3[*scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.OptionG[*scale_codec.Integer[uint64]],*Error]{F0: a, F1: b, F2: c.Ptr()},
+	}
 }
-var MIndex byte = 10
 
-var _ MyScaleEncodedEnum = (*M)(nil)
+func (variant R) Get() (*scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Bool], *scale_codec.OptionG[*scale_codec.Integer[uint64]], Error) {
+	return variant.Inner.F0, variant.Inner.F1, *variant.Inner.F2
+}
+var RemarkIndex byte = 0
 
-type M struct {
-	Inner *scale_codec.OptionG[Nested]
+var _ Pallet = (*Remark)(nil)
+
+type Remark struct {
+	Inner *scale_codec.SimpleVariant
 }
 
-func NewM() *M {
-	return &M{
-		Inner: new(scale_codec.OptionG[Nested]),
+func NewRemark() *Remark {
+	return &Remark{
+		Inner: new(scale_codec.SimpleVariant),
 	}
 }
 
-func (M) IsMyScaleEncodedEnum() {}
+func (Remark) IsPallet() {}
 
-func (i M) MarshalSCALE() ([]byte, error) {
+// VariantPosition orders the Pallet variants by declaration, as Rust's Ord
+func (Remark) VariantPosition() int {
+	return 0
+}
+
+func (i Remark) MarshalSCALE() ([]byte, error) {
 	innerEncode, err := i.Inner.MarshalSCALE()
 	if err != nil {
 		return nil, err
 	}
 
-	idx := MIndex
+	idx := RemarkIndex
 	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
 }
 
-func (i *M) UnmarshalSCALE(reader io.Reader) error {
-	return i.Inner.UnmarshalSCALE(reader, UnmarshalNested)
+func (i *Remark) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
 }
-var NIndex byte = 11
-
-var _ MyScaleEncodedEnum = (*N)(nil)
 
-type N struct {
-	Inner *scale_codec.ResultG[Nested,*scale_codec.Bool]
+func (i Remark) String() string {
+	return "Remark"
 }
 
-func NewN() *N {
-	return &N{
-		Inner: new(scale_codec.ResultG[Nested,*scale_codec.Bool]),
-	}
+func (i Remark) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Remark", nil)
 }
 
-func (N) IsMyScaleEncodedEnum() {}
-
-func (i N) MarshalSCALE() ([]byte, error) {
-	innerEncode, err := i.Inner.MarshalSCALE()
+func (i *Remark) UnmarshalJSON(data []byte) error {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	idx := NIndex
-	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
-}
-
-func (i *N) UnmarshalSCALE(reader io.Reader) error {
-	return i.Inner.UnmarshalSCALE(reader, UnmarshalNested, scale_codec.BoolFromRawBytes)
+	if name != "Remark" {
+		return fmt.Errorf("%w: expected Remark variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+	return nil
 }
-var OIndex byte = 12
+var TransferIndex byte = 5
 
-var _ MyScaleEncodedEnum = (*O)(nil)
+var _ Pallet = (*Transfer)(nil)
 
-type O struct {
-	Inner *scale_codec.ResultG[*scale_codec.Bool,Nested]
+type Transfer struct {
+	Inner *scale_codec.Integer[uint64]
 }
 
-func NewO() *O {
-	return &O{
-		Inner: new(scale_codec.ResultG[*scale_codec.Bool,Nested]),
+func NewTransfer() *Transfer {
+	return &Transfer{
+		Inner: new(scale_codec.Integer[uint64]),
 	}
 }
 
-func (O) IsMyScaleEncodedEnum() {}
+func (Transfer) IsPallet() {}
 
-func (i O) MarshalSCALE() ([]byte, error) {
+// VariantPosition orders the Pallet variants by declaration, as Rust's Ord
+func (Transfer) VariantPosition() int {
+	return 1
+}
+
+func (i Transfer) MarshalSCALE() ([]byte, error) {
 	innerEncode, err := i.Inner.MarshalSCALE()
 	if err != nil {
 		return nil, err
 	}
 
-	idx := OIndex
+	idx := TransferIndex
 	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
 }
 
-func (i *O) UnmarshalSCALE(reader io.Reader) error {
-	return i.Inner.UnmarshalSCALE(reader, scale_codec.BoolFromRawBytes, UnmarshalNested)
+func (i *Transfer) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
 }
-var PIndex byte = 13
-
-var _ MyScaleEncodedEnum = (*P)(nil)
 
-type P struct {
-	Inner *scale_codec.ResultG[Nested,Error]
+func (i Transfer) String() string {
+	return scale_codec.DebugTuple("Transfer", i.Inner)
 }
 
-func NewP() *P {
-	return &P{
-		Inner: new(scale_codec.ResultG[Nested,Error]),
-	}
+func (i Transfer) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Transfer", i.Inner)
 }
 
-func (P) IsMyScaleEncodedEnum() {}
-
-func (i P) MarshalSCALE() ([]byte, error) {
-	innerEncode, err := i.Inner.MarshalSCALE()
+func (i *Transfer) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	idx := PIndex
-	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+	if name != "Transfer" {
+		return fmt.Errorf("%w: expected Transfer variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.FromJSON[scale_codec.Integer[uint64]](value)
+	return err
 }
 
-func (i *P) UnmarshalSCALE(reader io.Reader) error {
-	return i.Inner.UnmarshalSCALE(reader, UnmarshalNested, UnmarshalError)
+// NewTransferFrom builds a Transfer variant from Go values
+func NewTransferFrom(v uint64) *Transfer {
+	return &Transfer{
+		Inner: &scale_codec.Integer[uint64]{Value: v},
+	}
 }
-var QIndex byte = 14
 
-var _ MyScaleEncodedEnum = (*Q)(nil)
+func (variant Transfer) Get() uint64 {
+	return variant.Inner.Value
+}
+var BurnIndex byte = 2
 
-type Q struct {
-	Inner *T3[Nested,*scale_codec.Integer[uint64],Error]
+var _ Pallet = (*Burn)(nil)
+
+type Burn struct {
+	Inner *scale_codec.SimpleVariant
 }
 
-func NewQ() *Q {
-	return &Q{
-		Inner: new(T3[Nested,*scale_codec.Integer[uint64],Error]),
+func NewBurn() *Burn {
+	return &Burn{
+		Inner: new(scale_codec.SimpleVariant),
 	}
 }
 
-func (Q) IsMyScaleEncodedEnum() {}
+func (Burn) IsPallet() {}
 
-func (i Q) MarshalSCALE() ([]byte, error) {
+// VariantPosition orders the Pallet variants by declaration, as Rust's Ord
+func (Burn) VariantPosition() int {
+	return 2
+}
+
+func (i Burn) MarshalSCALE() ([]byte, error) {
 	innerEncode, err := i.Inner.MarshalSCALE()
 	if err != nil {
 		return nil, err
 	}
 
-	idx := QIndex
+	idx := BurnIndex
 	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
 }
 
-func (i *Q) UnmarshalSCALE(reader io.Reader) error {
-	return i.Inner.UnmarshalSCALE(reader,UnmarshalNested,scale_codec.IntegerFromRawBytes[uint64],UnmarshalError)
+func (i *Burn) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
 }
-var RIndex byte = 15
 
-var _ MyScaleEncodedEnum = (*R)(nil)
-
-type R struct {
-	Inner *T3[*scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.OptionG[*scale_codec.Integer[uint64]],Error]
+func (i Burn) String() string {
+	return "Burn"
 }
 
-func NewR() *R {
-	return &R{
-		Inner: new(T3[*scale_codec.ResultG[*scale_codec.Integer[uint64],*scale_codec.Bool],*scale_codec.OptionG[*scale_codec.Integer[uint64]],Error]),
-	}
+func (i Burn) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Burn", nil)
 }
 
-func (R) IsMyScaleEncodedEnum() {}
-
-func (i R) MarshalSCALE() ([]byte, error) {
-	innerEncode, err := i.Inner.MarshalSCALE()
+func (i *Burn) UnmarshalJSON(data []byte) error {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	idx := RIndex
-	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+	if name != "Burn" {
+		return fmt.Errorf("%w: expected Burn variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+	return nil
 }
 
-func (i *R) UnmarshalSCALE(reader io.Reader) error {
-	return i.Inner.UnmarshalSCALE(reader,scale_codec.UnmarshalResultFromRawBytes[*scale_codec.Integer[uint64],*scale_codec.Bool](scale_codec.IntegerFromRawBytes[uint64],scale_codec.BoolFromRawBytes),scale_codec.UnmarshalOptionFromRawBytes[*scale_codec.Integer[uint64]](scale_codec.IntegerFromRawBytes[uint64]),UnmarshalError)
-}
+
@@ -0,0 +1,248 @@
+// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package main
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+// assertRoundTrip checks that unmarshal decodes the encoding of value
+// back to a value deeply equal to it, without leaving bytes behind
+func assertRoundTrip[T scale_codec.Marshaler](t *testing.T, value T, unmarshal func(io.Reader) (T, error)) {
+	t.Helper()
+
+	encoded, err := value.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("encoding %v: %v", value, err)
+	}
+
+	reader := bytes.NewReader(encoded)
+	decoded, err := unmarshal(reader)
+	if err != nil {
+		t.Fatalf("decoding %v: %v", encoded, err)
+	}
+
+	if reader.Len() != 0 {
+		t.Fatalf("%d bytes left after decoding %v", reader.Len(), encoded)
+	}
+
+	if !reflect.DeepEqual(value, decoded) {
+		t.Fatalf("\nexpected: %v\ngot: %v", value, decoded)
+	}
+}
+
+func randomT2[A scale_codec.Marshaler, B scale_codec.Marshaler](randomA scale_codec.RandomFunc[A], randomB scale_codec.RandomFunc[B]) scale_codec.RandomFunc[*T2[A, B]] {
+	return func(r *scale_codec.Random, depth int) *T2[A, B] {
+		return &T2[A, B]{F0: randomA(r, depth+1), F1: randomB(r, depth+1)}
+	}
+}
+
+func randomT3[A scale_codec.Marshaler, B scale_codec.Marshaler, C scale_codec.Marshaler](randomA scale_codec.RandomFunc[A], randomB scale_codec.RandomFunc[B], randomC scale_codec.RandomFunc[C]) scale_codec.RandomFunc[*T3[A, B, C]] {
+	return func(r *scale_codec.Random, depth int) *T3[A, B, C] {
+		return &T3[A, B, C]{F0: randomA(r, depth+1), F1: randomB(r, depth+1), F2: randomC(r, depth+1)}
+	}
+}
+
+func randomNested(r *scale_codec.Random, depth int) Nested {
+	return &Number{Inner: scale_codec.RandomInteger[uint32](r, depth+1)}
+}
+
+func randomError(r *scale_codec.Random, depth int) *Error {
+	return []Error{FailureX}[r.Intn(1)].Ptr()
+}
+
+func randomMyScaleEncodedEnum(r *scale_codec.Random, depth int) MyScaleEncodedEnum {
+	variants := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	if r.Deep(depth) {
+		variants = []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+	}
+
+	switch variants[r.Intn(len(variants))] {
+	case 0:
+		return NewSingle()
+	case 1:
+		return &Int{Inner: scale_codec.RandomInteger[uint64](r, depth+1)}
+	case 2:
+		return &Bool{Inner: scale_codec.RandomBool(r, depth+1)}
+	case 3:
+		return &A{Inner: scale_codec.RandomOption(scale_codec.RandomBool)(r, depth+1)}
+	case 4:
+		return &B{Inner: scale_codec.RandomResult(scale_codec.RandomInteger[uint64], scale_codec.RandomInteger[uint64])(r, depth+1)}
+	case 5:
+		return &G{Inner: randomT2(scale_codec.RandomInteger[uint64], scale_codec.RandomBool)(r, depth+1)}
+	case 6:
+		return &H{Inner: scale_codec.RandomOption(randomT2(scale_codec.RandomInteger[uint64], scale_codec.RandomBool))(r, depth+1)}
+	case 7:
+		return &J{Inner: scale_codec.RandomResult(randomT2(scale_codec.RandomInteger[uint64], scale_codec.RandomBool), scale_codec.RandomBool)(r, depth+1)}
+	case 8:
+		return &K{Inner: randomT2(scale_codec.RandomOption(scale_codec.RandomBool), scale_codec.RandomResult(scale_codec.RandomBool, scale_codec.RandomBool))(r, depth+1)}
+	case 9:
+		return &L{Inner: scale_codec.RandomResult(scale_codec.RandomOption(randomT2(scale_codec.RandomInteger[uint64], scale_codec.RandomBool)), scale_codec.RandomInteger[uint64])(r, depth+1)}
+	case 10:
+		return &M{Inner: scale_codec.RandomOption(randomNested)(r, depth+1)}
+	case 11:
+		return &N{Inner: scale_codec.RandomResult(randomNested, scale_codec.RandomBool)(r, depth+1)}
+	case 12:
+		return &O{Inner: scale_codec.RandomResult(scale_codec.RandomBool, randomNested)(r, depth+1)}
+	case 13:
+		return &P{Inner: scale_codec.RandomResult(randomNested, randomError)(r, depth+1)}
+	case 14:
+		return &Q{Inner: randomT3(randomNested, scale_codec.RandomInteger[uint64], randomError)(r, depth+1)}
+	default:
+		return &R{Inner: randomT3(scale_codec.RandomResult(scale_codec.RandomInteger[uint64], scale_codec.RandomBool), scale_codec.RandomOption(scale_codec.RandomInteger[uint64]), randomError)(r, depth+1)}
+	}
+}
+
+func randomPallet(r *scale_codec.Random, depth int) Pallet {
+	switch r.Intn(3) {
+	case 0:
+		return NewRemark()
+	case 1:
+		return &Transfer{Inner: scale_codec.RandomInteger[uint64](r, depth+1)}
+	default:
+		return NewBurn()
+	}
+}
+
+func randomStatus(r *scale_codec.Random, depth int) *Status {
+	return []Status{Active, Frozen, Closed}[r.Intn(3)].Ptr()
+}
+
+func TestRoundTripNested(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomNested(r, 0), UnmarshalNested)
+	}
+}
+
+func FuzzUnmarshalNested(f *testing.F) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 16; idx++ {
+		encoded, err := randomNested(r, 0).MarshalSCALE()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(encoded)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value, err := UnmarshalNested(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		assertRoundTrip(t, value, UnmarshalNested)
+	})
+}
+
+func TestRoundTripError(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomError(r, 0), UnmarshalError)
+	}
+}
+
+func FuzzUnmarshalError(f *testing.F) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 16; idx++ {
+		encoded, err := randomError(r, 0).MarshalSCALE()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(encoded)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value, err := UnmarshalError(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		assertRoundTrip(t, value, UnmarshalError)
+	})
+}
+
+func TestRoundTripMyScaleEncodedEnum(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomMyScaleEncodedEnum(r, 0), UnmarshalMyScaleEncodedEnum)
+	}
+}
+
+func FuzzUnmarshalMyScaleEncodedEnum(f *testing.F) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 16; idx++ {
+		encoded, err := randomMyScaleEncodedEnum(r, 0).MarshalSCALE()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(encoded)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value, err := UnmarshalMyScaleEncodedEnum(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		assertRoundTrip(t, value, UnmarshalMyScaleEncodedEnum)
+	})
+}
+
+func TestRoundTripPallet(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomPallet(r, 0), UnmarshalPallet)
+	}
+}
+
+func FuzzUnmarshalPallet(f *testing.F) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 16; idx++ {
+		encoded, err := randomPallet(r, 0).MarshalSCALE()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(encoded)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value, err := UnmarshalPallet(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		assertRoundTrip(t, value, UnmarshalPallet)
+	})
+}
+
+func TestRoundTripStatus(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomStatus(r, 0), UnmarshalStatus)
+	}
+}
+
+func FuzzUnmarshalStatus(f *testing.F) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 16; idx++ {
+		encoded, err := randomStatus(r, 0).MarshalSCALE()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(encoded)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value, err := UnmarshalStatus(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		assertRoundTrip(t, value, UnmarshalStatus)
+	})
+}
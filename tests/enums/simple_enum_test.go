@@ -2,6 +2,9 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
 	"reflect"
 	"testing"
@@ -70,8 +73,8 @@ func TestSimpleEnumMarshaler(t *testing.T) {
 			marshaler: &H{
 				Inner: scale_codec.SomeG[*T2[*scale_codec.Integer[uint64], *scale_codec.Bool]](
 					&T2[*scale_codec.Integer[uint64], *scale_codec.Bool]{
-						F0: &scale_codec.Integer[uint64]{60},
-						F1: &scale_codec.Bool{false},
+						F0: &scale_codec.Integer[uint64]{Value: 60},
+						F1: &scale_codec.Bool{Value: false},
 					},
 				),
 			},
@@ -81,8 +84,8 @@ func TestSimpleEnumMarshaler(t *testing.T) {
 			marshaler: &J{
 				Inner: scale_codec.OkG[*T2[*scale_codec.Integer[uint64], *scale_codec.Bool], *scale_codec.Bool](
 					&T2[*scale_codec.Integer[uint64], *scale_codec.Bool]{
-						F0: &scale_codec.Integer[uint64]{60},
-						F1: &scale_codec.Bool{false},
+						F0: &scale_codec.Integer[uint64]{Value: 60},
+						F1: &scale_codec.Bool{Value: false},
 					},
 				),
 			},
@@ -100,7 +103,7 @@ func TestSimpleEnumMarshaler(t *testing.T) {
 			expectedBytes: []byte{10, 1, 0, 10, 0, 0, 0},
 			marshaler: &M{
 				Inner: scale_codec.SomeG[Nested](
-					&Number{Inner: &scale_codec.Integer[uint32]{10}},
+					&Number{Inner: &scale_codec.Integer[uint32]{Value: 10}},
 				),
 			},
 		},
@@ -141,23 +144,23 @@ func TestSimpleEnumMarshaler(t *testing.T) {
 		{
 			expectedBytes: []byte{13, 0, 0, 255, 255, 255, 255},
 			marshaler: &P{
-				Inner: scale_codec.OkG[Nested, Error](
+				Inner: scale_codec.OkG[Nested, *Error](
 					&Number{Inner: &scale_codec.Integer[uint32]{Value: math.MaxUint32}}),
 			},
 		},
 		{
 			expectedBytes: []byte{13, 1, 0},
 			marshaler: &P{
-				Inner: scale_codec.ErrG[Nested, Error](NewFailureX()),
+				Inner: scale_codec.ErrG[Nested, *Error](FailureX.Ptr()),
 			},
 		},
 		{
 			expectedBytes: []byte{14, 0, 77, 0, 0, 0, 89, 0, 0, 0, 0, 0, 0, 0, 0},
 			marshaler: &Q{
-				Inner: &T3[Nested, *scale_codec.Integer[uint64], Error]{
-					F0: &Number{Inner: &scale_codec.Integer[uint32]{77}},
-					F1: &scale_codec.Integer[uint64]{89},
-					F2: NewFailureX(),
+				Inner: &T3[Nested, *scale_codec.Integer[uint64], *Error]{
+					F0: &Number{Inner: &scale_codec.Integer[uint32]{Value: 77}},
+					F1: &scale_codec.Integer[uint64]{Value: 89},
+					F2: FailureX.Ptr(),
 				},
 			},
 		},
@@ -225,8 +228,8 @@ func TestSimpleEnumUnmarshaler(t *testing.T) {
 			inputBytes: []byte{5, 60, 0, 0, 0, 0, 0, 0, 0, 0},
 			expectedVariant: &G{
 				Inner: &T2[*scale_codec.Integer[uint64], *scale_codec.Bool]{
-					F0: &scale_codec.Integer[uint64]{60},
-					F1: &scale_codec.Bool{false},
+					F0: &scale_codec.Integer[uint64]{Value: 60},
+					F1: &scale_codec.Bool{Value: false},
 				},
 			},
 		},
@@ -235,8 +238,8 @@ func TestSimpleEnumUnmarshaler(t *testing.T) {
 			expectedVariant: &H{
 				Inner: scale_codec.SomeG[*T2[*scale_codec.Integer[uint64], *scale_codec.Bool]](
 					&T2[*scale_codec.Integer[uint64], *scale_codec.Bool]{
-						F0: &scale_codec.Integer[uint64]{60},
-						F1: &scale_codec.Bool{false},
+						F0: &scale_codec.Integer[uint64]{Value: 60},
+						F1: &scale_codec.Bool{Value: false},
 					},
 				),
 			},
@@ -246,8 +249,8 @@ func TestSimpleEnumUnmarshaler(t *testing.T) {
 			expectedVariant: &J{
 				Inner: scale_codec.OkG[*T2[*scale_codec.Integer[uint64], *scale_codec.Bool], *scale_codec.Bool](
 					&T2[*scale_codec.Integer[uint64], *scale_codec.Bool]{
-						F0: &scale_codec.Integer[uint64]{60},
-						F1: &scale_codec.Bool{false},
+						F0: &scale_codec.Integer[uint64]{Value: 60},
+						F1: &scale_codec.Bool{Value: false},
 					},
 				),
 			},
@@ -266,7 +269,7 @@ func TestSimpleEnumUnmarshaler(t *testing.T) {
 			expectedVariant: &M{
 				Inner: scale_codec.SomeG[Nested](
 					&Number{
-						Inner: &scale_codec.Integer[uint32]{10},
+						Inner: &scale_codec.Integer[uint32]{Value: 10},
 					},
 				),
 			},
@@ -308,23 +311,23 @@ func TestSimpleEnumUnmarshaler(t *testing.T) {
 		{
 			inputBytes: []byte{13, 0, 0, 255, 255, 255, 255},
 			expectedVariant: &P{
-				Inner: scale_codec.OkG[Nested, Error](
+				Inner: scale_codec.OkG[Nested, *Error](
 					&Number{Inner: &scale_codec.Integer[uint32]{Value: math.MaxUint32}}),
 			},
 		},
 		{
 			inputBytes: []byte{13, 1, 0},
 			expectedVariant: &P{
-				Inner: scale_codec.ErrG[Nested, Error](NewFailureX()),
+				Inner: scale_codec.ErrG[Nested, *Error](FailureX.Ptr()),
 			},
 		},
 		{
 			inputBytes: []byte{14, 0, 77, 0, 0, 0, 89, 0, 0, 0, 0, 0, 0, 0, 0},
 			expectedVariant: &Q{
-				Inner: &T3[Nested, *scale_codec.Integer[uint64], Error]{
-					F0: &Number{Inner: &scale_codec.Integer[uint32]{77}},
-					F1: &scale_codec.Integer[uint64]{89},
-					F2: NewFailureX(),
+				Inner: &T3[Nested, *scale_codec.Integer[uint64], *Error]{
+					F0: &Number{Inner: &scale_codec.Integer[uint32]{Value: 77}},
+					F1: &scale_codec.Integer[uint64]{Value: 89},
+					F2: FailureX.Ptr(),
 				},
 			},
 		},
@@ -342,3 +345,261 @@ func TestSimpleEnumUnmarshaler(t *testing.T) {
 		}
 	}
 }
+
+func TestExplicitVariantIndex(t *testing.T) {
+	cases := []struct {
+		variant       Pallet
+		expectedBytes []byte
+	}{
+		{
+			variant:       NewRemark(),
+			expectedBytes: []byte{0},
+		},
+		{
+			variant: &Transfer{
+				Inner: &scale_codec.Integer[uint64]{Value: 10},
+			},
+			expectedBytes: []byte{5, 10, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			variant:       NewBurn(),
+			expectedBytes: []byte{2},
+		},
+	}
+
+	for _, tt := range cases {
+		output, err := tt.variant.MarshalSCALE()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !bytes.Equal(tt.expectedBytes, output) {
+			t.Fatalf("\nexpected: %v\nactual: %v", tt.expectedBytes, output)
+		}
+
+		variant, err := UnmarshalPallet(bytes.NewReader(tt.expectedBytes))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(tt.variant, variant) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.variant, variant)
+		}
+	}
+
+	_, err := UnmarshalPallet(bytes.NewReader([]byte{1}))
+	if err == nil {
+		t.Fatalf("expected error for unknown enum tag")
+	}
+}
+
+func TestUnitOnlyEnum(t *testing.T) {
+	cases := []struct {
+		value         Status
+		expectedBytes []byte
+		expectedName  string
+	}{
+		{value: Active, expectedBytes: []byte{0}, expectedName: "Active"},
+		{value: Frozen, expectedBytes: []byte{3}, expectedName: "Frozen"},
+		{value: Closed, expectedBytes: []byte{2}, expectedName: "Closed"},
+	}
+
+	for _, tt := range cases {
+		output, err := tt.value.MarshalSCALE()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !bytes.Equal(tt.expectedBytes, output) {
+			t.Fatalf("\nexpected: %v\nactual: %v", tt.expectedBytes, output)
+		}
+
+		decoded, err := UnmarshalStatus(bytes.NewReader(tt.expectedBytes))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if *decoded != tt.value {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.value, *decoded)
+		}
+
+		if tt.value.String() != tt.expectedName {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedName, tt.value.String())
+		}
+	}
+
+	invalid := Status(1)
+	if invalid.IsValid() {
+		t.Fatalf("expected %v to be invalid", invalid)
+	}
+
+	if invalid.String() != "Status(1)" {
+		t.Fatalf("\nexpected: %v\ngot: %v", "Status(1)", invalid.String())
+	}
+
+	if _, err := invalid.MarshalSCALE(); err == nil {
+		t.Fatalf("expected error to encode an unknown value")
+	}
+
+	if _, err := UnmarshalStatus(bytes.NewReader([]byte{1})); err == nil {
+		t.Fatalf("expected error for unknown enum tag")
+	}
+}
+
+func TestStringAndJSON(t *testing.T) {
+	type u64 = scale_codec.Integer[uint64]
+	type pair = T2[*u64, *scale_codec.Bool]
+
+	cases := []struct {
+		value          MyScaleEncodedEnum
+		expectedString string
+		expectedJSON   string
+	}{
+		{
+			value:          NewSingle(),
+			expectedString: "Single",
+			expectedJSON:   `{"Single":null}`,
+		},
+		{
+			value:          NewJOk(5, true),
+			expectedString: "J(Ok((5, true)))",
+			expectedJSON:   `{"J":{"ok":[5,true]}}`,
+		},
+		{
+			value:          &H{Inner: scale_codec.NoneG[*pair]()},
+			expectedString: "H(None)",
+			expectedJSON:   `{"H":null}`,
+		},
+		{
+			value:          &P{Inner: scale_codec.ErrG[Nested, *Error](FailureX.Ptr())},
+			expectedString: "P(Err(FailureX))",
+			expectedJSON:   `{"P":{"err":"FailureX"}}`,
+		},
+		{
+			value: &Q{
+				Inner: &T3[Nested, *u64, *Error]{
+					F0: &Number{Inner: &scale_codec.Integer[uint32]{Value: 1}},
+					F1: &u64{Value: 1 << 60},
+					F2: FailureX.Ptr(),
+				},
+			},
+			expectedString: "Q((Number(1), 1152921504606846976, FailureX))",
+			expectedJSON:   `{"Q":[{"Number":1},"0x1000000000000000","FailureX"]}`,
+		},
+	}
+
+	for _, tt := range cases {
+		if str := fmt.Sprint(tt.value); str != tt.expectedString {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedString, str)
+		}
+
+		output, err := json.Marshal(tt.value)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if string(output) != tt.expectedJSON {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedJSON, string(output))
+		}
+
+		decoded, err := UnmarshalMyScaleEncodedEnumJSON(output)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(tt.value, decoded) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.value, decoded)
+		}
+	}
+
+	single, err := UnmarshalMyScaleEncodedEnumJSON([]byte(`"Single"`))
+	if err != nil || !reflect.DeepEqual(NewSingle(), single) {
+		t.Fatalf("expected Single from its name, got %v (%v)", single, err)
+	}
+
+	if _, err := UnmarshalMyScaleEncodedEnumJSON([]byte(`{"Unknown":null}`)); !errors.Is(err, scale_codec.ErrInvalidJSON) {
+		t.Fatalf("\nexpected: %v\ngot: %v", scale_codec.ErrInvalidJSON, err)
+	}
+
+	status := new(Status)
+	if err := json.Unmarshal([]byte(`"Frozen"`), status); err != nil || *status != Frozen {
+		t.Fatalf("expected Frozen, got %v (%v)", status, err)
+	}
+
+	output, err := json.Marshal(Closed)
+	if err != nil || string(output) != `"Closed"` {
+		t.Fatalf("expected \"Closed\", got %s (%v)", output, err)
+	}
+}
+
+func TestTypedConstructors(t *testing.T) {
+	type u64 = scale_codec.Integer[uint64]
+	type pair = T2[*u64, *scale_codec.Bool]
+
+	cases := []struct {
+		value    MyScaleEncodedEnum
+		expected MyScaleEncodedEnum
+	}{
+		{
+			value:    NewIntFrom(32),
+			expected: &Int{Inner: &u64{Value: 32}},
+		},
+		{
+			value:    NewANone(),
+			expected: &A{Inner: scale_codec.NoneG[*scale_codec.Bool]()},
+		},
+		{
+			value:    NewASome(true),
+			expected: &A{Inner: scale_codec.SomeG(&scale_codec.Bool{Value: true})},
+		},
+		{
+			value: NewJOk(5, true),
+			expected: &J{
+				Inner: scale_codec.OkG[*pair, *scale_codec.Bool](&pair{
+					F0: &u64{Value: 5},
+					F1: &scale_codec.Bool{Value: true},
+				}),
+			},
+		},
+		{
+			value:    NewJErr(false),
+			expected: &J{Inner: scale_codec.ErrG[*pair](&scale_codec.Bool{Value: false})},
+		},
+		{
+			value: NewQFrom(NewNumberFrom(7), 1, FailureX),
+			expected: &Q{
+				Inner: &T3[Nested, *u64, *Error]{
+					F0: &Number{Inner: &scale_codec.Integer[uint32]{Value: 7}},
+					F1: &u64{Value: 1},
+					F2: FailureX.Ptr(),
+				},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		if !reflect.DeepEqual(tt.expected, tt.value) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expected, tt.value)
+		}
+	}
+
+	if value := NewIntFrom(32).Get(); value != 32 {
+		t.Fatalf("\nexpected: %v\ngot: %v", 32, value)
+	}
+
+	if a, b, ok := NewJOk(5, true).Ok(); !ok || a != 5 || !b {
+		t.Fatalf("expected Ok(5, true), got %v, %v, %v", a, b, ok)
+	}
+
+	if _, _, ok := NewJErr(true).Ok(); ok {
+		t.Fatalf("expected Err(true) not to be ok")
+	}
+
+	if _, ok := NewANone().Some(); ok {
+		t.Fatalf("expected None not to be some")
+	}
+
+	if err, ok := NewPErr(FailureX).Err(); !ok || err != FailureX {
+		t.Fatalf("expected Err(FailureX), got %v, %v", err, ok)
+	}
+}
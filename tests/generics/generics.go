@@ -0,0 +1,950 @@
+// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+
+
+type MaybeRef[T scale_codec.Marshaler] interface {
+	scale_codec.Marshaler
+	IsMaybeRef()
+}
+
+func UnmarshalMaybeRefFromRawBytes[T scale_codec.Marshaler](
+	funcT func(io.Reader) (T, error)) func(io.Reader) (MaybeRef[T], error) {
+	return func(reader io.Reader) (MaybeRef[T], error) {
+		return unmarshalMaybeRef(reader, funcT)
+	}
+}
+
+func unmarshalMaybeRef[T scale_codec.Marshaler](reader io.Reader, funcT func(io.Reader) (T, error)) (MaybeRef[T], error) {
+	enumTag := make([]byte, 1)
+	n, err := reader.Read(enumTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("%w: got %v", scale_codec.ErrExpectedOneByteRead, n)
+	}
+
+	switch enumTag[0] {
+	
+	case InlineIndex:
+		unmarshaler := NewInline[T]()
+		err := unmarshaler.UnmarshalSCALE(reader, funcT)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case HashIndex:
+		unmarshaler := NewHash[T]()
+		err := unmarshaler.UnmarshalSCALE(reader, funcT)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	default:
+		return nil, fmt.Errorf("unexpected enum tag: %v", enumTag[0])
+	}
+}
+
+func UnmarshalMaybeRefFromJSON[T scale_codec.Marshaler](
+	jsonT func([]byte) (T, error)) func([]byte) (MaybeRef[T], error) {
+	return func(data []byte) (MaybeRef[T], error) {
+		return unmarshalMaybeRefJSON(data, jsonT)
+	}
+}
+
+func unmarshalMaybeRefJSON[T scale_codec.Marshaler](data []byte, jsonT func([]byte) (T, error)) (MaybeRef[T], error) {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	
+	case "Inline":
+		unmarshaler := NewInline[T]()
+		err := unmarshaler.UnmarshalJSONWith(data, jsonT)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Hash":
+		unmarshaler := NewHash[T]()
+		err := unmarshaler.UnmarshalJSONWith(data, jsonT)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	default:
+		return nil, fmt.Errorf("%w: unexpected MaybeRef variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+}
+
+// MaybeRefVisitor has a method per MaybeRef variant, a visitor given
+// to VisitMaybeRef stops compiling when a variant is added
+type MaybeRefVisitor[T scale_codec.Marshaler] interface {
+	VisitInline(*Inline[T]) error
+	VisitHash(*Hash[T]) error
+}
+
+// VisitMaybeRef calls the visitor method of the variant of value
+func VisitMaybeRef[T scale_codec.Marshaler](value MaybeRef[T], visitor MaybeRefVisitor[T]) error {
+	switch variant := value.(type) {
+	case *Inline[T]:
+		return visitor.VisitInline(variant)
+	case *Hash[T]:
+		return visitor.VisitHash(variant)
+	default:
+		return fmt.Errorf("unexpected MaybeRef variant: %T", value)
+	}
+}
+
+// MaybeRefCases holds a func per MaybeRef variant for MatchMaybeRef,
+// it is built by NewMaybeRefCases so a variant added breaks the build
+type MaybeRefCases[T scale_codec.Marshaler, R any] struct {
+	onInline func(*Inline[T]) R
+	onHash func(*Hash[T]) R
+}
+
+// NewMaybeRefCases returns the cases of MatchMaybeRef, taking
+// a func per MaybeRef variant in declaration order
+func NewMaybeRefCases[T scale_codec.Marshaler, R any](onInline func(*Inline[T]) R, onHash func(*Hash[T]) R) MaybeRefCases[T, R] {
+	return MaybeRefCases[T, R]{
+		onInline: onInline,
+		onHash: onHash,
+	}
+}
+
+// MatchMaybeRef returns the result of the case of the variant of value,
+// it panics if that case is nil
+func MatchMaybeRef[T scale_codec.Marshaler, R any](value MaybeRef[T], cases MaybeRefCases[T, R]) R {
+	switch variant := value.(type) {
+	case *Inline[T]:
+		if cases.onInline == nil {
+			panic("MatchMaybeRef: missing case for Inline")
+		}
+		return cases.onInline(variant)
+	case *Hash[T]:
+		if cases.onHash == nil {
+			panic("MatchMaybeRef: missing case for Hash")
+		}
+		return cases.onHash(variant)
+	default:
+		panic(fmt.Sprintf("MatchMaybeRef: unexpected variant %T", value))
+	}
+}
+type Call interface {
+	scale_codec.Encodable
+	IsCall()
+}
+
+func UnmarshalCall(reader io.Reader) (Call, error) {
+	enumTag := make([]byte, 1)
+	n, err := reader.Read(enumTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("%w: got %v", scale_codec.ErrExpectedOneByteRead, n)
+	}
+
+	switch enumTag[0] {
+	
+	case RemarkIndex:
+		unmarshaler := NewRemark()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case StoreIndex:
+		unmarshaler := NewStore()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case NestedIndex:
+		unmarshaler := NewNested()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case BothIndex:
+		unmarshaler := NewBoth()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case WrappedIndex:
+		unmarshaler := NewWrapped()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	default:
+		return nil, fmt.Errorf("unexpected enum tag: %v", enumTag[0])
+	}
+}
+
+func UnmarshalCallJSON(data []byte) (Call, error) {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	
+	case "Remark":
+		unmarshaler := NewRemark()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Store":
+		unmarshaler := NewStore()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Nested":
+		unmarshaler := NewNested()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Both":
+		unmarshaler := NewBoth()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Wrapped":
+		unmarshaler := NewWrapped()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	default:
+		return nil, fmt.Errorf("%w: unexpected Call variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+}
+
+// CallVisitor has a method per Call variant, a visitor given
+// to VisitCall stops compiling when a variant is added
+type CallVisitor interface {
+	VisitRemark(*Remark) error
+	VisitStore(*Store) error
+	VisitNested(*Nested) error
+	VisitBoth(*Both) error
+	VisitWrapped(*Wrapped) error
+}
+
+// VisitCall calls the visitor method of the variant of value
+func VisitCall(value Call, visitor CallVisitor) error {
+	switch variant := value.(type) {
+	case *Remark:
+		return visitor.VisitRemark(variant)
+	case *Store:
+		return visitor.VisitStore(variant)
+	case *Nested:
+		return visitor.VisitNested(variant)
+	case *Both:
+		return visitor.VisitBoth(variant)
+	case *Wrapped:
+		return visitor.VisitWrapped(variant)
+	default:
+		return fmt.Errorf("unexpected Call variant: %T", value)
+	}
+}
+
+// CallCases holds a func per Call variant for MatchCall,
+// it is built by NewCallCases so a variant added breaks the build
+type CallCases[R any] struct {
+	onRemark func(*Remark) R
+	onStore func(*Store) R
+	onNested func(*Nested) R
+	onBoth func(*Both) R
+	onWrapped func(*Wrapped) R
+}
+
+// NewCallCases returns the cases of MatchCall, taking
+// a func per Call variant in declaration order
+func NewCallCases[R any](onRemark func(*Remark) R, onStore func(*Store) R, onNested func(*Nested) R, onBoth func(*Both) R, onWrapped func(*Wrapped) R) CallCases[R] {
+	return CallCases[R]{
+		onRemark: onRemark,
+		onStore: onStore,
+		onNested: onNested,
+		onBoth: onBoth,
+		onWrapped: onWrapped,
+	}
+}
+
+// MatchCall returns the result of the case of the variant of value,
+// it panics if that case is nil
+func MatchCall[R any](value Call, cases CallCases[R]) R {
+	switch variant := value.(type) {
+	case *Remark:
+		if cases.onRemark == nil {
+			panic("MatchCall: missing case for Remark")
+		}
+		return cases.onRemark(variant)
+	case *Store:
+		if cases.onStore == nil {
+			panic("MatchCall: missing case for Store")
+		}
+		return cases.onStore(variant)
+	case *Nested:
+		if cases.onNested == nil {
+			panic("MatchCall: missing case for Nested")
+		}
+		return cases.onNested(variant)
+	case *Both:
+		if cases.onBoth == nil {
+			panic("MatchCall: missing case for Both")
+		}
+		return cases.onBoth(variant)
+	case *Wrapped:
+		if cases.onWrapped == nil {
+			panic("MatchCall: missing case for Wrapped")
+		}
+		return cases.onWrapped(variant)
+	default:
+		panic(fmt.Sprintf("MatchCall: unexpected variant %T", value))
+	}
+}
+
+
+var InlineIndex byte = 0
+
+var _ MaybeRef[scale_codec.Marshaler] = (*Inline[scale_codec.Marshaler])(nil)
+
+type Inline[T scale_codec.Marshaler] struct {
+	Inner T
+}
+
+func NewInline[T scale_codec.Marshaler]() *Inline[T] {
+	return &Inline[T]{
+		Inner: *new(T),
+	}
+}
+
+func (Inline[T]) IsMaybeRef() {}
+
+// VariantPosition orders the MaybeRef variants by declaration, as Rust's Ord
+func (Inline[T]) VariantPosition() int {
+	return 0
+}
+
+func (i Inline[T]) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := InlineIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Inline[T]) UnmarshalSCALE(reader io.Reader, funcT func(io.Reader) (T, error)) error {
+	var err error
+	i.Inner, err = funcT(reader)
+	return err
+}
+
+func (i Inline[T]) String() string {
+	return scale_codec.DebugTuple("Inline", i.Inner)
+}
+
+func (i Inline[T]) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Inline", i.Inner)
+}
+
+func (i *Inline[T]) UnmarshalJSONWith(data []byte, jsonT func([]byte) (T, error)) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Inline" {
+		return fmt.Errorf("%w: expected Inline variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = jsonT(value)
+	return err
+}
+var HashIndex byte = 1
+
+var _ MaybeRef[scale_codec.Marshaler] = (*Hash[scale_codec.Marshaler])(nil)
+
+type Hash[T scale_codec.Marshaler] struct {
+	Inner *scale_codec.ByteArray
+}
+
+func NewHash[T scale_codec.Marshaler]() *Hash[T] {
+	return &Hash[T]{
+		Inner: scale_codec.NewByteArray(32),
+	}
+}
+
+func (Hash[T]) IsMaybeRef() {}
+
+// VariantPosition orders the MaybeRef variants by declaration, as Rust's Ord
+func (Hash[T]) VariantPosition() int {
+	return 1
+}
+
+func (i Hash[T]) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := HashIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Hash[T]) UnmarshalSCALE(reader io.Reader, funcT func(io.Reader) (T, error)) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i Hash[T]) String() string {
+	return scale_codec.DebugTuple("Hash", i.Inner)
+}
+
+func (i Hash[T]) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Hash", i.Inner)
+}
+
+func (i *Hash[T]) UnmarshalJSONWith(data []byte, jsonT func([]byte) (T, error)) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Hash" {
+		return fmt.Errorf("%w: expected Hash variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.UnmarshalByteArrayFromJSON(32)(value)
+	return err
+}
+
+// NewHashFrom builds a Hash variant from Go values
+func NewHashFrom[T scale_codec.Marshaler](v []byte) *Hash[T] {
+	return &Hash[T]{
+		Inner: &scale_codec.ByteArray{Value: v},
+	}
+}
+
+func (variant Hash[T]) Get() []byte {
+	return variant.Inner.Value
+}
+var RemarkIndex byte = 0
+
+var _ Call = (*Remark)(nil)
+
+type Remark struct {
+	Inner *scale_codec.SimpleVariant
+}
+
+func NewRemark() *Remark {
+	return &Remark{
+		Inner: new(scale_codec.SimpleVariant),
+	}
+}
+
+func (Remark) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Remark) VariantPosition() int {
+	return 0
+}
+
+func (i Remark) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := RemarkIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Remark) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i Remark) String() string {
+	return "Remark"
+}
+
+func (i Remark) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Remark", nil)
+}
+
+func (i *Remark) UnmarshalJSON(data []byte) error {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Remark" {
+		return fmt.Errorf("%w: expected Remark variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+	return nil
+}
+var StoreIndex byte = 1
+
+var _ Call = (*Store)(nil)
+
+type Store struct {
+	Inner MaybeRef[*scale_codec.Integer[uint64]]
+}
+
+func NewStore() *Store {
+	return &Store{
+		Inner: nil,
+	}
+}
+
+func (Store) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Store) VariantPosition() int {
+	return 1
+}
+
+func (i Store) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := StoreIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Store) UnmarshalSCALE(reader io.Reader) error {
+	var err error
+	i.Inner, err = UnmarshalMaybeRefFromRawBytes[*scale_codec.Integer[uint64]](scale_codec.IntegerFromRawBytes[uint64])(reader)
+	return err
+}
+
+func (i Store) String() string {
+	return scale_codec.DebugTuple("Store", i.Inner)
+}
+
+func (i Store) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Store", i.Inner)
+}
+
+func (i *Store) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Store" {
+		return fmt.Errorf("%w: expected Store variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = UnmarshalMaybeRefFromJSON[*scale_codec.Integer[uint64]](scale_codec.FromJSON[scale_codec.Integer[uint64]])(value)
+	return err
+}
+var NestedIndex byte = 2
+
+var _ Call = (*Nested)(nil)
+
+type Nested struct {
+	Inner MaybeRef[Call]
+}
+
+func NewNested() *Nested {
+	return &Nested{
+		Inner: nil,
+	}
+}
+
+func (Nested) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Nested) VariantPosition() int {
+	return 2
+}
+
+func (i Nested) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := NestedIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Nested) UnmarshalSCALE(reader io.Reader) error {
+	var err error
+	i.Inner, err = UnmarshalMaybeRefFromRawBytes[Call](UnmarshalCall)(reader)
+	return err
+}
+
+func (i Nested) String() string {
+	return scale_codec.DebugTuple("Nested", i.Inner)
+}
+
+func (i Nested) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Nested", i.Inner)
+}
+
+func (i *Nested) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Nested" {
+		return fmt.Errorf("%w: expected Nested variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = UnmarshalMaybeRefFromJSON[Call](UnmarshalCallJSON)(value)
+	return err
+}
+var BothIndex byte = 3
+
+var _ Call = (*Both)(nil)
+
+type Both struct {
+	Inner *Pair[*scale_codec.Integer[uint8],Call]
+}
+
+func NewBoth() *Both {
+	return &Both{
+		Inner: new(Pair[*scale_codec.Integer[uint8],Call]),
+	}
+}
+
+func (Both) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Both) VariantPosition() int {
+	return 3
+}
+
+func (i Both) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := BothIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Both) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader, scale_codec.IntegerFromRawBytes[uint8], UnmarshalCall)
+}
+
+func (i Both) String() string {
+	return scale_codec.DebugTuple("Both", i.Inner)
+}
+
+func (i Both) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Both", i.Inner)
+}
+
+func (i *Both) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Both" {
+		return fmt.Errorf("%w: expected Both variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = UnmarshalPairFromJSON[*scale_codec.Integer[uint8],Call](scale_codec.FromJSON[scale_codec.Integer[uint8]],UnmarshalCallJSON)(value)
+	return err
+}
+var WrappedIndex byte = 4
+
+var _ Call = (*Wrapped)(nil)
+
+type Wrapped struct {
+	Inner *Wrapper[*scale_codec.Bool]
+}
+
+func NewWrapped() *Wrapped {
+	return &Wrapped{
+		Inner: new(Wrapper[*scale_codec.Bool]),
+	}
+}
+
+func (Wrapped) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Wrapped) VariantPosition() int {
+	return 4
+}
+
+func (i Wrapped) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := WrappedIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Wrapped) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader, scale_codec.BoolFromRawBytes)
+}
+
+func (i Wrapped) String() string {
+	return scale_codec.DebugTuple("Wrapped", i.Inner)
+}
+
+func (i Wrapped) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Wrapped", i.Inner)
+}
+
+func (i *Wrapped) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Wrapped" {
+		return fmt.Errorf("%w: expected Wrapped variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = UnmarshalWrapperFromJSON[*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Bool])(value)
+	return err
+}
+
+
+var _ scale_codec.Marshaler = (*Pair[scale_codec.Marshaler, scale_codec.Marshaler])(nil)
+
+type Pair[A scale_codec.Marshaler, B scale_codec.Marshaler] struct {
+	First A
+	Second *scale_codec.VecG[B]
+}
+
+func NewPair[A scale_codec.Marshaler, B scale_codec.Marshaler]() *Pair[A, B] {
+	return &Pair[A, B]{
+		First: *new(A),
+		Second: new(scale_codec.VecG[B]),
+	}
+}
+
+func (s Pair[A, B]) MarshalSCALE() (output []byte, err error) {
+	output = make([]byte, 0)
+	var enc []byte
+	
+	enc, err = s.First.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.Second.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (s *Pair[A, B]) UnmarshalSCALE(reader io.Reader, funcA func(io.Reader) (A, error), funcB func(io.Reader) (B, error)) (err error) {
+	
+	s.First, err = funcA(reader)
+	if err != nil {
+		return err
+	}
+	
+	s.Second, err = scale_codec.UnmarshalVecFromRawBytes[B](funcB)(reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (s Pair[A, B]) String() string {
+	return scale_codec.DebugStruct("Pair", []string{"first", "second"}, s.First, s.Second)
+}
+
+func (s Pair[A, B]) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONObject([]string{"first", "second"}, s.First, s.Second)
+}
+
+func (s *Pair[A, B]) UnmarshalJSONWith(data []byte, jsonA func([]byte) (A, error), jsonB func([]byte) (B, error)) error {
+	items, err := scale_codec.UnmarshalJSONObject(data, []string{"first", "second"})
+	if err != nil {
+		return err
+	}
+	
+	s.First, err = jsonA(items[0])
+	if err != nil {
+		return err
+	}
+	
+	s.Second, err = scale_codec.UnmarshalVecFromJSON[B](jsonB)(items[1])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalPairFromJSON[A scale_codec.Marshaler, B scale_codec.Marshaler](
+	jsonA func([]byte) (A, error), jsonB func([]byte) (B, error)) func([]byte) (*Pair[A, B], error) {
+	return func(data []byte) (*Pair[A, B], error) {
+		s := new(Pair[A, B])
+		if err := s.UnmarshalJSONWith(data, jsonA, jsonB); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+}
+
+func UnmarshalPairFromRawBytes[A scale_codec.Marshaler, B scale_codec.Marshaler](
+	funcA func(io.Reader) (A, error), funcB func(io.Reader) (B, error)) func(io.Reader) (*Pair[A, B], error) {
+	return func(reader io.Reader) (*Pair[A, B], error) {
+		s := new(Pair[A, B])
+		if err := s.UnmarshalSCALE(reader, funcA, funcB); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+}
+var _ scale_codec.Marshaler = (*Wrapper[scale_codec.Marshaler])(nil)
+
+type Wrapper[T scale_codec.Marshaler] struct {
+	F0 *scale_codec.OptionG[T]
+	F1 MaybeRef[T]
+}
+
+func NewWrapper[T scale_codec.Marshaler]() *Wrapper[T] {
+	return &Wrapper[T]{
+		F0: new(scale_codec.OptionG[T]),
+		F1: nil,
+	}
+}
+
+func (s Wrapper[T]) MarshalSCALE() (output []byte, err error) {
+	output = make([]byte, 0)
+	var enc []byte
+	
+	enc, err = s.F0.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.F1.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (s *Wrapper[T]) UnmarshalSCALE(reader io.Reader, funcT func(io.Reader) (T, error)) (err error) {
+	
+	s.F0, err = scale_codec.UnmarshalOptionFromRawBytes[T](funcT)(reader)
+	if err != nil {
+		return err
+	}
+	
+	s.F1, err = UnmarshalMaybeRefFromRawBytes[T](funcT)(reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (s Wrapper[T]) String() string {
+	return scale_codec.DebugTuple("Wrapper", s.F0, s.F1)
+}
+
+func (s Wrapper[T]) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONTuple(s.F0, s.F1)
+}
+
+func (s *Wrapper[T]) UnmarshalJSONWith(data []byte, jsonT func([]byte) (T, error)) error {
+	items, err := scale_codec.UnmarshalJSONTuple(data, 2)
+	if err != nil {
+		return err
+	}
+	
+	s.F0, err = scale_codec.UnmarshalOptionFromJSON[T](jsonT)(items[0])
+	if err != nil {
+		return err
+	}
+	
+	s.F1, err = UnmarshalMaybeRefFromJSON[T](jsonT)(items[1])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalWrapperFromJSON[T scale_codec.Marshaler](
+	jsonT func([]byte) (T, error)) func([]byte) (*Wrapper[T], error) {
+	return func(data []byte) (*Wrapper[T], error) {
+		s := new(Wrapper[T])
+		if err := s.UnmarshalJSONWith(data, jsonT); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+}
+
+func UnmarshalWrapperFromRawBytes[T scale_codec.Marshaler](
+	funcT func(io.Reader) (T, error)) func(io.Reader) (*Wrapper[T], error) {
+	return func(reader io.Reader) (*Wrapper[T], error) {
+		s := new(Wrapper[T])
+		if err := s.UnmarshalSCALE(reader, funcT); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+}
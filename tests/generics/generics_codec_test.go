@@ -0,0 +1,112 @@
+// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package main
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+// assertRoundTrip checks that unmarshal decodes the encoding of value
+// back to a value deeply equal to it, without leaving bytes behind
+func assertRoundTrip[T scale_codec.Marshaler](t *testing.T, value T, unmarshal func(io.Reader) (T, error)) {
+	t.Helper()
+
+	encoded, err := value.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("encoding %v: %v", value, err)
+	}
+
+	reader := bytes.NewReader(encoded)
+	decoded, err := unmarshal(reader)
+	if err != nil {
+		t.Fatalf("decoding %v: %v", encoded, err)
+	}
+
+	if reader.Len() != 0 {
+		t.Fatalf("%d bytes left after decoding %v", reader.Len(), encoded)
+	}
+
+	if !reflect.DeepEqual(value, decoded) {
+		t.Fatalf("\nexpected: %v\ngot: %v", value, decoded)
+	}
+}
+
+func randomMaybeRef[T scale_codec.Marshaler](randomT scale_codec.RandomFunc[T]) scale_codec.RandomFunc[MaybeRef[T]] {
+	return func(r *scale_codec.Random, depth int) MaybeRef[T] {
+		switch r.Intn(2) {
+		case 0:
+			return &Inline[T]{Inner: randomT(r, depth+1)}
+		default:
+			return &Hash[T]{Inner: scale_codec.RandomByteArray(32)(r, depth+1)}
+		}
+	}
+}
+
+func randomCall(r *scale_codec.Random, depth int) Call {
+	variants := []int{0, 1, 2, 3, 4}
+	if r.Deep(depth) {
+		variants = []int{0}
+	}
+
+	switch variants[r.Intn(len(variants))] {
+	case 0:
+		return NewRemark()
+	case 1:
+		return &Store{Inner: randomMaybeRef(scale_codec.RandomInteger[uint64])(r, depth+1)}
+	case 2:
+		return &Nested{Inner: randomMaybeRef(randomCall)(r, depth+1)}
+	case 3:
+		return &Both{Inner: randomPair(scale_codec.RandomInteger[uint8], randomCall)(r, depth+1)}
+	default:
+		return &Wrapped{Inner: randomWrapper(scale_codec.RandomBool)(r, depth+1)}
+	}
+}
+
+func randomPair[A scale_codec.Marshaler, B scale_codec.Marshaler](randomA scale_codec.RandomFunc[A], randomB scale_codec.RandomFunc[B]) scale_codec.RandomFunc[*Pair[A, B]] {
+	return func(r *scale_codec.Random, depth int) *Pair[A, B] {
+		return &Pair[A, B]{
+			First: randomA(r, depth+1),
+			Second: scale_codec.RandomVec(randomB)(r, depth+1),
+		}
+	}
+}
+
+func randomWrapper[T scale_codec.Marshaler](randomT scale_codec.RandomFunc[T]) scale_codec.RandomFunc[*Wrapper[T]] {
+	return func(r *scale_codec.Random, depth int) *Wrapper[T] {
+		return &Wrapper[T]{
+			F0: scale_codec.RandomOption(randomT)(r, depth+1),
+			F1: randomMaybeRef(randomT)(r, depth+1),
+		}
+	}
+}
+
+func TestRoundTripCall(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomCall(r, 0), UnmarshalCall)
+	}
+}
+
+func FuzzUnmarshalCall(f *testing.F) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 16; idx++ {
+		encoded, err := randomCall(r, 0).MarshalSCALE()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(encoded)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value, err := UnmarshalCall(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		assertRoundTrip(t, value, UnmarshalCall)
+	})
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+func TestGenerics(t *testing.T) {
+	hash := bytes.Repeat([]byte{0xbb}, 32)
+
+	cases := []struct {
+		value         Call
+		expectedBytes []byte
+	}{
+		{
+			value: &Store{
+				Inner: &Inline[*scale_codec.Integer[uint64]]{
+					Inner: &scale_codec.Integer[uint64]{Value: 7},
+				},
+			},
+			expectedBytes: []byte{1, 0, 7, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			value: &Store{
+				Inner: &Hash[*scale_codec.Integer[uint64]]{
+					Inner: &scale_codec.ByteArray{Value: hash},
+				},
+			},
+			expectedBytes: append([]byte{1, 1}, hash...),
+		},
+		{
+			value: &Nested{
+				Inner: &Inline[Call]{Inner: NewRemark()},
+			},
+			expectedBytes: []byte{2, 0, 0},
+		},
+		{
+			value: &Both{
+				Inner: &Pair[*scale_codec.Integer[uint8], Call]{
+					First: &scale_codec.Integer[uint8]{Value: 3},
+					Second: &scale_codec.VecG[Call]{
+						Items: []Call{NewRemark(), &Nested{Inner: &Inline[Call]{Inner: NewRemark()}}},
+					},
+				},
+			},
+			expectedBytes: []byte{3, 3, 8, 0, 2, 0, 0},
+		},
+		{
+			value: &Wrapped{
+				Inner: &Wrapper[*scale_codec.Bool]{
+					F0: scale_codec.SomeG(&scale_codec.Bool{Value: true}),
+					F1: &Inline[*scale_codec.Bool]{Inner: &scale_codec.Bool{Value: false}},
+				},
+			},
+			expectedBytes: []byte{4, 1, 1, 0, 0},
+		},
+	}
+
+	for _, tt := range cases {
+		output, err := tt.value.MarshalSCALE()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !bytes.Equal(tt.expectedBytes, output) {
+			t.Fatalf("\nexpected: %v\nactual: %v", tt.expectedBytes, output)
+		}
+
+		decoded, err := UnmarshalCall(bytes.NewReader(tt.expectedBytes))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(tt.value, decoded) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.value, decoded)
+		}
+	}
+}
+
+func TestUnmarshalGenericStruct(t *testing.T) {
+	unmarshal := UnmarshalPairFromRawBytes(scale_codec.BoolFromRawBytes, scale_codec.IntegerFromRawBytes[uint16])
+	pair, err := unmarshal(bytes.NewReader([]byte{1, 4, 5, 0}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &Pair[*scale_codec.Bool, *scale_codec.Integer[uint16]]{
+		First: &scale_codec.Bool{Value: true},
+		Second: &scale_codec.VecG[*scale_codec.Integer[uint16]]{
+			Items: []*scale_codec.Integer[uint16]{{Value: 5}},
+		},
+	}
+
+	if !reflect.DeepEqual(expected, pair) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expected, pair)
+	}
+}
+
+func TestGenericsJSON(t *testing.T) {
+	value := &Both{
+		Inner: &Pair[*scale_codec.Integer[uint8], Call]{
+			First: &scale_codec.Integer[uint8]{Value: 3},
+			Second: &scale_codec.VecG[Call]{
+				Items: []Call{NewRemark(), &Store{
+					Inner: &Inline[*scale_codec.Integer[uint64]]{Inner: &scale_codec.Integer[uint64]{Value: 7}},
+				}},
+			},
+		},
+	}
+
+	expectedString := "Both(Pair { first: 3, second: [Remark, Store(Inline(7))] })"
+	if str := fmt.Sprint(value); str != expectedString {
+		t.Fatalf("\nexpected: %v\ngot: %v", expectedString, str)
+	}
+
+	output, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedJSON := `{"Both":{"first":3,"second":[{"Remark":null},{"Store":{"Inline":7}}]}}`
+	if string(output) != expectedJSON {
+		t.Fatalf("\nexpected: %v\ngot: %v", expectedJSON, string(output))
+	}
+
+	decoded, err := UnmarshalCallJSON(output)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(value, decoded) {
+		t.Fatalf("\nexpected: %v\ngot: %v", value, decoded)
+	}
+
+	wrapper, err := UnmarshalWrapperFromJSON[*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Bool])(
+		[]byte(`[null, {"Inline": true}]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if wrapper.String() != "Wrapper(None, Inline(true))" {
+		t.Fatalf("\nexpected: %v\ngot: %v", "Wrapper(None, Inline(true))", wrapper)
+	}
+}
@@ -0,0 +1,4 @@
+package main
+
+//go:generate enum_script -tests generics.scale main
+func main() {}
@@ -0,0 +1,553 @@
+// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+
+
+type MaybeRef[T scale_codec.Marshaler] interface {
+	scale_codec.Marshaler
+	IsMaybeRef()
+}
+
+func UnmarshalMaybeRefFromRawBytes[T scale_codec.Marshaler](
+	funcT func(io.Reader) (T, error)) func(io.Reader) (MaybeRef[T], error) {
+	return func(reader io.Reader) (MaybeRef[T], error) {
+		return unmarshalMaybeRef(reader, funcT)
+	}
+}
+
+func unmarshalMaybeRef[T scale_codec.Marshaler](reader io.Reader, funcT func(io.Reader) (T, error)) (MaybeRef[T], error) {
+	enumTag := make([]byte, 1)
+	n, err := reader.Read(enumTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("%w: got %v", scale_codec.ErrExpectedOneByteRead, n)
+	}
+
+	switch enumTag[0] {
+	
+	case InlineIndex:
+		unmarshaler := NewInline[T]()
+		err := unmarshaler.UnmarshalSCALE(reader, funcT)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case HashIndex:
+		unmarshaler := NewHash[T]()
+		err := unmarshaler.UnmarshalSCALE(reader, funcT)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	default:
+		return nil, fmt.Errorf("unexpected enum tag: %v", enumTag[0])
+	}
+}
+
+func UnmarshalMaybeRefFromJSON[T scale_codec.Marshaler](
+	jsonT func([]byte) (T, error)) func([]byte) (MaybeRef[T], error) {
+	return func(data []byte) (MaybeRef[T], error) {
+		return unmarshalMaybeRefJSON(data, jsonT)
+	}
+}
+
+func unmarshalMaybeRefJSON[T scale_codec.Marshaler](data []byte, jsonT func([]byte) (T, error)) (MaybeRef[T], error) {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	
+	case "Inline":
+		unmarshaler := NewInline[T]()
+		err := unmarshaler.UnmarshalJSONWith(data, jsonT)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Hash":
+		unmarshaler := NewHash[T]()
+		err := unmarshaler.UnmarshalJSONWith(data, jsonT)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	default:
+		return nil, fmt.Errorf("%w: unexpected MaybeRef variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+}
+
+// MaybeRefVisitor has a method per MaybeRef variant, a visitor given
+// to VisitMaybeRef stops compiling when a variant is added
+type MaybeRefVisitor[T scale_codec.Marshaler] interface {
+	VisitInline(*Inline[T]) error
+	VisitHash(*Hash[T]) error
+}
+
+// VisitMaybeRef calls the visitor method of the variant of value
+func VisitMaybeRef[T scale_codec.Marshaler](value MaybeRef[T], visitor MaybeRefVisitor[T]) error {
+	switch variant := value.(type) {
+	case *Inline[T]:
+		return visitor.VisitInline(variant)
+	case *Hash[T]:
+		return visitor.VisitHash(variant)
+	default:
+		return fmt.Errorf("unexpected MaybeRef variant: %T", value)
+	}
+}
+
+// MaybeRefCases holds a func per MaybeRef variant for MatchMaybeRef,
+// it is built by NewMaybeRefCases so a variant added breaks the build
+type MaybeRefCases[T scale_codec.Marshaler, R any] struct {
+	onInline func(*Inline[T]) R
+	onHash func(*Hash[T]) R
+}
+
+// NewMaybeRefCases returns the cases of MatchMaybeRef, taking
+// a func per MaybeRef variant in declaration order
+func NewMaybeRefCases[T scale_codec.Marshaler, R any](onInline func(*Inline[T]) R, onHash func(*Hash[T]) R) MaybeRefCases[T, R] {
+	return MaybeRefCases[T, R]{
+		onInline: onInline,
+		onHash: onHash,
+	}
+}
+
+// MatchMaybeRef returns the result of the case of the variant of value,
+// it panics if that case is nil
+func MatchMaybeRef[T scale_codec.Marshaler, R any](value MaybeRef[T], cases MaybeRefCases[T, R]) R {
+	switch variant := value.(type) {
+	case *Inline[T]:
+		if cases.onInline == nil {
+			panic("MatchMaybeRef: missing case for Inline")
+		}
+		return cases.onInline(variant)
+	case *Hash[T]:
+		if cases.onHash == nil {
+			panic("MatchMaybeRef: missing case for Hash")
+		}
+		return cases.onHash(variant)
+	default:
+		panic(fmt.Sprintf("MatchMaybeRef: unexpected variant %T", value))
+	}
+}
+type Origin interface {
+	scale_codec.Encodable
+	IsOrigin()
+}
+
+func UnmarshalOrigin(reader io.Reader) (Origin, error) {
+	enumTag := make([]byte, 1)
+	n, err := reader.Read(enumTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("%w: got %v", scale_codec.ErrExpectedOneByteRead, n)
+	}
+
+	switch enumTag[0] {
+	
+	case RootIndex:
+		unmarshaler := NewRoot()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case SignedIndex:
+		unmarshaler := NewSigned()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	default:
+		return nil, fmt.Errorf("unexpected enum tag: %v", enumTag[0])
+	}
+}
+
+func UnmarshalOriginJSON(data []byte) (Origin, error) {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	
+	case "Root":
+		unmarshaler := NewRoot()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Signed":
+		unmarshaler := NewSigned()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	default:
+		return nil, fmt.Errorf("%w: unexpected Origin variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+}
+
+// OriginVisitor has a method per Origin variant, a visitor given
+// to VisitOrigin stops compiling when a variant is added
+type OriginVisitor interface {
+	VisitRoot(*Root) error
+	VisitSigned(*Signed) error
+}
+
+// VisitOrigin calls the visitor method of the variant of value
+func VisitOrigin(value Origin, visitor OriginVisitor) error {
+	switch variant := value.(type) {
+	case *Root:
+		return visitor.VisitRoot(variant)
+	case *Signed:
+		return visitor.VisitSigned(variant)
+	default:
+		return fmt.Errorf("unexpected Origin variant: %T", value)
+	}
+}
+
+// OriginCases holds a func per Origin variant for MatchOrigin,
+// it is built by NewOriginCases so a variant added breaks the build
+type OriginCases[R any] struct {
+	onRoot func(*Root) R
+	onSigned func(*Signed) R
+}
+
+// NewOriginCases returns the cases of MatchOrigin, taking
+// a func per Origin variant in declaration order
+func NewOriginCases[R any](onRoot func(*Root) R, onSigned func(*Signed) R) OriginCases[R] {
+	return OriginCases[R]{
+		onRoot: onRoot,
+		onSigned: onSigned,
+	}
+}
+
+// MatchOrigin returns the result of the case of the variant of value,
+// it panics if that case is nil
+func MatchOrigin[R any](value Origin, cases OriginCases[R]) R {
+	switch variant := value.(type) {
+	case *Root:
+		if cases.onRoot == nil {
+			panic("MatchOrigin: missing case for Root")
+		}
+		return cases.onRoot(variant)
+	case *Signed:
+		if cases.onSigned == nil {
+			panic("MatchOrigin: missing case for Signed")
+		}
+		return cases.onSigned(variant)
+	default:
+		panic(fmt.Sprintf("MatchOrigin: unexpected variant %T", value))
+	}
+}
+
+
+var InlineIndex byte = 0
+
+var _ MaybeRef[scale_codec.Marshaler] = (*Inline[scale_codec.Marshaler])(nil)
+
+type Inline[T scale_codec.Marshaler] struct {
+	Inner T
+}
+
+func NewInline[T scale_codec.Marshaler]() *Inline[T] {
+	return &Inline[T]{
+		Inner: *new(T),
+	}
+}
+
+func (Inline[T]) IsMaybeRef() {}
+
+// VariantPosition orders the MaybeRef variants by declaration, as Rust's Ord
+func (Inline[T]) VariantPosition() int {
+	return 0
+}
+
+func (i Inline[T]) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := InlineIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Inline[T]) UnmarshalSCALE(reader io.Reader, funcT func(io.Reader) (T, error)) error {
+	var err error
+	i.Inner, err = funcT(reader)
+	return err
+}
+
+func (i Inline[T]) String() string {
+	return scale_codec.DebugTuple("Inline", i.Inner)
+}
+
+func (i Inline[T]) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Inline", i.Inner)
+}
+
+func (i *Inline[T]) UnmarshalJSONWith(data []byte, jsonT func([]byte) (T, error)) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Inline" {
+		return fmt.Errorf("%w: expected Inline variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = jsonT(value)
+	return err
+}
+var HashIndex byte = 1
+
+var _ MaybeRef[scale_codec.Marshaler] = (*Hash[scale_codec.Marshaler])(nil)
+
+type Hash[T scale_codec.Marshaler] struct {
+	Inner *scale_codec.ByteArray
+}
+
+func NewHash[T scale_codec.Marshaler]() *Hash[T] {
+	return &Hash[T]{
+		Inner: scale_codec.NewByteArray(32),
+	}
+}
+
+func (Hash[T]) IsMaybeRef() {}
+
+// VariantPosition orders the MaybeRef variants by declaration, as Rust's Ord
+func (Hash[T]) VariantPosition() int {
+	return 1
+}
+
+func (i Hash[T]) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := HashIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Hash[T]) UnmarshalSCALE(reader io.Reader, funcT func(io.Reader) (T, error)) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i Hash[T]) String() string {
+	return scale_codec.DebugTuple("Hash", i.Inner)
+}
+
+func (i Hash[T]) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Hash", i.Inner)
+}
+
+func (i *Hash[T]) UnmarshalJSONWith(data []byte, jsonT func([]byte) (T, error)) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Hash" {
+		return fmt.Errorf("%w: expected Hash variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.UnmarshalByteArrayFromJSON(32)(value)
+	return err
+}
+
+// NewHashFrom builds a Hash variant from Go values
+func NewHashFrom[T scale_codec.Marshaler](v []byte) *Hash[T] {
+	return &Hash[T]{
+		Inner: &scale_codec.ByteArray{Value: v},
+	}
+}
+
+func (variant Hash[T]) Get() []byte {
+	return variant.Inner.Value
+}
+var RootIndex byte = 0
+
+var _ Origin = (*Root)(nil)
+
+type Root struct {
+	Inner *scale_codec.SimpleVariant
+}
+
+func NewRoot() *Root {
+	return &Root{
+		Inner: new(scale_codec.SimpleVariant),
+	}
+}
+
+func (Root) IsOrigin() {}
+
+// VariantPosition orders the Origin variants by declaration, as Rust's Ord
+func (Root) VariantPosition() int {
+	return 0
+}
+
+func (i Root) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := RootIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Root) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i Root) String() string {
+	return "Root"
+}
+
+func (i Root) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Root", nil)
+}
+
+func (i *Root) UnmarshalJSON(data []byte) error {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Root" {
+		return fmt.Errorf("%w: expected Root variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+	return nil
+}
+var SignedIndex byte = 1
+
+var _ Origin = (*Signed)(nil)
+
+type Signed struct {
+	Inner *AccountId
+}
+
+func NewSigned() *Signed {
+	return &Signed{
+		Inner: new(AccountId),
+	}
+}
+
+func (Signed) IsOrigin() {}
+
+// VariantPosition orders the Origin variants by declaration, as Rust's Ord
+func (Signed) VariantPosition() int {
+	return 1
+}
+
+func (i Signed) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := SignedIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Signed) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i Signed) String() string {
+	return scale_codec.DebugTuple("Signed", i.Inner)
+}
+
+func (i Signed) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Signed", i.Inner)
+}
+
+func (i *Signed) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Signed" {
+		return fmt.Errorf("%w: expected Signed variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.FromJSON[AccountId](value)
+	return err
+}
+
+
+
+
+var _ scale_codec.Encodable = (*AccountId)(nil)
+
+type AccountId struct {
+	Inner *scale_codec.ByteArray
+}
+
+func NewAccountId() *AccountId {
+	return &AccountId{
+		Inner: scale_codec.NewByteArray(32),
+	}
+}
+
+func (n AccountId) MarshalSCALE() ([]byte, error) {
+	return n.Inner.MarshalSCALE()
+}
+
+func (n *AccountId) UnmarshalSCALE(reader io.Reader) (err error) {
+	n.Inner, err = scale_codec.UnmarshalByteArrayFromRawBytes(32)(reader)
+	return err
+}
+
+func (n AccountId) String() string {
+	return scale_codec.DebugTuple("AccountId", n.Inner)
+}
+
+// MarshalJSON writes the inner value, a newtype is transparent in JSON
+func (n AccountId) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.Inner)
+}
+
+func (n *AccountId) UnmarshalJSON(data []byte) (err error) {
+	n.Inner, err = scale_codec.UnmarshalByteArrayFromJSON(32)(data)
+	return err
+}
+
+func UnmarshalAccountId(reader io.Reader) (*AccountId, error) {
+	n := new(AccountId)
+	if err := n.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
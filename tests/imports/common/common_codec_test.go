@@ -0,0 +1,99 @@
+// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package common
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+// assertRoundTrip checks that unmarshal decodes the encoding of value
+// back to a value deeply equal to it, without leaving bytes behind
+func assertRoundTrip[T scale_codec.Marshaler](t *testing.T, value T, unmarshal func(io.Reader) (T, error)) {
+	t.Helper()
+
+	encoded, err := value.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("encoding %v: %v", value, err)
+	}
+
+	reader := bytes.NewReader(encoded)
+	decoded, err := unmarshal(reader)
+	if err != nil {
+		t.Fatalf("decoding %v: %v", encoded, err)
+	}
+
+	if reader.Len() != 0 {
+		t.Fatalf("%d bytes left after decoding %v", reader.Len(), encoded)
+	}
+
+	if !reflect.DeepEqual(value, decoded) {
+		t.Fatalf("\nexpected: %v\ngot: %v", value, decoded)
+	}
+}
+
+func randomMaybeRef[T scale_codec.Marshaler](randomT scale_codec.RandomFunc[T]) scale_codec.RandomFunc[MaybeRef[T]] {
+	return func(r *scale_codec.Random, depth int) MaybeRef[T] {
+		switch r.Intn(2) {
+		case 0:
+			return &Inline[T]{Inner: randomT(r, depth+1)}
+		default:
+			return &Hash[T]{Inner: scale_codec.RandomByteArray(32)(r, depth+1)}
+		}
+	}
+}
+
+func randomOrigin(r *scale_codec.Random, depth int) Origin {
+	variants := []int{0, 1}
+	if r.Deep(depth) {
+		variants = []int{0}
+	}
+
+	switch variants[r.Intn(len(variants))] {
+	case 0:
+		return NewRoot()
+	default:
+		return &Signed{Inner: randomAccountId(r, depth+1)}
+	}
+}
+
+func randomAccountId(r *scale_codec.Random, depth int) *AccountId {
+	return &AccountId{Inner: scale_codec.RandomByteArray(32)(r, depth+1)}
+}
+
+func TestRoundTripOrigin(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomOrigin(r, 0), UnmarshalOrigin)
+	}
+}
+
+func FuzzUnmarshalOrigin(f *testing.F) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 16; idx++ {
+		encoded, err := randomOrigin(r, 0).MarshalSCALE()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(encoded)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value, err := UnmarshalOrigin(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		assertRoundTrip(t, value, UnmarshalOrigin)
+	})
+}
+
+func TestRoundTripAccountId(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomAccountId(r, 0), UnmarshalAccountId)
+	}
+}
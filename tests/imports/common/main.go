@@ -0,0 +1,3 @@
+package common
+
+//go:generate enum_script -tests common.scale common
@@ -0,0 +1,458 @@
+// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+	common "github.com/crypto2lab/scale-codec/tests/imports/common"
+)
+
+
+
+type Call interface {
+	scale_codec.Encodable
+	IsCall()
+}
+
+func UnmarshalCall(reader io.Reader) (Call, error) {
+	enumTag := make([]byte, 1)
+	n, err := reader.Read(enumTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("%w: got %v", scale_codec.ErrExpectedOneByteRead, n)
+	}
+
+	switch enumTag[0] {
+	
+	case SendIndex:
+		unmarshaler := NewSend()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case SudoIndex:
+		unmarshaler := NewSudo()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case StoreIndex:
+		unmarshaler := NewStore()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	default:
+		return nil, fmt.Errorf("unexpected enum tag: %v", enumTag[0])
+	}
+}
+
+func UnmarshalCallJSON(data []byte) (Call, error) {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	
+	case "Send":
+		unmarshaler := NewSend()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Sudo":
+		unmarshaler := NewSudo()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Store":
+		unmarshaler := NewStore()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	default:
+		return nil, fmt.Errorf("%w: unexpected Call variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+}
+
+// CallVisitor has a method per Call variant, a visitor given
+// to VisitCall stops compiling when a variant is added
+type CallVisitor interface {
+	VisitSend(*Send) error
+	VisitSudo(*Sudo) error
+	VisitStore(*Store) error
+}
+
+// VisitCall calls the visitor method of the variant of value
+func VisitCall(value Call, visitor CallVisitor) error {
+	switch variant := value.(type) {
+	case *Send:
+		return visitor.VisitSend(variant)
+	case *Sudo:
+		return visitor.VisitSudo(variant)
+	case *Store:
+		return visitor.VisitStore(variant)
+	default:
+		return fmt.Errorf("unexpected Call variant: %T", value)
+	}
+}
+
+// CallCases holds a func per Call variant for MatchCall,
+// it is built by NewCallCases so a variant added breaks the build
+type CallCases[R any] struct {
+	onSend func(*Send) R
+	onSudo func(*Sudo) R
+	onStore func(*Store) R
+}
+
+// NewCallCases returns the cases of MatchCall, taking
+// a func per Call variant in declaration order
+func NewCallCases[R any](onSend func(*Send) R, onSudo func(*Sudo) R, onStore func(*Store) R) CallCases[R] {
+	return CallCases[R]{
+		onSend: onSend,
+		onSudo: onSudo,
+		onStore: onStore,
+	}
+}
+
+// MatchCall returns the result of the case of the variant of value,
+// it panics if that case is nil
+func MatchCall[R any](value Call, cases CallCases[R]) R {
+	switch variant := value.(type) {
+	case *Send:
+		if cases.onSend == nil {
+			panic("MatchCall: missing case for Send")
+		}
+		return cases.onSend(variant)
+	case *Sudo:
+		if cases.onSudo == nil {
+			panic("MatchCall: missing case for Sudo")
+		}
+		return cases.onSudo(variant)
+	case *Store:
+		if cases.onStore == nil {
+			panic("MatchCall: missing case for Store")
+		}
+		return cases.onStore(variant)
+	default:
+		panic(fmt.Sprintf("MatchCall: unexpected variant %T", value))
+	}
+}
+
+
+var SendIndex byte = 0
+
+var _ Call = (*Send)(nil)
+
+type Send struct {
+	Inner *Transfer
+}
+
+func NewSend() *Send {
+	return &Send{
+		Inner: new(Transfer),
+	}
+}
+
+func (Send) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Send) VariantPosition() int {
+	return 0
+}
+
+func (i Send) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := SendIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Send) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i Send) String() string {
+	return scale_codec.DebugTuple("Send", i.Inner)
+}
+
+func (i Send) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Send", i.Inner)
+}
+
+func (i *Send) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Send" {
+		return fmt.Errorf("%w: expected Send variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.FromJSON[Transfer](value)
+	return err
+}
+var SudoIndex byte = 1
+
+var _ Call = (*Sudo)(nil)
+
+type Sudo struct {
+	F0 common.Origin
+	F1 *scale_codec.OptionG[*common.AccountId]
+}
+
+func NewSudo() *Sudo {
+	return &Sudo{
+		F0: nil,
+		F1: new(scale_codec.OptionG[*common.AccountId]),
+	}
+}
+
+func (Sudo) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Sudo) VariantPosition() int {
+	return 1
+}
+
+func (i Sudo) MarshalSCALE() (output []byte, err error) {
+	output = []byte{SudoIndex}
+	var enc []byte
+	
+	enc, err = i.F0.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = i.F1.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (i *Sudo) UnmarshalSCALE(reader io.Reader) (err error) {
+	
+	i.F0, err = common.UnmarshalOrigin(reader)
+	if err != nil {
+		return err
+	}
+	
+	i.F1, err = scale_codec.UnmarshalOptionFromRawBytes[*common.AccountId](common.UnmarshalAccountId)(reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (i Sudo) String() string {
+	return scale_codec.DebugTuple("Sudo", i.F0, i.F1)
+}
+
+func (i Sudo) MarshalJSON() ([]byte, error) {
+	value, err := scale_codec.MarshalJSONTuple(i.F0, i.F1)
+	if err != nil {
+		return nil, err
+	}
+	return scale_codec.MarshalJSONVariant("Sudo", json.RawMessage(value))
+}
+
+func (i *Sudo) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Sudo" {
+		return fmt.Errorf("%w: expected Sudo variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	items, err := scale_codec.UnmarshalJSONTuple(value, 2)
+	if err != nil {
+		return err
+	}
+	
+	i.F0, err = common.UnmarshalOriginJSON(items[0])
+	if err != nil {
+		return err
+	}
+	
+	i.F1, err = scale_codec.UnmarshalOptionFromJSON[*common.AccountId](scale_codec.FromJSON[common.AccountId])(items[1])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+var StoreIndex byte = 2
+
+var _ Call = (*Store)(nil)
+
+type Store struct {
+	Inner common.MaybeRef[*scale_codec.Integer[uint64]]
+}
+
+func NewStore() *Store {
+	return &Store{
+		Inner: nil,
+	}
+}
+
+func (Store) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Store) VariantPosition() int {
+	return 2
+}
+
+func (i Store) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := StoreIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Store) UnmarshalSCALE(reader io.Reader) error {
+	var err error
+	i.Inner, err = common.UnmarshalMaybeRefFromRawBytes[*scale_codec.Integer[uint64]](scale_codec.IntegerFromRawBytes[uint64])(reader)
+	return err
+}
+
+func (i Store) String() string {
+	return scale_codec.DebugTuple("Store", i.Inner)
+}
+
+func (i Store) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Store", i.Inner)
+}
+
+func (i *Store) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Store" {
+		return fmt.Errorf("%w: expected Store variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = common.UnmarshalMaybeRefFromJSON[*scale_codec.Integer[uint64]](scale_codec.FromJSON[scale_codec.Integer[uint64]])(value)
+	return err
+}
+
+
+var _ scale_codec.Encodable = (*Transfer)(nil)
+
+type Transfer struct {
+	From *common.AccountId
+	Amount *scale_codec.U128
+}
+
+func NewTransfer() *Transfer {
+	return &Transfer{
+		From: new(common.AccountId),
+		Amount: new(scale_codec.U128),
+	}
+}
+
+func (s Transfer) MarshalSCALE() (output []byte, err error) {
+	output = make([]byte, 0)
+	var enc []byte
+	
+	enc, err = s.From.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.Amount.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (s *Transfer) UnmarshalSCALE(reader io.Reader) (err error) {
+	
+	s.From, err = common.UnmarshalAccountId(reader)
+	if err != nil {
+		return err
+	}
+	
+	s.Amount, err = scale_codec.U128FromRawBytes(reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (s Transfer) String() string {
+	return scale_codec.DebugStruct("Transfer", []string{"from", "amount"}, s.From, s.Amount)
+}
+
+func (s Transfer) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONObject([]string{"from", "amount"}, s.From, s.Amount)
+}
+
+func (s *Transfer) UnmarshalJSON(data []byte) error {
+	items, err := scale_codec.UnmarshalJSONObject(data, []string{"from", "amount"})
+	if err != nil {
+		return err
+	}
+	
+	s.From, err = scale_codec.FromJSON[common.AccountId](items[0])
+	if err != nil {
+		return err
+	}
+	
+	s.Amount, err = scale_codec.FromJSON[scale_codec.U128](items[1])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalTransfer(reader io.Reader) (*Transfer, error) {
+	s := new(Transfer)
+	if err := s.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
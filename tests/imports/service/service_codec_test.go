@@ -0,0 +1,126 @@
+// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package main
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+	common "github.com/crypto2lab/scale-codec/tests/imports/common"
+)
+
+// assertRoundTrip checks that unmarshal decodes the encoding of value
+// back to a value deeply equal to it, without leaving bytes behind
+func assertRoundTrip[T scale_codec.Marshaler](t *testing.T, value T, unmarshal func(io.Reader) (T, error)) {
+	t.Helper()
+
+	encoded, err := value.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("encoding %v: %v", value, err)
+	}
+
+	reader := bytes.NewReader(encoded)
+	decoded, err := unmarshal(reader)
+	if err != nil {
+		t.Fatalf("decoding %v: %v", encoded, err)
+	}
+
+	if reader.Len() != 0 {
+		t.Fatalf("%d bytes left after decoding %v", reader.Len(), encoded)
+	}
+
+	if !reflect.DeepEqual(value, decoded) {
+		t.Fatalf("\nexpected: %v\ngot: %v", value, decoded)
+	}
+}
+
+func randomCommonMaybeRef[T scale_codec.Marshaler](randomT scale_codec.RandomFunc[T]) scale_codec.RandomFunc[common.MaybeRef[T]] {
+	return func(r *scale_codec.Random, depth int) common.MaybeRef[T] {
+		switch r.Intn(2) {
+		case 0:
+			return &common.Inline[T]{Inner: randomT(r, depth+1)}
+		default:
+			return &common.Hash[T]{Inner: scale_codec.RandomByteArray(32)(r, depth+1)}
+		}
+	}
+}
+
+func randomCommonOrigin(r *scale_codec.Random, depth int) common.Origin {
+	variants := []int{0, 1}
+	if r.Deep(depth) {
+		variants = []int{0}
+	}
+
+	switch variants[r.Intn(len(variants))] {
+	case 0:
+		return common.NewRoot()
+	default:
+		return &common.Signed{Inner: randomCommonAccountId(r, depth+1)}
+	}
+}
+
+func randomCommonAccountId(r *scale_codec.Random, depth int) *common.AccountId {
+	return &common.AccountId{Inner: scale_codec.RandomByteArray(32)(r, depth+1)}
+}
+
+func randomCall(r *scale_codec.Random, depth int) Call {
+	variants := []int{0, 1, 2}
+	if r.Deep(depth) {
+		variants = []int{1, 2}
+	}
+
+	switch variants[r.Intn(len(variants))] {
+	case 0:
+		return &Send{Inner: randomTransfer(r, depth+1)}
+	case 1:
+		return &Sudo{
+			F0: randomCommonOrigin(r, depth+1),
+			F1: scale_codec.RandomOption(randomCommonAccountId)(r, depth+1),
+		}
+	default:
+		return &Store{Inner: randomCommonMaybeRef(scale_codec.RandomInteger[uint64])(r, depth+1)}
+	}
+}
+
+func randomTransfer(r *scale_codec.Random, depth int) *Transfer {
+	return &Transfer{
+		From: randomCommonAccountId(r, depth+1),
+		Amount: scale_codec.RandomU128(r, depth+1),
+	}
+}
+
+func TestRoundTripCall(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomCall(r, 0), UnmarshalCall)
+	}
+}
+
+func FuzzUnmarshalCall(f *testing.F) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 16; idx++ {
+		encoded, err := randomCall(r, 0).MarshalSCALE()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(encoded)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value, err := UnmarshalCall(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		assertRoundTrip(t, value, UnmarshalCall)
+	})
+}
+
+func TestRoundTripTransfer(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomTransfer(r, 0), UnmarshalTransfer)
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+	"github.com/crypto2lab/scale-codec/tests/imports/common"
+)
+
+func TestImportedTypes(t *testing.T) {
+	alice := &common.AccountId{Inner: &scale_codec.ByteArray{Value: bytes.Repeat([]byte{1}, 32)}}
+
+	cases := []struct {
+		value         Call
+		expectedBytes []byte
+	}{
+		{
+			value: &Send{
+				Inner: &Transfer{
+					From:   alice,
+					Amount: scale_codec.U128FromUpperLower(0, 5),
+				},
+			},
+			expectedBytes: bytes.Join([][]byte{
+				{0},
+				alice.Inner.Value,
+				{5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			}, nil),
+		},
+		{
+			value: &Sudo{
+				F0: &common.Signed{Inner: alice},
+				F1: scale_codec.NoneG[*common.AccountId](),
+			},
+			expectedBytes: bytes.Join([][]byte{{1, 1}, alice.Inner.Value, {0}}, nil),
+		},
+		{
+			value: &Store{
+				Inner: &common.Inline[*scale_codec.Integer[uint64]]{
+					Inner: &scale_codec.Integer[uint64]{Value: 2},
+				},
+			},
+			expectedBytes: []byte{2, 0, 2, 0, 0, 0, 0, 0, 0, 0},
+		},
+	}
+
+	for _, tt := range cases {
+		output, err := tt.value.MarshalSCALE()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !bytes.Equal(tt.expectedBytes, output) {
+			t.Fatalf("\nexpected: %v\nactual: %v", tt.expectedBytes, output)
+		}
+
+		decoded, err := UnmarshalCall(bytes.NewReader(tt.expectedBytes))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(tt.value, decoded) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.value, decoded)
+		}
+	}
+}
@@ -0,0 +1,4 @@
+package main
+
+//go:generate enum_script -tests -in naming.scale -naming enum
+func main() {}
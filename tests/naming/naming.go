@@ -0,0 +1,785 @@
+// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+
+
+type Call interface {
+	scale_codec.Encodable
+	IsCall()
+}
+
+func UnmarshalCall(reader io.Reader) (Call, error) {
+	enumTag := make([]byte, 1)
+	n, err := reader.Read(enumTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("%w: got %v", scale_codec.ErrExpectedOneByteRead, n)
+	}
+
+	switch enumTag[0] {
+	
+	case CallNoneIndex:
+		unmarshaler := NewCallNone()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case CallTransferIndex:
+		unmarshaler := NewCallTransfer()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case CallBoolIndex:
+		unmarshaler := NewCallBool()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	default:
+		return nil, fmt.Errorf("unexpected enum tag: %v", enumTag[0])
+	}
+}
+
+func UnmarshalCallJSON(data []byte) (Call, error) {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	
+	case "None":
+		unmarshaler := NewCallNone()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Transfer":
+		unmarshaler := NewCallTransfer()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Bool":
+		unmarshaler := NewCallBool()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	default:
+		return nil, fmt.Errorf("%w: unexpected Call variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+}
+
+// CallVisitor has a method per Call variant, a visitor given
+// to VisitCall stops compiling when a variant is added
+type CallVisitor interface {
+	VisitNone(*CallNone) error
+	VisitTransfer(*CallTransfer) error
+	VisitBool(*CallBool) error
+}
+
+// VisitCall calls the visitor method of the variant of value
+func VisitCall(value Call, visitor CallVisitor) error {
+	switch variant := value.(type) {
+	case *CallNone:
+		return visitor.VisitNone(variant)
+	case *CallTransfer:
+		return visitor.VisitTransfer(variant)
+	case *CallBool:
+		return visitor.VisitBool(variant)
+	default:
+		return fmt.Errorf("unexpected Call variant: %T", value)
+	}
+}
+
+// CallCases holds a func per Call variant for MatchCall,
+// it is built by NewCallCases so a variant added breaks the build
+type CallCases[R any] struct {
+	onNone func(*CallNone) R
+	onTransfer func(*CallTransfer) R
+	onBool func(*CallBool) R
+}
+
+// NewCallCases returns the cases of MatchCall, taking
+// a func per Call variant in declaration order
+func NewCallCases[R any](onNone func(*CallNone) R, onTransfer func(*CallTransfer) R, onBool func(*CallBool) R) CallCases[R] {
+	return CallCases[R]{
+		onNone: onNone,
+		onTransfer: onTransfer,
+		onBool: onBool,
+	}
+}
+
+// MatchCall returns the result of the case of the variant of value,
+// it panics if that case is nil
+func MatchCall[R any](value Call, cases CallCases[R]) R {
+	switch variant := value.(type) {
+	case *CallNone:
+		if cases.onNone == nil {
+			panic("MatchCall: missing case for None")
+		}
+		return cases.onNone(variant)
+	case *CallTransfer:
+		if cases.onTransfer == nil {
+			panic("MatchCall: missing case for Transfer")
+		}
+		return cases.onTransfer(variant)
+	case *CallBool:
+		if cases.onBool == nil {
+			panic("MatchCall: missing case for Bool")
+		}
+		return cases.onBool(variant)
+	default:
+		panic(fmt.Sprintf("MatchCall: unexpected variant %T", value))
+	}
+}
+type Event interface {
+	scale_codec.Encodable
+	IsEvent()
+}
+
+func UnmarshalEvent(reader io.Reader) (Event, error) {
+	enumTag := make([]byte, 1)
+	n, err := reader.Read(enumTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("%w: got %v", scale_codec.ErrExpectedOneByteRead, n)
+	}
+
+	switch enumTag[0] {
+	
+	case EventNoneIndex:
+		unmarshaler := NewEventNone()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case EventTransferIndex:
+		unmarshaler := NewEventTransfer()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case EventFailedIndex:
+		unmarshaler := NewEventFailed()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	default:
+		return nil, fmt.Errorf("unexpected enum tag: %v", enumTag[0])
+	}
+}
+
+func UnmarshalEventJSON(data []byte) (Event, error) {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	
+	case "None":
+		unmarshaler := NewEventNone()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Transfer":
+		unmarshaler := NewEventTransfer()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Failed":
+		unmarshaler := NewEventFailed()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	default:
+		return nil, fmt.Errorf("%w: unexpected Event variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+}
+
+// EventVisitor has a method per Event variant, a visitor given
+// to VisitEvent stops compiling when a variant is added
+type EventVisitor interface {
+	VisitNone(*EventNone) error
+	VisitTransfer(*EventTransfer) error
+	VisitFailed(*EventFailed) error
+}
+
+// VisitEvent calls the visitor method of the variant of value
+func VisitEvent(value Event, visitor EventVisitor) error {
+	switch variant := value.(type) {
+	case *EventNone:
+		return visitor.VisitNone(variant)
+	case *EventTransfer:
+		return visitor.VisitTransfer(variant)
+	case *EventFailed:
+		return visitor.VisitFailed(variant)
+	default:
+		return fmt.Errorf("unexpected Event variant: %T", value)
+	}
+}
+
+// EventCases holds a func per Event variant for MatchEvent,
+// it is built by NewEventCases so a variant added breaks the build
+type EventCases[R any] struct {
+	onNone func(*EventNone) R
+	onTransfer func(*EventTransfer) R
+	onFailed func(*EventFailed) R
+}
+
+// NewEventCases returns the cases of MatchEvent, taking
+// a func per Event variant in declaration order
+func NewEventCases[R any](onNone func(*EventNone) R, onTransfer func(*EventTransfer) R, onFailed func(*EventFailed) R) EventCases[R] {
+	return EventCases[R]{
+		onNone: onNone,
+		onTransfer: onTransfer,
+		onFailed: onFailed,
+	}
+}
+
+// MatchEvent returns the result of the case of the variant of value,
+// it panics if that case is nil
+func MatchEvent[R any](value Event, cases EventCases[R]) R {
+	switch variant := value.(type) {
+	case *EventNone:
+		if cases.onNone == nil {
+			panic("MatchEvent: missing case for None")
+		}
+		return cases.onNone(variant)
+	case *EventTransfer:
+		if cases.onTransfer == nil {
+			panic("MatchEvent: missing case for Transfer")
+		}
+		return cases.onTransfer(variant)
+	case *EventFailed:
+		if cases.onFailed == nil {
+			panic("MatchEvent: missing case for Failed")
+		}
+		return cases.onFailed(variant)
+	default:
+		panic(fmt.Sprintf("MatchEvent: unexpected variant %T", value))
+	}
+}
+
+
+var CallNoneIndex byte = 0
+
+var _ Call = (*CallNone)(nil)
+
+type CallNone struct {
+	Inner *scale_codec.SimpleVariant
+}
+
+func NewCallNone() *CallNone {
+	return &CallNone{
+		Inner: new(scale_codec.SimpleVariant),
+	}
+}
+
+func (CallNone) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (CallNone) VariantPosition() int {
+	return 0
+}
+
+func (i CallNone) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := CallNoneIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *CallNone) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i CallNone) String() string {
+	return "None"
+}
+
+func (i CallNone) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("None", nil)
+}
+
+func (i *CallNone) UnmarshalJSON(data []byte) error {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "None" {
+		return fmt.Errorf("%w: expected None variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+	return nil
+}
+var CallTransferIndex byte = 1
+
+var _ Call = (*CallTransfer)(nil)
+
+type CallTransfer struct {
+	Inner *Transfer
+}
+
+func NewCallTransfer() *CallTransfer {
+	return &CallTransfer{
+		Inner: new(Transfer),
+	}
+}
+
+func (CallTransfer) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (CallTransfer) VariantPosition() int {
+	return 1
+}
+
+func (i CallTransfer) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := CallTransferIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *CallTransfer) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i CallTransfer) String() string {
+	return scale_codec.DebugTuple("Transfer", i.Inner)
+}
+
+func (i CallTransfer) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Transfer", i.Inner)
+}
+
+func (i *CallTransfer) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Transfer" {
+		return fmt.Errorf("%w: expected Transfer variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.FromJSON[Transfer](value)
+	return err
+}
+var CallBoolIndex byte = 2
+
+var _ Call = (*CallBool)(nil)
+
+type CallBool struct {
+	Inner *scale_codec.Bool
+}
+
+func NewCallBool() *CallBool {
+	return &CallBool{
+		Inner: new(scale_codec.Bool),
+	}
+}
+
+func (CallBool) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (CallBool) VariantPosition() int {
+	return 2
+}
+
+func (i CallBool) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := CallBoolIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *CallBool) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i CallBool) String() string {
+	return scale_codec.DebugTuple("Bool", i.Inner)
+}
+
+func (i CallBool) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Bool", i.Inner)
+}
+
+func (i *CallBool) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Bool" {
+		return fmt.Errorf("%w: expected Bool variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.FromJSON[scale_codec.Bool](value)
+	return err
+}
+
+// NewCallBoolFrom builds a Bool variant from Go values
+func NewCallBoolFrom(v bool) *CallBool {
+	return &CallBool{
+		Inner: &scale_codec.Bool{Value: v},
+	}
+}
+
+func (variant CallBool) Get() bool {
+	return variant.Inner.Value
+}
+var EventNoneIndex byte = 0
+
+var _ Event = (*EventNone)(nil)
+
+type EventNone struct {
+	Inner *scale_codec.SimpleVariant
+}
+
+func NewEventNone() *EventNone {
+	return &EventNone{
+		Inner: new(scale_codec.SimpleVariant),
+	}
+}
+
+func (EventNone) IsEvent() {}
+
+// VariantPosition orders the Event variants by declaration, as Rust's Ord
+func (EventNone) VariantPosition() int {
+	return 0
+}
+
+func (i EventNone) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := EventNoneIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *EventNone) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i EventNone) String() string {
+	return "None"
+}
+
+func (i EventNone) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("None", nil)
+}
+
+func (i *EventNone) UnmarshalJSON(data []byte) error {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "None" {
+		return fmt.Errorf("%w: expected None variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+	return nil
+}
+var EventTransferIndex byte = 1
+
+var _ Event = (*EventTransfer)(nil)
+
+type EventTransfer struct {
+	Inner *Transfer
+}
+
+func NewEventTransfer() *EventTransfer {
+	return &EventTransfer{
+		Inner: new(Transfer),
+	}
+}
+
+func (EventTransfer) IsEvent() {}
+
+// VariantPosition orders the Event variants by declaration, as Rust's Ord
+func (EventTransfer) VariantPosition() int {
+	return 1
+}
+
+func (i EventTransfer) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := EventTransferIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *EventTransfer) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i EventTransfer) String() string {
+	return scale_codec.DebugTuple("Transfer", i.Inner)
+}
+
+func (i EventTransfer) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Transfer", i.Inner)
+}
+
+func (i *EventTransfer) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Transfer" {
+		return fmt.Errorf("%w: expected Transfer variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.FromJSON[Transfer](value)
+	return err
+}
+var EventFailedIndex byte = 2
+
+var _ Event = (*EventFailed)(nil)
+
+type EventFailed struct {
+	Call Call
+	Code *scale_codec.Integer[uint8]
+}
+
+func NewEventFailed() *EventFailed {
+	return &EventFailed{
+		Call: nil,
+		Code: new(scale_codec.Integer[uint8]),
+	}
+}
+
+func (EventFailed) IsEvent() {}
+
+// VariantPosition orders the Event variants by declaration, as Rust's Ord
+func (EventFailed) VariantPosition() int {
+	return 2
+}
+
+func (i EventFailed) MarshalSCALE() (output []byte, err error) {
+	output = []byte{EventFailedIndex}
+	var enc []byte
+	
+	enc, err = i.Call.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = i.Code.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (i *EventFailed) UnmarshalSCALE(reader io.Reader) (err error) {
+	
+	i.Call, err = UnmarshalCall(reader)
+	if err != nil {
+		return err
+	}
+	
+	i.Code, err = scale_codec.IntegerFromRawBytes[uint8](reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (i EventFailed) String() string {
+	return scale_codec.DebugStruct("Failed", []string{"call", "code"}, i.Call, i.Code)
+}
+
+func (i EventFailed) MarshalJSON() ([]byte, error) {
+	value, err := scale_codec.MarshalJSONObject([]string{"call", "code"}, i.Call, i.Code)
+	if err != nil {
+		return nil, err
+	}
+	return scale_codec.MarshalJSONVariant("Failed", json.RawMessage(value))
+}
+
+func (i *EventFailed) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Failed" {
+		return fmt.Errorf("%w: expected Failed variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	items, err := scale_codec.UnmarshalJSONObject(value, []string{"call", "code"})
+	if err != nil {
+		return err
+	}
+	
+	i.Call, err = UnmarshalCallJSON(items[0])
+	if err != nil {
+		return err
+	}
+	
+	i.Code, err = scale_codec.FromJSON[scale_codec.Integer[uint8]](items[1])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+// NewEventFailedFrom builds a Failed variant from Go values
+func NewEventFailedFrom(call Call, code uint8) *EventFailed {
+	return &EventFailed{
+		Call: call,
+		Code: &scale_codec.Integer[uint8]{Value: code},
+	}
+}
+
+
+var _ scale_codec.Encodable = (*Transfer)(nil)
+
+type Transfer struct {
+	To *scale_codec.Integer[uint32]
+	Amount *scale_codec.Integer[uint64]
+}
+
+func NewTransfer() *Transfer {
+	return &Transfer{
+		To: new(scale_codec.Integer[uint32]),
+		Amount: new(scale_codec.Integer[uint64]),
+	}
+}
+
+func (s Transfer) MarshalSCALE() (output []byte, err error) {
+	output = make([]byte, 0)
+	var enc []byte
+	
+	enc, err = s.To.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.Amount.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (s *Transfer) UnmarshalSCALE(reader io.Reader) (err error) {
+	
+	s.To, err = scale_codec.IntegerFromRawBytes[uint32](reader)
+	if err != nil {
+		return err
+	}
+	
+	s.Amount, err = scale_codec.IntegerFromRawBytes[uint64](reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (s Transfer) String() string {
+	return scale_codec.DebugStruct("Transfer", []string{"to", "amount"}, s.To, s.Amount)
+}
+
+func (s Transfer) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONObject([]string{"to", "amount"}, s.To, s.Amount)
+}
+
+func (s *Transfer) UnmarshalJSON(data []byte) error {
+	items, err := scale_codec.UnmarshalJSONObject(data, []string{"to", "amount"})
+	if err != nil {
+		return err
+	}
+	
+	s.To, err = scale_codec.FromJSON[scale_codec.Integer[uint32]](items[0])
+	if err != nil {
+		return err
+	}
+	
+	s.Amount, err = scale_codec.FromJSON[scale_codec.Integer[uint64]](items[1])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalTransfer(reader io.Reader) (*Transfer, error) {
+	s := new(Transfer)
+	if err := s.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
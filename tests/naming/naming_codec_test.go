@@ -0,0 +1,139 @@
+// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package main
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+// assertRoundTrip checks that unmarshal decodes the encoding of value
+// back to a value deeply equal to it, without leaving bytes behind
+func assertRoundTrip[T scale_codec.Marshaler](t *testing.T, value T, unmarshal func(io.Reader) (T, error)) {
+	t.Helper()
+
+	encoded, err := value.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("encoding %v: %v", value, err)
+	}
+
+	reader := bytes.NewReader(encoded)
+	decoded, err := unmarshal(reader)
+	if err != nil {
+		t.Fatalf("decoding %v: %v", encoded, err)
+	}
+
+	if reader.Len() != 0 {
+		t.Fatalf("%d bytes left after decoding %v", reader.Len(), encoded)
+	}
+
+	if !reflect.DeepEqual(value, decoded) {
+		t.Fatalf("\nexpected: %v\ngot: %v", value, decoded)
+	}
+}
+
+func randomCall(r *scale_codec.Random, depth int) Call {
+	variants := []int{0, 1, 2}
+	if r.Deep(depth) {
+		variants = []int{0, 2}
+	}
+
+	switch variants[r.Intn(len(variants))] {
+	case 0:
+		return NewCallNone()
+	case 1:
+		return &CallTransfer{Inner: randomTransfer(r, depth+1)}
+	default:
+		return &CallBool{Inner: scale_codec.RandomBool(r, depth+1)}
+	}
+}
+
+func randomEvent(r *scale_codec.Random, depth int) Event {
+	variants := []int{0, 1, 2}
+	if r.Deep(depth) {
+		variants = []int{0}
+	}
+
+	switch variants[r.Intn(len(variants))] {
+	case 0:
+		return NewEventNone()
+	case 1:
+		return &EventTransfer{Inner: randomTransfer(r, depth+1)}
+	default:
+		return &EventFailed{
+			Call: randomCall(r, depth+1),
+			Code: scale_codec.RandomInteger[uint8](r, depth+1),
+		}
+	}
+}
+
+func randomTransfer(r *scale_codec.Random, depth int) *Transfer {
+	return &Transfer{
+		To: scale_codec.RandomInteger[uint32](r, depth+1),
+		Amount: scale_codec.RandomInteger[uint64](r, depth+1),
+	}
+}
+
+func TestRoundTripCall(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomCall(r, 0), UnmarshalCall)
+	}
+}
+
+func FuzzUnmarshalCall(f *testing.F) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 16; idx++ {
+		encoded, err := randomCall(r, 0).MarshalSCALE()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(encoded)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value, err := UnmarshalCall(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		assertRoundTrip(t, value, UnmarshalCall)
+	})
+}
+
+func TestRoundTripEvent(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomEvent(r, 0), UnmarshalEvent)
+	}
+}
+
+func FuzzUnmarshalEvent(f *testing.F) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 16; idx++ {
+		encoded, err := randomEvent(r, 0).MarshalSCALE()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(encoded)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value, err := UnmarshalEvent(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		assertRoundTrip(t, value, UnmarshalEvent)
+	})
+}
+
+func TestRoundTripTransfer(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomTransfer(r, 0), UnmarshalTransfer)
+	}
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+func TestEnumPrefixedVariants(t *testing.T) {
+	transfer := &Transfer{
+		To:     &scale_codec.Integer[uint32]{Value: 1},
+		Amount: &scale_codec.Integer[uint64]{Value: 2},
+	}
+
+	calls := []struct {
+		value         Call
+		expectedBytes []byte
+	}{
+		{
+			value:         NewCallNone(),
+			expectedBytes: []byte{CallNoneIndex},
+		},
+		{
+			value:         &CallTransfer{Inner: transfer},
+			expectedBytes: []byte{CallTransferIndex, 1, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			value:         &CallBool{Inner: &scale_codec.Bool{Value: true}},
+			expectedBytes: []byte{CallBoolIndex, 1},
+		},
+	}
+
+	for _, tt := range calls {
+		output, err := tt.value.MarshalSCALE()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !bytes.Equal(tt.expectedBytes, output) {
+			t.Fatalf("\nexpected: %v\nactual: %v", tt.expectedBytes, output)
+		}
+
+		decoded, err := UnmarshalCall(bytes.NewReader(tt.expectedBytes))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(tt.value, decoded) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.value, decoded)
+		}
+	}
+
+	events := []struct {
+		value         Event
+		expectedBytes []byte
+	}{
+		{
+			value:         NewEventNone(),
+			expectedBytes: []byte{EventNoneIndex},
+		},
+		{
+			value:         &EventTransfer{Inner: transfer},
+			expectedBytes: []byte{EventTransferIndex, 1, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			value: &EventFailed{
+				Call: NewCallNone(),
+				Code: &scale_codec.Integer[uint8]{Value: 3},
+			},
+			expectedBytes: []byte{EventFailedIndex, CallNoneIndex, 3},
+		},
+	}
+
+	for _, tt := range events {
+		output, err := tt.value.MarshalSCALE()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !bytes.Equal(tt.expectedBytes, output) {
+			t.Fatalf("\nexpected: %v\nactual: %v", tt.expectedBytes, output)
+		}
+
+		decoded, err := UnmarshalEvent(bytes.NewReader(tt.expectedBytes))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(tt.value, decoded) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.value, decoded)
+		}
+	}
+}
+
+// eventAmounts sums the transferred amounts of the visited events
+type eventAmounts struct {
+	total  uint64
+	failed int
+}
+
+func (e *eventAmounts) VisitNone(*EventNone) error {
+	return nil
+}
+
+func (e *eventAmounts) VisitTransfer(transfer *EventTransfer) error {
+	e.total += transfer.Inner.Amount.Value
+	return nil
+}
+
+func (e *eventAmounts) VisitFailed(failed *EventFailed) error {
+	e.failed++
+	return nil
+}
+
+func TestVisitAndMatch(t *testing.T) {
+	events := []Event{
+		NewEventNone(),
+		&EventTransfer{Inner: &Transfer{To: &scale_codec.Integer[uint32]{Value: 1}, Amount: &scale_codec.Integer[uint64]{Value: 2}}},
+		&EventTransfer{Inner: &Transfer{To: &scale_codec.Integer[uint32]{Value: 1}, Amount: &scale_codec.Integer[uint64]{Value: 3}}},
+		&EventFailed{Call: NewCallNone(), Code: &scale_codec.Integer[uint8]{Value: 1}},
+	}
+
+	visitor := new(eventAmounts)
+	for _, event := range events {
+		if err := VisitEvent(event, visitor); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if visitor.total != 5 || visitor.failed != 1 {
+		t.Fatalf("\nexpected: total 5 and 1 failure\ngot: total %d and %d failures", visitor.total, visitor.failed)
+	}
+
+	cases := NewCallCases(
+		func(*CallNone) string { return "none" },
+		func(*CallTransfer) string { return "transfer" },
+		func(call *CallBool) string {
+			if call.Inner.Value {
+				return "true"
+			}
+			return "false"
+		},
+	)
+
+	calls := map[string]Call{
+		"none":  NewCallNone(),
+		"true":  &CallBool{Inner: &scale_codec.Bool{Value: true}},
+		"false": &CallBool{Inner: &scale_codec.Bool{Value: false}},
+	}
+
+	for expected, call := range calls {
+		if got := MatchCall(call, cases); got != expected {
+			t.Fatalf("\nexpected: %v\ngot: %v", expected, got)
+		}
+	}
+
+	// a nil case only panics when its variant is matched
+	onlyNone := NewCallCases(func(*CallNone) string { return "none" }, nil, nil)
+	if got := MatchCall(NewCallNone(), onlyNone); got != "none" {
+		t.Fatalf("\nexpected: none\ngot: %v", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected MatchCall to panic on a missing case")
+		}
+	}()
+
+	MatchCall(Call(&CallBool{Inner: &scale_codec.Bool{Value: true}}), onlyNone)
+}
@@ -0,0 +1,269 @@
+//! Golden vectors shared with the Go tests, each vector is a type, a value
+//! in the JSON form read by the Go codecs and its parity-scale-codec
+//! encoding. `UPDATE_GOLDEN=1 cargo test -p rust-scale-codec golden` rewrites
+//! tests/golden/vectors.json, a plain `cargo test` fails when it is stale
+
+use std::collections::{BTreeMap, BTreeSet};
+use std::path::PathBuf;
+
+use parity_scale_codec::{Compact, Encode};
+
+use super::simple_enum::{Error, MyScaleEncodedEnum, Nested, Pallet, Status};
+
+/// VERSION is bumped whenever the layout of the file changes
+const VERSION: u32 = 1;
+
+struct Vector {
+    type_expr: &'static str,
+    value: &'static str,
+    encoded: Vec<u8>,
+}
+
+fn vector<T: Encode>(type_expr: &'static str, value: &'static str, v: T) -> Vector {
+    Vector {
+        type_expr,
+        value,
+        encoded: v.encode(),
+    }
+}
+
+fn vectors() -> Vec<Vector> {
+    vec![
+        vector("u8", r#"0"#, 0u8),
+        vector("u8", r#"255"#, u8::MAX),
+        vector("u16", r#"65535"#, u16::MAX),
+        vector("u32", r#"4294967295"#, u32::MAX),
+        vector("u64", r#"9007199254740991"#, 9_007_199_254_740_991u64),
+        vector("u64", r#""0xffffffffffffffff""#, u64::MAX),
+        vector("u128", r#""0xffffffffffffffffffffffffffffffff""#, u128::MAX),
+        vector("u128", r#""18446744073709551616""#, u64::MAX as u128 + 1),
+        vector("i8", r#"-128"#, i8::MIN),
+        vector("i8", r#"127"#, i8::MAX),
+        vector("i16", r#"-32768"#, i16::MIN),
+        vector("i32", r#"-2147483648"#, i32::MIN),
+        vector("i64", r#"-10"#, -10i64),
+        vector("i64", r#""0x8000000000000000""#, i64::MIN),
+        vector("i128", r#"-1"#, -1i128),
+        vector("i128", r#""0x80000000000000000000000000000000""#, i128::MIN),
+        vector("bool", r#"true"#, true),
+        vector("bool", r#"false"#, false),
+        vector("Compact<u32>", r#"0"#, Compact(0u32)),
+        vector("Compact<u32>", r#"1"#, Compact(1u32)),
+        vector("Compact<u32>", r#"63"#, Compact(63u32)),
+        vector("Compact<u32>", r#"64"#, Compact(64u32)),
+        vector("Compact<u32>", r#"16383"#, Compact(16_383u32)),
+        vector("Compact<u32>", r#"16384"#, Compact(16_384u32)),
+        vector("Compact<u32>", r#"1073741823"#, Compact(1_073_741_823u32)),
+        vector("Compact<u32>", r#"1073741824"#, Compact(1_073_741_824u32)),
+        vector("Compact<u32>", r#"4294967295"#, Compact(u32::MAX)),
+        vector("Compact<u64>", r#"4294967296"#, Compact(1u64 << 32)),
+        vector("Compact<u64>", r#""0xffffffffffffffff""#, Compact(u64::MAX)),
+        vector(
+            "Compact<u128>",
+            r#""0xffffffffffffffffffffffffffffffff""#,
+            Compact(u128::MAX),
+        ),
+        vector("String", r#""""#, String::new()),
+        vector("String", r#""eclesio""#, String::from("eclesio")),
+        vector("Vec<u8>", r#""0x01020304""#, vec![1u8, 2, 3, 4]),
+        vector("Vec<u16>", r#"[1, 2, 65535]"#, vec![1u16, 2, u16::MAX]),
+        vector("[u8; 4]", r#""0xdeadbeef""#, [0xdeu8, 0xad, 0xbe, 0xef]),
+        vector("Option<bool>", r#"null"#, None::<bool>),
+        vector("Option<bool>", r#"true"#, Some(true)),
+        vector("Option<bool>", r#"false"#, Some(false)),
+        vector("Option<u32>", r#"null"#, None::<u32>),
+        vector("Option<u32>", r#"7"#, Some(7u32)),
+        vector(
+            "Option<String>",
+            r#""eclesio""#,
+            Some(String::from("eclesio")),
+        ),
+        vector(
+            "Result<u64, bool>",
+            r#"{"ok": 32290}"#,
+            Ok::<u64, bool>(32290),
+        ),
+        vector(
+            "Result<u64, bool>",
+            r#"{"err": false}"#,
+            Err::<u64, bool>(false),
+        ),
+        vector(
+            "Result<Result<u64, u8>, bool>",
+            r#"{"ok": {"err": 10}}"#,
+            Ok::<Result<u64, u8>, bool>(Err(10)),
+        ),
+        vector(
+            "(Option<u64>, Result<bool, u64>)",
+            r#"[79, {"ok": true}]"#,
+            (Some(79u64), Ok::<bool, u64>(true)),
+        ),
+        vector(
+            "(Option<u64>, Result<bool, u64>)",
+            r#"[null, {"err": 44}]"#,
+            (None::<u64>, Err::<bool, u64>(44)),
+        ),
+        vector("(u8, bool, u16)", r#"[1, true, 2]"#, (1u8, true, 2u16)),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"Single": null}"#,
+            MyScaleEncodedEnum::Single,
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"Int": 32}"#,
+            MyScaleEncodedEnum::Int(32),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"Bool": true}"#,
+            MyScaleEncodedEnum::Bool(true),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"A": null}"#,
+            MyScaleEncodedEnum::A(None),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"A": true}"#,
+            MyScaleEncodedEnum::A(Some(true)),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"B": {"err": 90}}"#,
+            MyScaleEncodedEnum::B(Err(90)),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"G": [60, false]}"#,
+            MyScaleEncodedEnum::G((60, false)),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"H": [60, false]}"#,
+            MyScaleEncodedEnum::H(Some((60, false))),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"J": {"ok": [60, false]}}"#,
+            MyScaleEncodedEnum::J(Ok((60, false))),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"K": [true, {"ok": false}]}"#,
+            MyScaleEncodedEnum::K((Some(true), Ok(false))),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"L": {"ok": null}}"#,
+            MyScaleEncodedEnum::L(Ok(None)),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"M": {"Number": 10}}"#,
+            MyScaleEncodedEnum::M(Some(Nested::Number(10))),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"N": {"err": true}}"#,
+            MyScaleEncodedEnum::N(Err(true)),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"O": {"err": {"Number": 76}}}"#,
+            MyScaleEncodedEnum::O(Err(Nested::Number(76))),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"P": {"ok": {"Number": 4294967295}}}"#,
+            MyScaleEncodedEnum::P(Ok(Nested::Number(u32::MAX))),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"P": {"err": "FailureX"}}"#,
+            MyScaleEncodedEnum::P(Err(Error::FailureX)),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"Q": [{"Number": 77}, 89, "FailureX"]}"#,
+            MyScaleEncodedEnum::Q((Nested::Number(77), 89, Error::FailureX)),
+        ),
+        vector(
+            "MyScaleEncodedEnum",
+            r#"{"R": [{"ok": 12}, null, "FailureX"]}"#,
+            MyScaleEncodedEnum::R((Ok(12), None, Error::FailureX)),
+        ),
+        vector("Pallet", r#"{"Remark": null}"#, Pallet::Remark),
+        vector("Pallet", r#"{"Transfer": 9}"#, Pallet::Transfer(9)),
+        vector("Pallet", r#"{"Burn": null}"#, Pallet::Burn),
+        vector("Status", r#""Active""#, Status::Active),
+        vector("Status", r#""Frozen""#, Status::Frozen),
+        vector("Status", r#""Closed""#, Status::Closed),
+        vector(
+            "BTreeMap<u16, bool>",
+            r#"{"256": false, "2": false, "1": true}"#,
+            BTreeMap::from([(256u16, false), (2, false), (1, true)]),
+        ),
+        vector("BTreeSet<u8>", r#"[3, 1, 3]"#, BTreeSet::from([3u8, 1, 3])),
+        vector(
+            "BTreeSet<Status>",
+            r#"["Closed", "Frozen", "Active"]"#,
+            BTreeSet::from([Status::Closed, Status::Frozen, Status::Active]),
+        ),
+        vector(
+            "BTreeSet<Pallet>",
+            r#"[{"Burn": null}, {"Transfer": 9}, {"Remark": null}]"#,
+            BTreeSet::from([Pallet::Burn, Pallet::Transfer(9), Pallet::Remark]),
+        ),
+    ]
+}
+
+fn render(vectors: &[Vector]) -> String {
+    let lines: Vec<String> = vectors
+        .iter()
+        .map(|v| {
+            let hex: String = v.encoded.iter().map(|b| format!("{:02x}", b)).collect();
+            format!(
+                "    {{\"type\": \"{}\", \"value\": {}, \"hex\": \"0x{}\"}}",
+                v.type_expr, v.value, hex
+            )
+        })
+        .collect();
+
+    format!(
+        "{{\n  \"version\": {},\n  \"vectors\": [\n{}\n  ]\n}}\n",
+        VERSION,
+        lines.join(",\n")
+    )
+}
+
+/// golden_path finds the repository root, the directory holding go.mod,
+/// from the crate being built which is either the root or this crate
+fn golden_path() -> PathBuf {
+    let manifest = PathBuf::from(env!("CARGO_MANIFEST_DIR"));
+    let root = manifest
+        .ancestors()
+        .find(|dir| dir.join("go.mod").exists())
+        .expect("go.mod not found above the crate");
+
+    root.join("tests").join("golden").join("vectors.json")
+}
+
+#[test]
+fn golden_vectors() {
+    let rendered = render(&vectors());
+    let path = golden_path();
+
+    if std::env::var_os("UPDATE_GOLDEN").is_some() {
+        std::fs::write(&path, rendered).unwrap();
+        return;
+    }
+
+    let current = std::fs::read_to_string(&path).unwrap_or_default();
+    assert!(
+        current == rendered,
+        "{} is stale, run `UPDATE_GOLDEN=1 cargo test golden` to regenerate it",
+        path.display()
+    );
+}
@@ -1,4 +1,10 @@
 use parity_scale_codec::{self, Error};
+
+mod simple_enum;
+
+#[cfg(test)]
+mod golden;
+
 fn main() {}
 
 #[cfg(test)]
@@ -8,40 +14,14 @@ mod tests {
     use super::parity_scale_codec::Encode;
     use super::parity_scale_codec::Encode as DervieEncode;
 
-    #[derive(DervieEncode, Decode, Debug)]
-    enum Nested {
-        SimpleN(u32),
-    }
-
-    #[derive(DervieEncode, Decode, Debug)]
-    enum Error {
-        FailureX,
-    }
+    // the schema types are generated from tests/enums/simple_enum.scale
+    use super::simple_enum::{Error, MyScaleEncodedEnum as ToTest, Nested};
 
     #[derive(DervieEncode, Decode, Debug)]
     enum EnumA {
         A(bool),
     }
 
-    #[derive(DervieEncode, Decode, Debug)]
-    enum ToTest {
-        Single,
-        Int(u64),
-        Bool(bool),
-        A(Option<bool>),
-        B(Result<u64, u64>),
-        G((u64, bool)),
-        H(Option<(u64, bool)>),
-        J(Result<(u64, bool), bool>),
-        K((Option<bool>, Result<bool, bool>)),
-        L(Result<Option<(u64, bool)>, u64>),
-        M(Option<Nested>),
-        N(Result<Nested, bool>),
-        O(Result<bool, Nested>),
-        P(Result<Nested, Error>),
-        Q((Nested, u64, Error)),
-    }
-
     #[test]
     fn scale_encoded_u128() {
         let value: u128 = u64::MAX as u128;
@@ -129,10 +109,10 @@ mod tests {
         let a: ToTest = ToTest::K((Some(true), Ok(false)));
         println!("{:?}", a.encode());
 
-        let a: ToTest = ToTest::M(Some(Nested::SimpleN(10)));
+        let a: ToTest = ToTest::M(Some(Nested::Number(10)));
         println!("{:?}", a.encode());
 
-        let a: ToTest = ToTest::N(Ok(Nested::SimpleN(78)));
+        let a: ToTest = ToTest::N(Ok(Nested::Number(78)));
         println!("{:?}", a.encode());
 
         let a: ToTest = ToTest::N(Err(true));
@@ -141,16 +121,19 @@ mod tests {
         let a: ToTest = ToTest::O(Ok(true));
         println!("{:?}", a.encode());
 
-        let a: ToTest = ToTest::O(Err(Nested::SimpleN(76)));
+        let a: ToTest = ToTest::O(Err(Nested::Number(76)));
         println!("{:?}", a.encode());
 
-        let a: ToTest = ToTest::P(Ok(Nested::SimpleN(u32::MAX)));
+        let a: ToTest = ToTest::P(Ok(Nested::Number(u32::MAX)));
         println!("{:?}", a.encode());
 
         let a: ToTest = ToTest::P(Err(Error::FailureX));
         println!("{:?}", a.encode());
 
-        let a: ToTest = ToTest::Q((Nested::SimpleN(77), 89, Error::FailureX));
+        let a: ToTest = ToTest::Q((Nested::Number(77), 89, Error::FailureX));
+        println!("{:?}", a.encode());
+
+        let a: ToTest = ToTest::R((Ok(12), None, Error::FailureX));
         println!("{:?}", a.encode());
 
         let binding = vec![1, 32, 0, 0, 0, 0, 0, 0, 0];
@@ -0,0 +1,1061 @@
+// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+type T2[A scale_codec.Marshaler,B scale_codec.Marshaler] struct {
+	F0 A
+	F1 B
+}
+
+func (t *T2[A,B]) MarshalSCALE() (output []byte, err error) {
+	output = make([]byte, 0)
+	var enc []byte
+	
+	enc, err = t.F0.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = t.F1.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (t *T2[A,B]) UnmarshalSCALE(reader io.Reader, funcA func (io.Reader) (A, error),funcB func (io.Reader) (B, error)) (err error) {
+	
+	t.F0, err =  funcA(reader)
+	if err != nil {
+		return err
+	}
+	
+	t.F1, err =  funcB(reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalT2FromRawBytes[A scale_codec.Marshaler,B scale_codec.Marshaler](
+	funcA func (io.Reader) (A, error),funcB func (io.Reader) (B, error)) func(io.Reader) (*T2[A,B], error) {
+	return func(reader io.Reader) (*T2[A,B], error) {
+		tuple := new(T2[A,B])
+		err := tuple.UnmarshalSCALE(reader,
+			funcA,
+			funcB,)
+		
+		if err != nil {
+			return nil, err
+		}
+		return tuple, nil
+	}
+}
+
+func (t *T2[A,B]) String() string {
+	return scale_codec.DebugTuple("", t.F0, t.F1)
+}
+
+func (t *T2[A,B]) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONTuple(t.F0, t.F1)
+}
+
+func (t *T2[A,B]) UnmarshalJSONWith(data []byte, jsonA func([]byte) (A, error),jsonB func([]byte) (B, error)) error {
+	items, err := scale_codec.UnmarshalJSONTuple(data, 2)
+	if err != nil {
+		return err
+	}
+	
+	t.F0, err = jsonA(items[0])
+	if err != nil {
+		return err
+	}
+	
+	t.F1, err = jsonB(items[1])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalT2FromJSON[A scale_codec.Marshaler,B scale_codec.Marshaler](
+	jsonA func([]byte) (A, error),jsonB func([]byte) (B, error)) func([]byte) (*T2[A,B], error) {
+	return func(data []byte) (*T2[A,B], error) {
+		tuple := new(T2[A,B])
+		err := tuple.UnmarshalJSONWith(data, jsonA, jsonB)
+		if err != nil {
+			return nil, err
+		}
+		return tuple, nil
+	}
+}
+
+
+// Call is a call dispatched by the runtime
+//
+// Calls are encoded with the index of their variant
+type Call interface {
+	scale_codec.Encodable
+	IsCall()
+}
+
+func UnmarshalCall(reader io.Reader) (Call, error) {
+	enumTag := make([]byte, 1)
+	n, err := reader.Read(enumTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("%w: got %v", scale_codec.ErrExpectedOneByteRead, n)
+	}
+
+	switch enumTag[0] {
+	
+	case RemarkIndex:
+		unmarshaler := NewRemark()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case SendIndex:
+		unmarshaler := NewSend()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case BatchIndex:
+		unmarshaler := NewBatch()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case GuardedIndex:
+		unmarshaler := NewGuarded()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case PairIndex:
+		unmarshaler := NewPair()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	case MoveIndex:
+		unmarshaler := NewMove()
+		err := unmarshaler.UnmarshalSCALE(reader)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, err
+	
+	default:
+		return nil, fmt.Errorf("unexpected enum tag: %v", enumTag[0])
+	}
+}
+
+func UnmarshalCallJSON(data []byte) (Call, error) {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	
+	case "Remark":
+		unmarshaler := NewRemark()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Send":
+		unmarshaler := NewSend()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Batch":
+		unmarshaler := NewBatch()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Guarded":
+		unmarshaler := NewGuarded()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Pair":
+		unmarshaler := NewPair()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	case "Move":
+		unmarshaler := NewMove()
+		err := unmarshaler.UnmarshalJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshaler, nil
+	
+	default:
+		return nil, fmt.Errorf("%w: unexpected Call variant: %s", scale_codec.ErrInvalidJSON, name)
+	}
+}
+
+// CallVisitor has a method per Call variant, a visitor given
+// to VisitCall stops compiling when a variant is added
+type CallVisitor interface {
+	VisitRemark(*Remark) error
+	VisitSend(*Send) error
+	VisitBatch(*Batch) error
+	VisitGuarded(*Guarded) error
+	VisitPair(*Pair) error
+	VisitMove(*Move) error
+}
+
+// VisitCall calls the visitor method of the variant of value
+func VisitCall(value Call, visitor CallVisitor) error {
+	switch variant := value.(type) {
+	case *Remark:
+		return visitor.VisitRemark(variant)
+	case *Send:
+		return visitor.VisitSend(variant)
+	case *Batch:
+		return visitor.VisitBatch(variant)
+	case *Guarded:
+		return visitor.VisitGuarded(variant)
+	case *Pair:
+		return visitor.VisitPair(variant)
+	case *Move:
+		return visitor.VisitMove(variant)
+	default:
+		return fmt.Errorf("unexpected Call variant: %T", value)
+	}
+}
+
+// CallCases holds a func per Call variant for MatchCall,
+// it is built by NewCallCases so a variant added breaks the build
+type CallCases[R any] struct {
+	onRemark func(*Remark) R
+	onSend func(*Send) R
+	onBatch func(*Batch) R
+	onGuarded func(*Guarded) R
+	onPair func(*Pair) R
+	onMove func(*Move) R
+}
+
+// NewCallCases returns the cases of MatchCall, taking
+// a func per Call variant in declaration order
+func NewCallCases[R any](onRemark func(*Remark) R, onSend func(*Send) R, onBatch func(*Batch) R, onGuarded func(*Guarded) R, onPair func(*Pair) R, onMove func(*Move) R) CallCases[R] {
+	return CallCases[R]{
+		onRemark: onRemark,
+		onSend: onSend,
+		onBatch: onBatch,
+		onGuarded: onGuarded,
+		onPair: onPair,
+		onMove: onMove,
+	}
+}
+
+// MatchCall returns the result of the case of the variant of value,
+// it panics if that case is nil
+func MatchCall[R any](value Call, cases CallCases[R]) R {
+	switch variant := value.(type) {
+	case *Remark:
+		if cases.onRemark == nil {
+			panic("MatchCall: missing case for Remark")
+		}
+		return cases.onRemark(variant)
+	case *Send:
+		if cases.onSend == nil {
+			panic("MatchCall: missing case for Send")
+		}
+		return cases.onSend(variant)
+	case *Batch:
+		if cases.onBatch == nil {
+			panic("MatchCall: missing case for Batch")
+		}
+		return cases.onBatch(variant)
+	case *Guarded:
+		if cases.onGuarded == nil {
+			panic("MatchCall: missing case for Guarded")
+		}
+		return cases.onGuarded(variant)
+	case *Pair:
+		if cases.onPair == nil {
+			panic("MatchCall: missing case for Pair")
+		}
+		return cases.onPair(variant)
+	case *Move:
+		if cases.onMove == nil {
+			panic("MatchCall: missing case for Move")
+		}
+		return cases.onMove(variant)
+	default:
+		panic(fmt.Sprintf("MatchCall: unexpected variant %T", value))
+	}
+}
+
+
+var RemarkIndex byte = 0
+
+var _ Call = (*Remark)(nil)
+
+// Remark does nothing
+type Remark struct {
+	Inner *scale_codec.SimpleVariant
+}
+
+func NewRemark() *Remark {
+	return &Remark{
+		Inner: new(scale_codec.SimpleVariant),
+	}
+}
+
+func (Remark) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Remark) VariantPosition() int {
+	return 0
+}
+
+func (i Remark) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := RemarkIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Remark) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i Remark) String() string {
+	return "Remark"
+}
+
+func (i Remark) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Remark", nil)
+}
+
+func (i *Remark) UnmarshalJSON(data []byte) error {
+	name, _, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Remark" {
+		return fmt.Errorf("%w: expected Remark variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+	return nil
+}
+var SendIndex byte = 1
+
+var _ Call = (*Send)(nil)
+
+type Send struct {
+	Inner *Transfer
+}
+
+func NewSend() *Send {
+	return &Send{
+		Inner: new(Transfer),
+	}
+}
+
+func (Send) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Send) VariantPosition() int {
+	return 1
+}
+
+func (i Send) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := SendIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Send) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader)
+}
+
+func (i Send) String() string {
+	return scale_codec.DebugTuple("Send", i.Inner)
+}
+
+func (i Send) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Send", i.Inner)
+}
+
+func (i *Send) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Send" {
+		return fmt.Errorf("%w: expected Send variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.FromJSON[Transfer](value)
+	return err
+}
+var BatchIndex byte = 2
+
+var _ Call = (*Batch)(nil)
+
+type Batch struct {
+	Inner *T2[*Transfer,*scale_codec.OptionG[*AccountId]]
+}
+
+func NewBatch() *Batch {
+	return &Batch{
+		Inner: new(T2[*Transfer,*scale_codec.OptionG[*AccountId]]),
+	}
+}
+
+func (Batch) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Batch) VariantPosition() int {
+	return 2
+}
+
+func (i Batch) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := BatchIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Batch) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader,UnmarshalTransfer,scale_codec.UnmarshalOptionFromRawBytes[*AccountId](UnmarshalAccountId))
+}
+
+func (i Batch) String() string {
+	return scale_codec.DebugTuple("Batch", i.Inner)
+}
+
+func (i Batch) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Batch", i.Inner)
+}
+
+func (i *Batch) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Batch" {
+		return fmt.Errorf("%w: expected Batch variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = UnmarshalT2FromJSON[*Transfer,*scale_codec.OptionG[*AccountId]](scale_codec.FromJSON[Transfer],scale_codec.UnmarshalOptionFromJSON[*AccountId](scale_codec.FromJSON[AccountId]))(value)
+	return err
+}
+
+// NewBatchFrom builds a Batch variant from Go values
+func NewBatchFrom(a *Transfer, b *scale_codec.OptionG[*AccountId]) *Batch {
+	return &Batch{
+		Inner: &T2[*Transfer,*scale_codec.OptionG[*AccountId]]{F0: a, F1: b},
+	}
+}
+
+func (variant Batch) Get() (*Transfer, *scale_codec.OptionG[*AccountId]) {
+	return variant.Inner.F0, variant.Inner.F1
+}
+var GuardedIndex byte = 3
+
+var _ Call = (*Guarded)(nil)
+
+type Guarded struct {
+	Inner *scale_codec.ResultG[*Empty,Call]
+}
+
+func NewGuarded() *Guarded {
+	return &Guarded{
+		Inner: new(scale_codec.ResultG[*Empty,Call]),
+	}
+}
+
+func (Guarded) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Guarded) VariantPosition() int {
+	return 3
+}
+
+func (i Guarded) MarshalSCALE() ([]byte, error) {
+	innerEncode, err := i.Inner.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := GuardedIndex
+	return bytes.Join([][]byte{[]byte{idx}, innerEncode}, nil), nil
+}
+
+func (i *Guarded) UnmarshalSCALE(reader io.Reader) error {
+	return i.Inner.UnmarshalSCALE(reader, UnmarshalEmpty, UnmarshalCall)
+}
+
+func (i Guarded) String() string {
+	return scale_codec.DebugTuple("Guarded", i.Inner)
+}
+
+func (i Guarded) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONVariant("Guarded", i.Inner)
+}
+
+func (i *Guarded) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Guarded" {
+		return fmt.Errorf("%w: expected Guarded variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	i.Inner, err = scale_codec.UnmarshalResultFromJSON[*Empty,Call](scale_codec.FromJSON[Empty],UnmarshalCallJSON)(value)
+	return err
+}
+
+// NewGuardedOk builds a Guarded variant from Go values
+func NewGuardedOk(v *Empty) *Guarded {
+	return &Guarded{
+		Inner: scale_codec.OkG[*Empty, Call](v),
+	}
+}
+
+func (variant Guarded) Ok() (v *Empty, ok bool) {
+	inner, ok := variant.Inner.Ok()
+	if !ok {
+		return v, false
+	}
+	return inner, true
+}
+
+// NewGuardedErr builds a Guarded variant from Go values
+func NewGuardedErr(v Call) *Guarded {
+	return &Guarded{
+		Inner: scale_codec.ErrG[*Empty, Call](v),
+	}
+}
+
+func (variant Guarded) Err() (v Call, ok bool) {
+	inner, ok := variant.Inner.Err()
+	if !ok {
+		return v, false
+	}
+	return inner, true
+}
+var PairIndex byte = 4
+
+var _ Call = (*Pair)(nil)
+
+// Pair carries two unnamed fields
+type Pair struct {
+	F0 *scale_codec.Integer[uint32]
+	F1 *scale_codec.Bool
+}
+
+func NewPair() *Pair {
+	return &Pair{
+		F0: new(scale_codec.Integer[uint32]),
+		F1: new(scale_codec.Bool),
+	}
+}
+
+func (Pair) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Pair) VariantPosition() int {
+	return 4
+}
+
+func (i Pair) MarshalSCALE() (output []byte, err error) {
+	output = []byte{PairIndex}
+	var enc []byte
+	
+	enc, err = i.F0.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = i.F1.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (i *Pair) UnmarshalSCALE(reader io.Reader) (err error) {
+	
+	i.F0, err = scale_codec.IntegerFromRawBytes[uint32](reader)
+	if err != nil {
+		return err
+	}
+	
+	i.F1, err = scale_codec.BoolFromRawBytes(reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (i Pair) String() string {
+	return scale_codec.DebugTuple("Pair", i.F0, i.F1)
+}
+
+func (i Pair) MarshalJSON() ([]byte, error) {
+	value, err := scale_codec.MarshalJSONTuple(i.F0, i.F1)
+	if err != nil {
+		return nil, err
+	}
+	return scale_codec.MarshalJSONVariant("Pair", json.RawMessage(value))
+}
+
+func (i *Pair) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Pair" {
+		return fmt.Errorf("%w: expected Pair variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	items, err := scale_codec.UnmarshalJSONTuple(value, 2)
+	if err != nil {
+		return err
+	}
+	
+	i.F0, err = scale_codec.FromJSON[scale_codec.Integer[uint32]](items[0])
+	if err != nil {
+		return err
+	}
+	
+	i.F1, err = scale_codec.FromJSON[scale_codec.Bool](items[1])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+// NewPairFrom builds a Pair variant from Go values
+func NewPairFrom(a uint32, b bool) *Pair {
+	return &Pair{
+		F0: &scale_codec.Integer[uint32]{Value: a},
+		F1: &scale_codec.Bool{Value: b},
+	}
+}
+var MoveIndex byte = 5
+
+var _ Call = (*Move)(nil)
+
+type Move struct {
+	To *AccountId
+	// amount moved to to
+	Amount *scale_codec.Integer[uint64]
+	Reason *scale_codec.OptionG[Call]
+}
+
+func NewMove() *Move {
+	return &Move{
+		To: new(AccountId),
+		Amount: new(scale_codec.Integer[uint64]),
+		Reason: new(scale_codec.OptionG[Call]),
+	}
+}
+
+func (Move) IsCall() {}
+
+// VariantPosition orders the Call variants by declaration, as Rust's Ord
+func (Move) VariantPosition() int {
+	return 5
+}
+
+func (i Move) MarshalSCALE() (output []byte, err error) {
+	output = []byte{MoveIndex}
+	var enc []byte
+	
+	enc, err = i.To.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = i.Amount.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = i.Reason.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (i *Move) UnmarshalSCALE(reader io.Reader) (err error) {
+	
+	i.To, err = UnmarshalAccountId(reader)
+	if err != nil {
+		return err
+	}
+	
+	i.Amount, err = scale_codec.IntegerFromRawBytes[uint64](reader)
+	if err != nil {
+		return err
+	}
+	
+	i.Reason, err = scale_codec.UnmarshalOptionFromRawBytes[Call](UnmarshalCall)(reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (i Move) String() string {
+	return scale_codec.DebugStruct("Move", []string{"to", "amount", "reason"}, i.To, i.Amount, i.Reason)
+}
+
+func (i Move) MarshalJSON() ([]byte, error) {
+	value, err := scale_codec.MarshalJSONObject([]string{"to", "amount", "reason"}, i.To, i.Amount, i.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return scale_codec.MarshalJSONVariant("Move", json.RawMessage(value))
+}
+
+func (i *Move) UnmarshalJSON(data []byte) error {
+	name, value, err := scale_codec.UnmarshalJSONVariant(data)
+	if err != nil {
+		return err
+	}
+
+	if name != "Move" {
+		return fmt.Errorf("%w: expected Move variant, got %s", scale_codec.ErrInvalidJSON, name)
+	}
+
+	items, err := scale_codec.UnmarshalJSONObject(value, []string{"to", "amount", "reason"})
+	if err != nil {
+		return err
+	}
+	
+	i.To, err = scale_codec.FromJSON[AccountId](items[0])
+	if err != nil {
+		return err
+	}
+	
+	i.Amount, err = scale_codec.FromJSON[scale_codec.Integer[uint64]](items[1])
+	if err != nil {
+		return err
+	}
+	
+	i.Reason, err = scale_codec.UnmarshalOptionFromJSON[Call](UnmarshalCallJSON)(items[2])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+// NewMoveFrom builds a Move variant from Go values
+func NewMoveFrom(to *AccountId, amount uint64, reason *scale_codec.OptionG[Call]) *Move {
+	return &Move{
+		To: to,
+		Amount: &scale_codec.Integer[uint64]{Value: amount},
+		Reason: reason,
+	}
+}
+
+
+var _ scale_codec.Encodable = (*AccountId)(nil)
+
+// AccountId identifies an account by its shard and index
+type AccountId struct {
+	F0 *scale_codec.Integer[uint32]
+	F1 *scale_codec.Integer[uint32]
+}
+
+func NewAccountId() *AccountId {
+	return &AccountId{
+		F0: new(scale_codec.Integer[uint32]),
+		F1: new(scale_codec.Integer[uint32]),
+	}
+}
+
+func (s AccountId) MarshalSCALE() (output []byte, err error) {
+	output = make([]byte, 0)
+	var enc []byte
+	
+	enc, err = s.F0.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.F1.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (s *AccountId) UnmarshalSCALE(reader io.Reader) (err error) {
+	
+	s.F0, err = scale_codec.IntegerFromRawBytes[uint32](reader)
+	if err != nil {
+		return err
+	}
+	
+	s.F1, err = scale_codec.IntegerFromRawBytes[uint32](reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (s AccountId) String() string {
+	return scale_codec.DebugTuple("AccountId", s.F0, s.F1)
+}
+
+func (s AccountId) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONTuple(s.F0, s.F1)
+}
+
+func (s *AccountId) UnmarshalJSON(data []byte) error {
+	items, err := scale_codec.UnmarshalJSONTuple(data, 2)
+	if err != nil {
+		return err
+	}
+	
+	s.F0, err = scale_codec.FromJSON[scale_codec.Integer[uint32]](items[0])
+	if err != nil {
+		return err
+	}
+	
+	s.F1, err = scale_codec.FromJSON[scale_codec.Integer[uint32]](items[1])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalAccountId(reader io.Reader) (*AccountId, error) {
+	s := new(AccountId)
+	if err := s.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+var _ scale_codec.Encodable = (*Transfer)(nil)
+
+// Transfer moves value to dest
+type Transfer struct {
+	Dest *AccountId
+	// value is in the smallest unit
+	Value *scale_codec.Integer[uint64]
+	Memo *scale_codec.OptionG[*T2[*scale_codec.Integer[uint8],*scale_codec.Bool]]
+}
+
+func NewTransfer() *Transfer {
+	return &Transfer{
+		Dest: new(AccountId),
+		Value: new(scale_codec.Integer[uint64]),
+		Memo: new(scale_codec.OptionG[*T2[*scale_codec.Integer[uint8],*scale_codec.Bool]]),
+	}
+}
+
+func (s Transfer) MarshalSCALE() (output []byte, err error) {
+	output = make([]byte, 0)
+	var enc []byte
+	
+	enc, err = s.Dest.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.Value.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	enc, err = s.Memo.MarshalSCALE()
+	if err != nil {
+		return nil, err
+	}
+	output = append(output, enc...)
+	
+	return output, nil
+}
+
+func (s *Transfer) UnmarshalSCALE(reader io.Reader) (err error) {
+	
+	s.Dest, err = UnmarshalAccountId(reader)
+	if err != nil {
+		return err
+	}
+	
+	s.Value, err = scale_codec.IntegerFromRawBytes[uint64](reader)
+	if err != nil {
+		return err
+	}
+	
+	s.Memo, err = scale_codec.UnmarshalOptionFromRawBytes[*T2[*scale_codec.Integer[uint8],*scale_codec.Bool]](UnmarshalT2FromRawBytes[*scale_codec.Integer[uint8],*scale_codec.Bool](scale_codec.IntegerFromRawBytes[uint8],scale_codec.BoolFromRawBytes))(reader)
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (s Transfer) String() string {
+	return scale_codec.DebugStruct("Transfer", []string{"dest", "value", "memo"}, s.Dest, s.Value, s.Memo)
+}
+
+func (s Transfer) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONObject([]string{"dest", "value", "memo"}, s.Dest, s.Value, s.Memo)
+}
+
+func (s *Transfer) UnmarshalJSON(data []byte) error {
+	items, err := scale_codec.UnmarshalJSONObject(data, []string{"dest", "value", "memo"})
+	if err != nil {
+		return err
+	}
+	
+	s.Dest, err = scale_codec.FromJSON[AccountId](items[0])
+	if err != nil {
+		return err
+	}
+	
+	s.Value, err = scale_codec.FromJSON[scale_codec.Integer[uint64]](items[1])
+	if err != nil {
+		return err
+	}
+	
+	s.Memo, err = scale_codec.UnmarshalOptionFromJSON[*T2[*scale_codec.Integer[uint8],*scale_codec.Bool]](UnmarshalT2FromJSON[*scale_codec.Integer[uint8],*scale_codec.Bool](scale_codec.FromJSON[scale_codec.Integer[uint8]],scale_codec.FromJSON[scale_codec.Bool]))(items[2])
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalTransfer(reader io.Reader) (*Transfer, error) {
+	s := new(Transfer)
+	if err := s.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+var _ scale_codec.Encodable = (*Empty)(nil)
+
+type Empty struct {
+}
+
+func NewEmpty() *Empty {
+	return &Empty{
+	}
+}
+
+func (s Empty) MarshalSCALE() (output []byte, err error) {
+	output = make([]byte, 0)
+	
+	return output, nil
+}
+
+func (s *Empty) UnmarshalSCALE(reader io.Reader) (err error) {
+	
+	return nil
+}
+
+func (s Empty) String() string {
+	return scale_codec.DebugStruct("Empty", []string{})
+}
+
+func (s Empty) MarshalJSON() ([]byte, error) {
+	return scale_codec.MarshalJSONObject([]string{})
+}
+
+func (s *Empty) UnmarshalJSON(data []byte) error {
+	_, err := scale_codec.UnmarshalJSONObject(data, []string{})
+	if err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func UnmarshalEmpty(reader io.Reader) (*Empty, error) {
+	s := new(Empty)
+	if err := s.UnmarshalSCALE(reader); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
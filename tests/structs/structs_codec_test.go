@@ -0,0 +1,138 @@
+// Code generated by scale_codec/enum_script. DO NOT EDIT.
+package main
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+// assertRoundTrip checks that unmarshal decodes the encoding of value
+// back to a value deeply equal to it, without leaving bytes behind
+func assertRoundTrip[T scale_codec.Marshaler](t *testing.T, value T, unmarshal func(io.Reader) (T, error)) {
+	t.Helper()
+
+	encoded, err := value.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("encoding %v: %v", value, err)
+	}
+
+	reader := bytes.NewReader(encoded)
+	decoded, err := unmarshal(reader)
+	if err != nil {
+		t.Fatalf("decoding %v: %v", encoded, err)
+	}
+
+	if reader.Len() != 0 {
+		t.Fatalf("%d bytes left after decoding %v", reader.Len(), encoded)
+	}
+
+	if !reflect.DeepEqual(value, decoded) {
+		t.Fatalf("\nexpected: %v\ngot: %v", value, decoded)
+	}
+}
+
+func randomT2[A scale_codec.Marshaler, B scale_codec.Marshaler](randomA scale_codec.RandomFunc[A], randomB scale_codec.RandomFunc[B]) scale_codec.RandomFunc[*T2[A, B]] {
+	return func(r *scale_codec.Random, depth int) *T2[A, B] {
+		return &T2[A, B]{F0: randomA(r, depth+1), F1: randomB(r, depth+1)}
+	}
+}
+
+func randomCall(r *scale_codec.Random, depth int) Call {
+	variants := []int{0, 1, 2, 3, 4, 5}
+	if r.Deep(depth) {
+		variants = []int{0, 4}
+	}
+
+	switch variants[r.Intn(len(variants))] {
+	case 0:
+		return NewRemark()
+	case 1:
+		return &Send{Inner: randomTransfer(r, depth+1)}
+	case 2:
+		return &Batch{Inner: randomT2(randomTransfer, scale_codec.RandomOption(randomAccountId))(r, depth+1)}
+	case 3:
+		return &Guarded{Inner: scale_codec.RandomResult(randomEmpty, randomCall)(r, depth+1)}
+	case 4:
+		return &Pair{
+			F0: scale_codec.RandomInteger[uint32](r, depth+1),
+			F1: scale_codec.RandomBool(r, depth+1),
+		}
+	default:
+		return &Move{
+			To: randomAccountId(r, depth+1),
+			Amount: scale_codec.RandomInteger[uint64](r, depth+1),
+			Reason: scale_codec.RandomOption(randomCall)(r, depth+1),
+		}
+	}
+}
+
+func randomAccountId(r *scale_codec.Random, depth int) *AccountId {
+	return &AccountId{
+		F0: scale_codec.RandomInteger[uint32](r, depth+1),
+		F1: scale_codec.RandomInteger[uint32](r, depth+1),
+	}
+}
+
+func randomTransfer(r *scale_codec.Random, depth int) *Transfer {
+	return &Transfer{
+		Dest: randomAccountId(r, depth+1),
+		Value: scale_codec.RandomInteger[uint64](r, depth+1),
+		Memo: scale_codec.RandomOption(randomT2(scale_codec.RandomInteger[uint8], scale_codec.RandomBool))(r, depth+1),
+	}
+}
+
+func randomEmpty(r *scale_codec.Random, depth int) *Empty {
+	return &Empty{}
+}
+
+func TestRoundTripCall(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomCall(r, 0), UnmarshalCall)
+	}
+}
+
+func FuzzUnmarshalCall(f *testing.F) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 16; idx++ {
+		encoded, err := randomCall(r, 0).MarshalSCALE()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(encoded)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		value, err := UnmarshalCall(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		assertRoundTrip(t, value, UnmarshalCall)
+	})
+}
+
+func TestRoundTripAccountId(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomAccountId(r, 0), UnmarshalAccountId)
+	}
+}
+
+func TestRoundTripTransfer(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomTransfer(r, 0), UnmarshalTransfer)
+	}
+}
+
+func TestRoundTripEmpty(t *testing.T) {
+	r := scale_codec.NewRandom(1)
+	for idx := 0; idx < 100; idx++ {
+		assertRoundTrip(t, randomEmpty(r, 0), UnmarshalEmpty)
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+func TestStructs(t *testing.T) {
+	transfer := &Transfer{
+		Dest: &AccountId{
+			F0: &scale_codec.Integer[uint32]{Value: 1},
+			F1: &scale_codec.Integer[uint32]{Value: 2},
+		},
+		Value: &scale_codec.Integer[uint64]{Value: 300},
+		Memo: scale_codec.SomeG[*T2[*scale_codec.Integer[uint8], *scale_codec.Bool]](
+			&T2[*scale_codec.Integer[uint8], *scale_codec.Bool]{
+				F0: &scale_codec.Integer[uint8]{Value: 7},
+				F1: &scale_codec.Bool{Value: true},
+			}),
+	}
+
+	cases := []struct {
+		value         Call
+		expectedBytes []byte
+	}{
+		{
+			value:         NewRemark(),
+			expectedBytes: []byte{0},
+		},
+		{
+			value: &Send{Inner: transfer},
+			expectedBytes: []byte{1,
+				1, 0, 0, 0, 2, 0, 0, 0,
+				44, 1, 0, 0, 0, 0, 0, 0,
+				1, 7, 1},
+		},
+		{
+			value: &Batch{
+				Inner: &T2[*Transfer, *scale_codec.OptionG[*AccountId]]{
+					F0: transfer,
+					F1: scale_codec.NoneG[*AccountId](),
+				},
+			},
+			expectedBytes: []byte{2,
+				1, 0, 0, 0, 2, 0, 0, 0,
+				44, 1, 0, 0, 0, 0, 0, 0,
+				1, 7, 1,
+				0},
+		},
+		{
+			value: &Guarded{
+				Inner: scale_codec.OkG[*Empty, Call](&Empty{}),
+			},
+			expectedBytes: []byte{3, 0},
+		},
+		{
+			value: &Guarded{
+				Inner: scale_codec.ErrG[*Empty, Call](NewRemark()),
+			},
+			expectedBytes: []byte{3, 1, 0},
+		},
+		{
+			value: &Pair{
+				F0: &scale_codec.Integer[uint32]{Value: 9},
+				F1: &scale_codec.Bool{Value: true},
+			},
+			expectedBytes: []byte{4, 9, 0, 0, 0, 1},
+		},
+		{
+			value: &Move{
+				To: &AccountId{
+					F0: &scale_codec.Integer[uint32]{Value: 3},
+					F1: &scale_codec.Integer[uint32]{Value: 4},
+				},
+				Amount: &scale_codec.Integer[uint64]{Value: 5},
+				Reason: scale_codec.SomeG[Call](NewRemark()),
+			},
+			expectedBytes: []byte{5,
+				3, 0, 0, 0, 4, 0, 0, 0,
+				5, 0, 0, 0, 0, 0, 0, 0,
+				1, 0},
+		},
+	}
+
+	for _, tt := range cases {
+		output, err := tt.value.MarshalSCALE()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !bytes.Equal(tt.expectedBytes, output) {
+			t.Fatalf("\nexpected: %v\nactual: %v", tt.expectedBytes, output)
+		}
+
+		decoded, err := UnmarshalCall(bytes.NewReader(tt.expectedBytes))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(tt.value, decoded) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.value, decoded)
+		}
+	}
+}
+
+func TestUnmarshalStruct(t *testing.T) {
+	input := []byte{1, 0, 0, 0, 2, 0, 0, 0, 44, 1, 0, 0, 0, 0, 0, 0, 0}
+	transfer, err := UnmarshalTransfer(bytes.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &Transfer{
+		Dest: &AccountId{
+			F0: &scale_codec.Integer[uint32]{Value: 1},
+			F1: &scale_codec.Integer[uint32]{Value: 2},
+		},
+		Value: &scale_codec.Integer[uint64]{Value: 300},
+		Memo:  scale_codec.NoneG[*T2[*scale_codec.Integer[uint8], *scale_codec.Bool]](),
+	}
+
+	if !reflect.DeepEqual(expected, transfer) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expected, transfer)
+	}
+}
@@ -0,0 +1,728 @@
+package scale_codec
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TranscodeToJSON reads a SCALE payload of the type described by desc from
+// reader and writes it to writer as JSON, with the conventions of
+// MarshalValueJSON: enums are `{"Variant": payload}`, None is null, integers
+// larger than a javascript number are hex strings and byte sequences 0x hex
+// strings. The JSON is written while the payload is read, no Value is built
+// and the bytes of byte sequences are copied in chunks, so large payloads
+// are never held in memory. The output is incomplete when an error occurs
+func TranscodeToJSON(desc *TypeDesc, reader io.Reader, writer io.Writer) error {
+	output := bufio.NewWriter(writer)
+	if err := transcodeToJSON(desc, reader, output); err != nil {
+		output.Flush()
+		return err
+	}
+
+	return output.Flush()
+}
+
+func transcodeToJSON(desc *TypeDesc, reader io.Reader, output *bufio.Writer) error {
+	switch desc.Kind {
+	case PrimitiveDesc, CompactDesc, BitSequenceDesc:
+		value, err := DecodeValue(desc, reader)
+		if err != nil {
+			return err
+		}
+
+		encoded, err := MarshalValueJSON(desc, value)
+		output.Write(encoded)
+		return err
+	case CompositeDesc:
+		return transcodeFieldsToJSON(desc.Fields, reader, output, desc.Newtype)
+	case EnumDesc:
+		enumTag := make([]byte, 1)
+		err := readFull(reader, enumTag)
+		if err != nil {
+			return err
+		}
+
+		variant := desc.variantIndexed(enumTag[0])
+		if variant == nil {
+			return fmt.Errorf("%w: %v", ErrWrongEnumTag, enumTag[0])
+		}
+
+		switch {
+		case desc.isOption() && len(variant.Fields) == 0:
+			output.WriteString("null")
+			return nil
+		case desc.isOption():
+			return transcodeToJSON(variant.Fields[0].Type, reader, output)
+		case desc.unitOnly():
+			encoded, err := json.Marshal(variant.Name)
+			output.Write(encoded)
+			return err
+		}
+
+		name := variant.Name
+		if desc.isResult() {
+			name = strings.ToLower(name)
+		}
+
+		encoded, err := json.Marshal(name)
+		if err != nil {
+			return err
+		}
+
+		output.WriteByte('{')
+		output.Write(encoded)
+		output.WriteByte(':')
+		if err := transcodeFieldsToJSON(variant.Fields, reader, output, true); err != nil {
+			return fmt.Errorf("decoding variant %s: %w", variant.Name, err)
+		}
+		output.WriteByte('}')
+		return nil
+	case SequenceDesc, SetDesc, MapDesc:
+		length, err := decodeCompactLength(reader)
+		if err != nil {
+			return err
+		}
+
+		if desc.Kind == MapDesc {
+			return transcodeMapToJSON(desc, length, reader, output)
+		}
+		return transcodeItemsToJSON(desc, length, reader, output)
+	case ArrayDesc:
+		return transcodeItemsToJSON(desc, uint64(desc.Len), reader, output)
+	default:
+		return fmt.Errorf("unexpected type kind: %d", desc.Kind)
+	}
+}
+
+// transcodeFieldsToJSON writes the fields of a composite as writeFieldsJSON
+// does, or as writePayloadJSON does when payload is set, a single unnamed
+// field being written as is
+func transcodeFieldsToJSON(descs []*FieldDesc, reader io.Reader, output *bufio.Writer, payload bool) error {
+	if payload && len(descs) == 1 && descs[0].Name == "" {
+		return transcodeToJSON(descs[0].Type, reader, output)
+	}
+
+	if len(descs) == 0 {
+		output.WriteString("null")
+		return nil
+	}
+
+	named := descs[0].Name != ""
+	closer := byte(']')
+	if named {
+		output.WriteByte('{')
+		closer = '}'
+	} else {
+		output.WriteByte('[')
+	}
+
+	for idx, desc := range descs {
+		if idx > 0 {
+			output.WriteByte(',')
+		}
+
+		if named {
+			encoded, err := json.Marshal(jsonFieldName(desc.Name))
+			if err != nil {
+				return err
+			}
+			output.Write(encoded)
+			output.WriteByte(':')
+		}
+
+		if err := transcodeToJSON(desc.Type, reader, output); err != nil {
+			return fmt.Errorf("decoding field %s: %w", fieldLabel(desc.Name, idx), err)
+		}
+	}
+
+	output.WriteByte(closer)
+	return nil
+}
+
+// transcodeItemsToJSON writes the items of a sequence, a set or an array,
+// the bytes of byte sequences are hex encoded while they are copied
+func transcodeItemsToJSON(desc *TypeDesc, length uint64, reader io.Reader, output *bufio.Writer) error {
+	if desc.isByteString() {
+		output.WriteString(`"0x`)
+		if err := copyBytes(hex.NewEncoder(output), reader, length); err != nil {
+			return err
+		}
+		output.WriteByte('"')
+		return nil
+	}
+
+	output.WriteByte('[')
+	for idx := uint64(0); idx < length; idx++ {
+		if idx > 0 {
+			output.WriteByte(',')
+		}
+
+		if err := transcodeToJSON(desc.Elem, reader, output); err != nil {
+			return fmt.Errorf("decoding item at index %v: %w", idx, err)
+		}
+	}
+	output.WriteByte(']')
+	return nil
+}
+
+// transcodeMapToJSON writes a map as writeMapJSON does, the keys
+// are decoded as values to be written as object keys
+func transcodeMapToJSON(desc *TypeDesc, length uint64, reader io.Reader, output *bufio.Writer) error {
+	keyDesc, valueDesc := desc.Elem.Fields[0].Type, desc.Elem.Fields[1].Type
+
+	output.WriteByte('{')
+	for idx := uint64(0); idx < length; idx++ {
+		if idx > 0 {
+			output.WriteByte(',')
+		}
+
+		key, err := DecodeValue(keyDesc, reader)
+		if err != nil {
+			return fmt.Errorf("decoding key at index %v: %w", idx, err)
+		}
+
+		encodedKey, err := MarshalValueJSON(keyDesc, key)
+		if err != nil {
+			return fmt.Errorf("decoding key at index %v: %w", idx, err)
+		}
+
+		encoded, err := json.Marshal(jsonMapKey(encodedKey))
+		if err != nil {
+			return err
+		}
+		output.Write(encoded)
+		output.WriteByte(':')
+
+		if err := transcodeToJSON(valueDesc, reader, output); err != nil {
+			return fmt.Errorf("decoding value at index %v: %w", idx, err)
+		}
+	}
+	output.WriteByte('}')
+	return nil
+}
+
+// TranscodeFromJSON reads a JSON value of the type described by desc from
+// reader, written with the conventions of MarshalValueJSON and accepting
+// what UnmarshalValueJSON accepts, and writes its SCALE encoding to writer.
+// The JSON is read token by token and encoded as it is read, except the
+// items of sequences and maps, which are held encoded until their count,
+// written first, is known, and the struct fields given before the fields
+// declared ahead of them. The output is incomplete when an error occurs
+func TranscodeFromJSON(desc *TypeDesc, reader io.Reader, writer io.Writer) error {
+	tokens := newJSONTokens(reader)
+	output := bufio.NewWriter(writer)
+	if err := transcodeFromJSON(desc, tokens, output); err != nil {
+		output.Flush()
+		return err
+	}
+
+	if _, err := tokens.decoder.Token(); !errors.Is(err, io.EOF) {
+		output.Flush()
+		return fmt.Errorf("%w: unexpected data after the value", ErrInvalidJSON)
+	}
+
+	return output.Flush()
+}
+
+func transcodeFromJSON(desc *TypeDesc, tokens *jsonTokens, output io.Writer) error {
+	switch desc.Kind {
+	case PrimitiveDesc, CompactDesc:
+		data, err := tokens.scalar()
+		if err != nil {
+			return err
+		}
+		return encodeJSONValue(desc, data, output)
+	case BitSequenceDesc:
+		if err := tokens.expectDelim('['); err != nil {
+			return err
+		}
+
+		bits := make([]bool, 0)
+		for tokens.more() {
+			token, err := tokens.token()
+			if err != nil {
+				return err
+			}
+
+			bit, ok := token.(bool)
+			if !ok {
+				return fmt.Errorf("%w: expected a boolean, got %v", ErrInvalidJSON, token)
+			}
+			bits = append(bits, bit)
+		}
+
+		if err := tokens.expectDelim(']'); err != nil {
+			return err
+		}
+
+		encoded, err := EncodeValue(desc, NewBitSequence(bits...))
+		if err != nil {
+			return err
+		}
+		_, err = output.Write(encoded)
+		return err
+	case CompositeDesc:
+		return transcodeFieldsFromJSON(desc.Fields, tokens, output, desc.Newtype)
+	case EnumDesc:
+		return transcodeVariantFromJSON(desc, tokens, output)
+	case SequenceDesc, ArrayDesc:
+		if desc.isByteString() {
+			data, err := tokens.scalar()
+			if err != nil {
+				return err
+			}
+			return encodeJSONValue(desc, data, output)
+		}
+
+		if err := tokens.expectDelim('['); err != nil {
+			return err
+		}
+
+		// the items of arrays are written as they are read, as their count is
+		// known, while the items of sequences follow their compact count
+		items := output
+		buffer := new(bytes.Buffer)
+		if desc.Kind == SequenceDesc {
+			items = buffer
+		}
+
+		count := 0
+		for ; tokens.more(); count++ {
+			if desc.Kind == ArrayDesc && count == desc.Len {
+				return fmt.Errorf("%w: want: %v items, got more", ErrUnexpectedLength, desc.Len)
+			}
+
+			if err := transcodeFromJSON(desc.Elem, tokens, items); err != nil {
+				return fmt.Errorf("decoding item at index %v: %w", count, err)
+			}
+		}
+
+		if err := tokens.expectDelim(']'); err != nil {
+			return err
+		}
+
+		if desc.Kind == ArrayDesc {
+			if count != desc.Len {
+				return fmt.Errorf("%w: want: %v items, got: %v", ErrUnexpectedLength, desc.Len, count)
+			}
+			return nil
+		}
+		return writeCountedItems(output, count, buffer)
+	case SetDesc:
+		return transcodeSetFromJSON(desc, tokens, output)
+	case MapDesc:
+		return transcodeMapFromJSON(desc, tokens, output)
+	default:
+		return fmt.Errorf("unexpected type kind: %d", desc.Kind)
+	}
+}
+
+// transcodeVariantFromJSON reads a variant as UnmarshalValueJSON does,
+// Option from null or its value and the other enums from `{"Name": payload}`
+// or `"Name"`, and writes its tag followed by its payload
+func transcodeVariantFromJSON(desc *TypeDesc, tokens *jsonTokens, output io.Writer) error {
+	if desc.isOption() {
+		token, err := tokens.peek()
+		if err != nil {
+			return err
+		}
+
+		if token == nil {
+			tokens.token()
+			_, err := output.Write([]byte{desc.Variants[0].Index})
+			return err
+		}
+
+		if _, err := output.Write([]byte{desc.Variants[1].Index}); err != nil {
+			return err
+		}
+		return transcodeFieldsFromJSON(desc.Variants[1].Fields, tokens, output, true)
+	}
+
+	token, err := tokens.token()
+	if err != nil {
+		return err
+	}
+
+	// the unit variants written as their name have a null payload
+	payload := tokens
+	name, isName := token.(string)
+	if isName {
+		payload = newJSONTokens(strings.NewReader("null"))
+	} else if token != json.Delim('{') {
+		return fmt.Errorf("%w: expected an object or a string, got %v", ErrInvalidJSON, token)
+	} else if name, err = tokens.key(); err != nil {
+		return err
+	}
+
+	variant := desc.variantNamed(name)
+	if desc.isResult() {
+		switch strings.ToLower(name) {
+		case "ok":
+			variant = desc.Variants[0]
+		case "err":
+			variant = desc.Variants[1]
+		}
+	}
+
+	if variant == nil {
+		return fmt.Errorf("%w: unknown variant %q", ErrInvalidJSON, name)
+	}
+
+	if _, err := output.Write([]byte{variant.Index}); err != nil {
+		return err
+	}
+
+	if len(variant.Fields) == 0 {
+		err = payload.skip()
+	} else {
+		err = transcodeFieldsFromJSON(variant.Fields, payload, output, true)
+	}
+
+	if err != nil {
+		return fmt.Errorf("decoding variant %s: %w", variant.Name, err)
+	}
+
+	if isName {
+		return nil
+	}
+
+	if tokens.more() {
+		return fmt.Errorf("%w: expected an object with a single variant", ErrInvalidJSON)
+	}
+	return tokens.expectDelim('}')
+}
+
+// transcodeFieldsFromJSON reads the fields of a composite as
+// unmarshalFieldsJSON does, or as unmarshalPayloadJSON does when
+// payload is set, a single unnamed field being read as is
+func transcodeFieldsFromJSON(descs []*FieldDesc, tokens *jsonTokens, output io.Writer, payload bool) error {
+	switch {
+	case payload && len(descs) == 1 && descs[0].Name == "":
+		return transcodeFromJSON(descs[0].Type, tokens, output)
+	case len(descs) == 0:
+		token, err := tokens.token()
+		if err != nil {
+			return err
+		}
+
+		if token != nil {
+			return fmt.Errorf("%w: expected null, got %v", ErrInvalidJSON, token)
+		}
+		return nil
+	case descs[0].Name == "":
+		if err := tokens.expectDelim('['); err != nil {
+			return err
+		}
+
+		for idx, desc := range descs {
+			if !tokens.more() {
+				return fmt.Errorf("%w: expected %d items, got %d", ErrInvalidJSON, len(descs), idx)
+			}
+
+			if err := transcodeFromJSON(desc.Type, tokens, output); err != nil {
+				return fmt.Errorf("decoding field %s: %w", fieldLabel(desc.Name, idx), err)
+			}
+		}
+
+		if tokens.more() {
+			return fmt.Errorf("%w: expected %d items, got more", ErrInvalidJSON, len(descs))
+		}
+		return tokens.expectDelim(']')
+	}
+
+	if err := tokens.expectDelim('{'); err != nil {
+		return err
+	}
+
+	positions := make(map[string]int, len(descs))
+	for idx, desc := range descs {
+		positions[jsonFieldName(desc.Name)] = idx
+	}
+
+	// the fields are written as soon as the fields declared before them
+	// are, the ones given earlier than that are held encoded until then
+	next := 0
+	pending := make(map[int]*bytes.Buffer)
+	for tokens.more() {
+		key, err := tokens.key()
+		if err != nil {
+			return err
+		}
+
+		idx, ok := positions[key]
+		if !ok {
+			return fmt.Errorf("%w: unknown field %q", ErrInvalidJSON, key)
+		}
+
+		if _, seen := pending[idx]; seen || idx < next {
+			return fmt.Errorf("%w: duplicate field %q", ErrInvalidJSON, key)
+		}
+
+		fieldOutput := output
+		if idx > next {
+			pending[idx] = new(bytes.Buffer)
+			fieldOutput = pending[idx]
+		}
+
+		if err := transcodeFromJSON(descs[idx].Type, tokens, fieldOutput); err != nil {
+			return fmt.Errorf("decoding field %s: %w", fieldLabel(descs[idx].Name, idx), err)
+		}
+
+		if idx > next {
+			continue
+		}
+
+		for next++; pending[next] != nil; next++ {
+			if _, err := pending[next].WriteTo(output); err != nil {
+				return err
+			}
+			delete(pending, next)
+		}
+	}
+
+	if next < len(descs) {
+		return fmt.Errorf("%w: missing field %q", ErrInvalidJSON, jsonFieldName(descs[next].Name))
+	}
+	return tokens.expectDelim('}')
+}
+
+// transcodeSetFromJSON reads a set from an array, the encoded items are
+// held until they are sorted without duplicates, as BTreeSet does, and
+// their count, written first, is known
+func transcodeSetFromJSON(desc *TypeDesc, tokens *jsonTokens, output io.Writer) error {
+	if err := tokens.expectDelim('['); err != nil {
+		return err
+	}
+
+	var items []*Value
+	var encodedItems []*bytes.Buffer
+	for count := 0; tokens.more(); count++ {
+		encoded := new(bytes.Buffer)
+		if err := transcodeFromJSON(desc.Elem, tokens, encoded); err != nil {
+			return fmt.Errorf("decoding item at index %v: %w", count, err)
+		}
+
+		// the item is decoded back to be compared with the others
+		item, err := DecodeValue(desc.Elem, bytes.NewReader(encoded.Bytes()))
+		if err != nil {
+			return fmt.Errorf("decoding item at index %v: %w", count, err)
+		}
+
+		items = append(items, item)
+		encodedItems = append(encodedItems, encoded)
+	}
+
+	if err := tokens.expectDelim(']'); err != nil {
+		return err
+	}
+
+	order := uniqueOrder(desc.Elem, items)
+	sorted := new(bytes.Buffer)
+	for _, idx := range order {
+		encodedItems[idx].WriteTo(sorted)
+	}
+	return writeCountedItems(output, len(order), sorted)
+}
+
+// transcodeMapFromJSON reads a map as unmarshalMapJSON does, the encoded
+// entries are held until they are sorted by their keys, as BTreeMap does,
+// and their count, written first, is known
+func transcodeMapFromJSON(desc *TypeDesc, tokens *jsonTokens, output io.Writer) error {
+	keyDesc, valueDesc := desc.Elem.Fields[0].Type, desc.Elem.Fields[1].Type
+	if err := tokens.expectDelim('{'); err != nil {
+		return err
+	}
+
+	var keys []*Value
+	var entries []*bytes.Buffer
+	for count := 0; tokens.more(); count++ {
+		name, err := tokens.key()
+		if err != nil {
+			return err
+		}
+
+		key, err := unmarshalMapKeyJSON(keyDesc, name)
+		if err != nil {
+			return fmt.Errorf("decoding key at index %v: %w", count, err)
+		}
+
+		encodedKey, err := EncodeValue(keyDesc, key)
+		if err != nil {
+			return fmt.Errorf("decoding key at index %v: %w", count, err)
+		}
+
+		entry := bytes.NewBuffer(encodedKey)
+		if err := transcodeFromJSON(valueDesc, tokens, entry); err != nil {
+			return fmt.Errorf("decoding value at index %v: %w", count, err)
+		}
+
+		keys = append(keys, key)
+		entries = append(entries, entry)
+	}
+
+	if err := tokens.expectDelim('}'); err != nil {
+		return err
+	}
+
+	order, err := keyOrder(keyDesc, keys)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+
+	sorted := new(bytes.Buffer)
+	for _, idx := range order {
+		entries[idx].WriteTo(sorted)
+	}
+	return writeCountedItems(output, len(order), sorted)
+}
+
+// writeCountedItems writes the compact count of the items followed by them
+func writeCountedItems(output io.Writer, count int, items *bytes.Buffer) error {
+	length, err := encodeCompactLength(count)
+	if err != nil {
+		return err
+	}
+
+	if _, err := output.Write(length); err != nil {
+		return err
+	}
+
+	_, err = items.WriteTo(output)
+	return err
+}
+
+// encodeJSONValue writes the SCALE encoding of data, the JSON text of a
+// primitive or a byte sequence, which are read at once
+func encodeJSONValue(desc *TypeDesc, data []byte, output io.Writer) error {
+	value, err := UnmarshalValueJSON(desc, data)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := EncodeValue(desc, value)
+	if err != nil {
+		return err
+	}
+
+	_, err = output.Write(encoded)
+	return err
+}
+
+// jsonTokens reads the tokens of a JSON document and allows
+// to look at the next one before reading it
+type jsonTokens struct {
+	decoder *json.Decoder
+	next    json.Token
+	peeked  bool
+}
+
+func newJSONTokens(reader io.Reader) *jsonTokens {
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+	return &jsonTokens{decoder: decoder}
+}
+
+func (t *jsonTokens) peek() (json.Token, error) {
+	if !t.peeked {
+		token, err := t.decoder.Token()
+		if err != nil {
+			return nil, jsonTokenError(err)
+		}
+		t.next, t.peeked = token, true
+	}
+
+	return t.next, nil
+}
+
+func (t *jsonTokens) token() (json.Token, error) {
+	token, err := t.peek()
+	t.next, t.peeked = nil, false
+	return token, err
+}
+
+// more reports whether the current array or object has more elements
+func (t *jsonTokens) more() bool {
+	if t.peeked {
+		return t.next != json.Delim(']') && t.next != json.Delim('}')
+	}
+
+	return t.decoder.More()
+}
+
+func (t *jsonTokens) expectDelim(delim json.Delim) error {
+	token, err := t.token()
+	if err != nil {
+		return err
+	}
+
+	if token != delim {
+		return fmt.Errorf("%w: expected '%v', got %v", ErrInvalidJSON, delim, token)
+	}
+
+	return nil
+}
+
+// key reads the key of an object entry
+func (t *jsonTokens) key() (string, error) {
+	token, err := t.token()
+	if err != nil {
+		return "", err
+	}
+
+	key, ok := token.(string)
+	if !ok {
+		return "", fmt.Errorf("%w: expected an object key, got %v", ErrInvalidJSON, token)
+	}
+
+	return key, nil
+}
+
+// scalar reads a string, a number, a boolean or null and returns its JSON text
+func (t *jsonTokens) scalar() ([]byte, error) {
+	token, err := t.token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token := token.(type) {
+	case json.Delim:
+		return nil, fmt.Errorf("%w: unexpected '%v'", ErrInvalidJSON, token)
+	case json.Number:
+		return []byte(token), nil
+	default:
+		return json.Marshal(token)
+	}
+}
+
+// skip reads a whole value, the payload of the variants without fields
+func (t *jsonTokens) skip() error {
+	depth := 0
+	for {
+		token, err := t.token()
+		if err != nil {
+			return err
+		}
+
+		switch token {
+		case json.Delim('['), json.Delim('{'):
+			depth++
+		case json.Delim(']'), json.Delim('}'):
+			depth--
+		}
+
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+func jsonTokenError(err error) error {
+	return fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+}
@@ -0,0 +1,212 @@
+package scale_codec
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestTranscode(t *testing.T) {
+	const src = `
+newtype AccountId([u8; 2])
+
+struct Transfer {
+	to_account: AccountId,
+	amounts: BTreeMap<String, u128>,
+	memo: Option<Bytes>,
+}
+
+enum Event {
+	Halted
+	Moved { from: u8, to: u8 }
+	Flags(Vec<bool>, ())
+	Paid(Result<Transfer, String>)
+}
+`
+	schema, err := ParseSchema("events.scale", strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transfer := append([]byte{0xaa, 0xbb, 4, 4, 'a'}, append([]byte{0xff}, bytes.Repeat([]byte{0xff}, 15)...)...)
+	cases := []struct {
+		expr     string
+		encoded  []byte
+		expected string
+		inputs   []string
+	}{
+		{
+			expr:     "Transfer",
+			encoded:  append(transfer, 1, 8, 'h', 'i'),
+			expected: `{"toAccount":"0xaabb","amounts":{"a":"0xffffffffffffffffffffffffffffffff"},"memo":"0x6869"}`,
+			inputs: []string{
+				`{"memo": "0x6869", "amounts": {"a": "340282366920938463463374607431768211455"}, "toAccount": "0xaabb"}`,
+			},
+		},
+		{
+			expr:     "Vec<Event>",
+			encoded:  []byte{16, 0, 1, 2, 3, 2, 8, 1, 0, 3, 1, 4, 'x'},
+			expected: `[{"Halted":null},{"Moved":{"from":2,"to":3}},{"Flags":[[true,false],null]},{"Paid":{"err":"x"}}]`,
+			inputs: []string{
+				`["Halted", {"Moved": {"to": 3, "from": 2}}, {"Flags": [[true, false], null]}, {"Paid": {"Err": "x"}}]`,
+			},
+		},
+		{
+			expr:     "BTreeMap<u16, bool>",
+			encoded:  []byte{12, 1, 0, 1, 2, 0, 0, 0, 1, 0},
+			expected: `{"1":true,"2":false,"256":false}`,
+			inputs:   []string{`{"256":false,"2":false,"1":true}`},
+		},
+		{
+			expr:     "BTreeSet<u16>",
+			encoded:  []byte{12, 1, 0, 2, 0, 0, 1},
+			expected: `[1,2,256]`,
+			inputs:   []string{`[256,2,1,2]`},
+		},
+		{
+			expr:     "(Option<u64>, Option<bool>, Compact<u128>, [i16; 2])",
+			encoded:  []byte{0, 1, 0, 0x04, 0xff, 0xff, 2, 0},
+			expected: `[null,false,1,[-1,2]]`,
+		},
+	}
+
+	for _, tt := range cases {
+		desc, err := ParseTypeDesc(tt.expr, schema)
+		if err != nil {
+			t.Fatalf("describing %s: %v", tt.expr, err)
+		}
+
+		output := new(bytes.Buffer)
+		if err := TranscodeToJSON(desc, bytes.NewReader(tt.encoded), output); err != nil {
+			t.Fatalf("transcoding %s: %v", tt.expr, err)
+		}
+
+		if output.String() != tt.expected {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expected, output)
+		}
+
+		for _, input := range append(tt.inputs, tt.expected) {
+			encoded := new(bytes.Buffer)
+			if err := TranscodeFromJSON(desc, strings.NewReader(input), encoded); err != nil {
+				t.Fatalf("transcoding %s: %v", input, err)
+			}
+
+			if !bytes.Equal(encoded.Bytes(), tt.encoded) {
+				t.Fatalf("\nexpected: %v\ngot: %v", tt.encoded, encoded.Bytes())
+			}
+		}
+	}
+
+	// the bytes of large byte sequences are streamed to hex
+	payload := append([]byte{2, 0, 0x40, 0}, bytes.Repeat([]byte{0xab}, 1<<20)...)
+	output := new(bytes.Buffer)
+	if err := TranscodeToJSON(NewPrimitiveDesc("Bytes"), bytes.NewReader(payload), output); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `"0x` + strings.Repeat("ab", 1<<20) + `"`
+	if output.String() != expected {
+		t.Fatalf("\nexpected: %d bytes\ngot: %d bytes", len(expected), output.Len())
+	}
+
+	errorCases := []struct {
+		expr        string
+		input       string
+		expectedErr error
+	}{
+		{expr: "u8", input: "256", expectedErr: ErrUnexpectedInteger},
+		{expr: "[u8; 2]", input: `"0xaabbcc"`, expectedErr: ErrUnexpectedLength},
+		{expr: "[u16; 2]", input: `[1, 2, 3]`, expectedErr: ErrUnexpectedLength},
+		{expr: "Event", input: `{"Stopped":null}`, expectedErr: ErrInvalidJSON},
+		{expr: "Event", input: `{"Moved":{"from":1}}`, expectedErr: ErrInvalidJSON},
+		{expr: "Event", input: `{"Moved":{"from":1,"from":2,"to":3}}`, expectedErr: ErrInvalidJSON},
+		{expr: "Event", input: `{"Halted":null,"Moved":null}`, expectedErr: ErrInvalidJSON},
+		{expr: "(u8, bool)", input: `[1]`, expectedErr: ErrInvalidJSON},
+		{expr: "()", input: `[]`, expectedErr: ErrInvalidJSON},
+		{expr: "Vec<u8>", input: `"0x01" 2`, expectedErr: ErrInvalidJSON},
+		{expr: "Vec<u16>", input: `[1, 2`, expectedErr: ErrInvalidJSON},
+		{expr: "BTreeMap<u8, bool>", input: `{"1":true,"1":false}`, expectedErr: ErrUnexpectedValue},
+	}
+
+	for _, tt := range errorCases {
+		desc, err := ParseTypeDesc(tt.expr, schema)
+		if err != nil {
+			t.Fatalf("describing %s: %v", tt.expr, err)
+		}
+
+		err = TranscodeFromJSON(desc, strings.NewReader(tt.input), new(bytes.Buffer))
+		if !errors.Is(err, tt.expectedErr) {
+			t.Fatalf("%s\nexpected: %v\ngot: %v", tt.input, tt.expectedErr, err)
+		}
+	}
+
+	desc, err := ParseTypeDesc("Vec<Event>", schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = TranscodeToJSON(desc, bytes.NewReader([]byte{8, 0, 9}), new(bytes.Buffer))
+	if !errors.Is(err, ErrWrongEnumTag) {
+		t.Fatalf("\nexpected: %v\ngot: %v", ErrWrongEnumTag, err)
+	}
+
+	// a 2^64 - 1 length prefix does not fit in an int64
+	huge := []byte{0x13, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	for _, desc := range []*TypeDesc{NewSequenceDesc(NewPrimitiveDesc("u8")), NewPrimitiveDesc("Bytes")} {
+		err = TranscodeToJSON(desc, bytes.NewReader(huge), new(bytes.Buffer))
+		if !errors.Is(err, ErrUnexpectedLength) {
+			t.Fatalf("\nexpected: %v\ngot: %v", ErrUnexpectedLength, err)
+		}
+	}
+}
+
+func TestTranscodeShortReads(t *testing.T) {
+	cases := []struct {
+		expr     string
+		encoded  []byte
+		expected string
+	}{
+		{expr: "u32", encoded: []byte{1, 2, 3, 4}, expected: "67305985"},
+		{expr: "Vec<u16>", encoded: []byte{8, 1, 1, 2, 0}, expected: "[257, 2]"},
+		{expr: "u128", encoded: []byte{1, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0}, expected: "18446744073709551617"},
+		{expr: "Compact<u32>", encoded: []byte{2, 0, 1, 0}, expected: "16384"},
+		{expr: "i128", encoded: bytes.Repeat([]byte{0xff}, 16), expected: "-1"},
+		{expr: "bool", encoded: []byte{1}, expected: "true"},
+		{expr: "(u8, bool)", encoded: []byte{7, 0}, expected: "(7, false)"},
+		{expr: "Result<u8, bool>", encoded: []byte{1, 1}, expected: "Err(true)"},
+	}
+
+	// the readers return fewer bytes than asked for, as network streams do,
+	// or return io.EOF along with the last bytes
+	readers := []func(io.Reader) io.Reader{iotest.OneByteReader, iotest.HalfReader, iotest.DataErrReader}
+	for _, tt := range cases {
+		desc, err := ParseTypeDesc(tt.expr, nil)
+		if err != nil {
+			t.Fatalf("describing %s: %v", tt.expr, err)
+		}
+
+		expected := new(bytes.Buffer)
+		if err := TranscodeToJSON(desc, bytes.NewReader(tt.encoded), expected); err != nil {
+			t.Fatalf("transcoding %s: %v", tt.expr, err)
+		}
+
+		for _, reader := range readers {
+			value, err := DecodeValue(desc, reader(bytes.NewReader(tt.encoded)))
+			if err != nil || value.String() != tt.expected {
+				t.Fatalf("%s\nexpected: %v\ngot: %v, %v", tt.expr, tt.expected, value, err)
+			}
+
+			output := new(bytes.Buffer)
+			if err := TranscodeToJSON(desc, reader(bytes.NewReader(tt.encoded)), output); err != nil {
+				t.Fatalf("transcoding %s: %v", tt.expr, err)
+			}
+
+			if output.String() != expected.String() {
+				t.Fatalf("%s\nexpected: %v\ngot: %v", tt.expr, expected, output)
+			}
+		}
+	}
+}
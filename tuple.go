@@ -49,3 +49,33 @@ func (t *Tuple) FieldAccess(at int) Encodable {
 
 	return t.Items[at]
 }
+
+// Unit is the empty tuple `()`, it has no encoded bytes
+type Unit struct{}
+
+func UnitFromRawBytes(_ io.Reader) (*Unit, error) {
+	return &Unit{}, nil
+}
+
+func (Unit) MarshalSCALE() ([]byte, error) {
+	return []byte{}, nil
+}
+
+func (*Unit) UnmarshalSCALE(_ io.Reader) error {
+	return nil
+}
+
+func (Unit) String() string {
+	return "()"
+}
+
+func (Unit) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+func (*Unit) UnmarshalJSON(data []byte) error {
+	if !isJSONNull(data) {
+		return fmt.Errorf("%w: expected null, got %s", ErrInvalidJSON, data)
+	}
+	return nil
+}
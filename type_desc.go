@@ -0,0 +1,176 @@
+package scale_codec
+
+import "fmt"
+
+// DescKind is the kind of a TypeDesc
+type DescKind int
+
+const (
+	PrimitiveDesc DescKind = iota
+	CompactDesc
+	CompositeDesc
+	EnumDesc
+	SequenceDesc
+	ArrayDesc
+	MapDesc
+	SetDesc
+	BitSequenceDesc
+)
+
+// TypeDesc describes a SCALE type at runtime, it drives DecodeValue and
+// EncodeValue when no Go type was generated for it. Like scale-info, a
+// few kinds describe every type: Option and Result are enums whose
+// variants are None and Some, Ok and Err, tuples and `()` are composites
+// whose fields have no names and Bytes is a sequence of u8. BTreeMap is
+// encoded as a sequence of (key, value) tuples and BTreeSet as a sequence
+// of its items, they have their own kinds as their entries and items are
+// sorted and unique and as they are written in JSON as an object and an
+// array. A recursive type is described by a TypeDesc reachable from itself
+type TypeDesc struct {
+	Kind DescKind
+	// Name is the name of the described struct, enum or newtype, it
+	// is empty for the anonymous types as tuples and sequences
+	Name string
+	// Primitive is bool, String or an integer, either with its Rust
+	// name u8...i128 or its Go name uint8...int128, it is the integer
+	// type of compacts
+	Primitive string
+	// Fields are the fields of a composite, unnamed for tuple structs
+	Fields []*FieldDesc
+	// Variants are the variants of an enum
+	Variants []*VariantDesc
+	// Elem is the item of sequences, sets and arrays and the (key, value) tuple of maps
+	Elem *TypeDesc
+	// Len is the length of arrays
+	Len int
+	// Newtype is set on the composite of a newtype, its single unnamed
+	// field, which is written in JSON as the newtype itself
+	Newtype bool
+}
+
+// FieldDesc is a field of a composite or of a variant
+type FieldDesc struct {
+	Name string
+	Type *TypeDesc
+}
+
+// VariantDesc is an enum variant, Index is its encoded tag
+type VariantDesc struct {
+	Name   string
+	Index  uint8
+	Fields []*FieldDesc
+}
+
+// NewPrimitiveDesc describes the bool, String, Bytes and integer primitives
+func NewPrimitiveDesc(name string) *TypeDesc {
+	if name == "Bytes" {
+		return NewSequenceDesc(NewPrimitiveDesc("u8"))
+	}
+
+	return &TypeDesc{Kind: PrimitiveDesc, Primitive: name}
+}
+
+// NewCompactDesc describes `Compact<T>`, T being the unsigned integer
+// primitive, u8...u128, whose range bounds the decoded and encoded values
+func NewCompactDesc(primitive string) *TypeDesc {
+	return &TypeDesc{Kind: CompactDesc, Primitive: primitive}
+}
+
+// NewTupleDesc describes a tuple, `()` when it has no items
+func NewTupleDesc(items ...*TypeDesc) *TypeDesc {
+	fields := make([]*FieldDesc, len(items))
+	for idx, item := range items {
+		fields[idx] = &FieldDesc{Type: item}
+	}
+
+	return &TypeDesc{Kind: CompositeDesc, Fields: fields}
+}
+
+// NewOptionDesc describes `Option<T>` as the None and Some(T) variants
+func NewOptionDesc(inner *TypeDesc) *TypeDesc {
+	return &TypeDesc{
+		Kind: EnumDesc,
+		Name: "Option",
+		Variants: []*VariantDesc{
+			{Name: "None", Index: 0},
+			{Name: "Some", Index: 1, Fields: []*FieldDesc{{Type: inner}}},
+		},
+	}
+}
+
+// NewResultDesc describes `Result<T, E>` as the Ok(T) and Err(E) variants
+func NewResultDesc(ok, err *TypeDesc) *TypeDesc {
+	return &TypeDesc{
+		Kind: EnumDesc,
+		Name: "Result",
+		Variants: []*VariantDesc{
+			{Name: "Ok", Index: 0, Fields: []*FieldDesc{{Type: ok}}},
+			{Name: "Err", Index: 1, Fields: []*FieldDesc{{Type: err}}},
+		},
+	}
+}
+
+// NewSequenceDesc describes `Vec<T>`
+func NewSequenceDesc(elem *TypeDesc) *TypeDesc {
+	return &TypeDesc{Kind: SequenceDesc, Elem: elem}
+}
+
+// NewSetDesc describes `BTreeSet<T>`, encoded as a sequence of its items
+// sorted in their Rust order and without duplicates
+func NewSetDesc(elem *TypeDesc) *TypeDesc {
+	return &TypeDesc{Kind: SetDesc, Elem: elem}
+}
+
+// NewArrayDesc describes `[T; N]`
+func NewArrayDesc(length int, elem *TypeDesc) *TypeDesc {
+	return &TypeDesc{Kind: ArrayDesc, Elem: elem, Len: length}
+}
+
+// NewMapDesc describes `BTreeMap<K, V>`, whose entries are (K, V) tuples
+func NewMapDesc(key, value *TypeDesc) *TypeDesc {
+	return &TypeDesc{Kind: MapDesc, Elem: NewTupleDesc(key, value)}
+}
+
+// NewBitSequenceDesc describes `BitVec<u8, Lsb0>`, its compact encoded
+// length in bits followed by the bits packed in bytes, least significant
+// bit first, which is the only bit order and store decoded
+func NewBitSequenceDesc() *TypeDesc {
+	return &TypeDesc{Kind: BitSequenceDesc}
+}
+
+// variantNamed returns the variant declared with name, or nil
+func (t *TypeDesc) variantNamed(name string) *VariantDesc {
+	for _, variant := range t.Variants {
+		if variant.Name == name {
+			return variant
+		}
+	}
+
+	return nil
+}
+
+// variantIndexed returns the variant whose tag is index, or nil
+func (t *TypeDesc) variantIndexed(index uint8) *VariantDesc {
+	for _, variant := range t.Variants {
+		if variant.Index == index {
+			return variant
+		}
+	}
+
+	return nil
+}
+
+// valueVariant returns the variant of value, looked up by its
+// name unless it is empty and by its index otherwise
+func (t *TypeDesc) valueVariant(value *Value) (*VariantDesc, error) {
+	variant := t.variantIndexed(value.Index)
+	if value.Name != "" {
+		variant = t.variantNamed(value.Name)
+	}
+
+	if variant == nil {
+		return nil, fmt.Errorf("%w: unknown variant %v", ErrUnexpectedValue, value)
+	}
+
+	return variant, nil
+}
@@ -0,0 +1,213 @@
+package scale_codec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxInstanceDepth bounds the nesting of generic instances while describing
+// a type, a generic type instantiating itself with growing type arguments,
+// like `enum Tree<T> { Leaf(T), Node(Tree<(T, T)>) }`, has no finite description
+const maxInstanceDepth = 64
+
+// ParseTypeExpr parses a type expression written as in a .scale file, such
+// as `Vec<(u32, Option<bool>)>`, `Result<common.AccountId, String>` or
+// `Call`. The syntax errors are reported at `<input>:1:column`
+func ParseTypeExpr(expr string) (*TypeExpr, error) {
+	lexer := newLexer("", strings.NewReader(expr))
+	lexer.start = TYPEEXPR
+	yyParse(lexer)
+	return lexer.typeExpr, lexer.errs.err()
+}
+
+// ParseTypeDesc parses a type expression and describes it for DecodeValue
+// and EncodeValue, its named types are resolved in schema and in the schemas
+// it imports. schema is nil when the expression only uses built-in types
+func ParseTypeDesc(expr string, schema *Schema) (*TypeDesc, error) {
+	t, err := ParseTypeExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.TypeDesc(t)
+}
+
+// TypeDesc describes t, whose named types are declared by the schema or by
+// the schemas it imports. Structs are composites named after them, newtypes
+// composites with a single unnamed field, as in scale-info, and aliases are
+// described by the type they stand for. The descriptions of recursive types
+// reference themselves
+func (s *Schema) TypeDesc(t *TypeExpr) (*TypeDesc, error) {
+	d := &typeDescriber{instances: make(map[string]*TypeDesc)}
+	desc := d.describe(s, nil, t)
+	return desc, d.errs.err()
+}
+
+// typeDescriber describes the types of a schema, the instances of its
+// declarations are described once so recursive references share them
+type typeDescriber struct {
+	instances map[string]*TypeDesc
+	depth     int
+	errs      SchemaErrors
+}
+
+// describe returns the description of t referenced in scope, params are
+// the descriptions of the type parameters of the enclosing declaration
+func (d *typeDescriber) describe(scope *Schema, params map[string]*TypeDesc, t *TypeExpr) *TypeDesc {
+	args := make([]*TypeDesc, len(t.Args))
+	if t.Kind != NamedType {
+		for idx, arg := range t.Args {
+			args[idx] = d.describe(scope, params, arg)
+		}
+	}
+
+	switch t.Kind {
+	case PrimitiveType:
+		return NewPrimitiveDesc(t.Name)
+	case ParamType:
+		return params[t.Name]
+	case OptionType:
+		return NewOptionDesc(args[0])
+	case ResultType:
+		return NewResultDesc(args[0], args[1])
+	case TupleType:
+		return NewTupleDesc(args...)
+	case UnitType:
+		return NewTupleDesc()
+	case VecType:
+		return NewSequenceDesc(args[0])
+	case SetType:
+		return NewSetDesc(args[0])
+	case ArrayType:
+		return NewArrayDesc(t.Len, args[0])
+	case CompactType:
+		inner := args[0]
+		if inner == nil {
+			return nil
+		}
+
+		// aliases are resolved by now, as checkSchema expands them
+		if inner.Kind != PrimitiveDesc || !isUnsignedInteger(&TypeExpr{Kind: PrimitiveType, Name: inner.Primitive}) {
+			d.errs.add(t.Args[0].Pos, "Compact is only defined for unsigned integers, found %s", t.Args[0])
+			return nil
+		}
+		return NewCompactDesc(inner.Primitive)
+	case MapType:
+		return NewMapDesc(args[0], args[1])
+	case NamedType:
+		return d.describeNamed(scope, params, t)
+	default:
+		panic(fmt.Sprintf("unexpected type kind: %d", t.Kind))
+	}
+}
+
+// describeNamed describes the enum, struct, alias or newtype named by t
+func (d *typeDescriber) describeNamed(scope *Schema, params map[string]*TypeDesc, t *TypeExpr) *TypeDesc {
+	owner := scope
+	if t.Package != "" && scope != nil {
+		imp := scope.importNamed(t.Package)
+		if imp == nil || imp.Schema == nil {
+			d.errs.add(t.Pos, "undefined: %s", t.Package)
+			return nil
+		}
+		owner = imp.Schema
+	}
+
+	name := t.Name
+	if t.Package != "" {
+		name = t.Package + "." + t.Name
+	}
+
+	var typeParams []*TypeParam
+	var build func(desc *TypeDesc, params map[string]*TypeDesc)
+	switch {
+	case owner == nil:
+	case owner.alias(t.Name) != nil:
+		if len(t.Args) > 0 {
+			d.errs.add(t.Pos, "wrong number of type arguments for %s: got %d, want 0", name, len(t.Args))
+			return nil
+		}
+		return d.describe(owner, nil, owner.alias(t.Name).Type)
+	case owner.enum(t.Name) != nil:
+		enum := owner.enum(t.Name)
+		typeParams = enum.TypeParams
+		build = func(desc *TypeDesc, params map[string]*TypeDesc) {
+			desc.Kind = EnumDesc
+			desc.Variants = make([]*VariantDesc, len(enum.Variants))
+			for idx, variant := range enum.Variants {
+				fields := variant.Fields
+				if variant.Payload != nil {
+					fields = tupleFields([]*TypeExpr{variant.Payload})
+				}
+
+				desc.Variants[idx] = &VariantDesc{
+					Name:   variant.Name,
+					Index:  uint8(variant.Index),
+					Fields: d.describeFields(owner, params, fields),
+				}
+			}
+		}
+	case owner.structNamed(t.Name) != nil:
+		structDecl := owner.structNamed(t.Name)
+		typeParams = structDecl.TypeParams
+		build = func(desc *TypeDesc, params map[string]*TypeDesc) {
+			desc.Kind = CompositeDesc
+			desc.Fields = d.describeFields(owner, params, structDecl.Fields)
+		}
+	case owner.newtype(t.Name) != nil:
+		newtype := owner.newtype(t.Name)
+		build = func(desc *TypeDesc, params map[string]*TypeDesc) {
+			desc.Kind = CompositeDesc
+			desc.Fields = d.describeFields(owner, params, tupleFields([]*TypeExpr{newtype.Type}))
+			desc.Newtype = true
+		}
+	}
+
+	if build == nil {
+		d.errs.add(t.Pos, "undefined: %s", name)
+		return nil
+	}
+
+	if len(t.Args) != len(typeParams) {
+		d.errs.add(t.Pos, "wrong number of type arguments for %s: got %d, want %d",
+			name, len(t.Args), len(typeParams))
+		return nil
+	}
+
+	// the instances are keyed by their declaration and the descriptions
+	// of their type arguments, which are resolved in the referencing scope
+	key := fmt.Sprintf("%p.%s", owner, t.Name)
+	instanceParams := make(map[string]*TypeDesc, len(typeParams))
+	for idx, param := range typeParams {
+		arg := d.describe(scope, params, t.Args[idx])
+		instanceParams[param.Name] = arg
+		key += fmt.Sprintf(",%p", arg)
+	}
+
+	if desc, ok := d.instances[key]; ok {
+		return desc
+	}
+
+	if d.depth == maxInstanceDepth {
+		d.errs.add(t.Pos, "type %s is nested too deeply", name)
+		return nil
+	}
+
+	desc := &TypeDesc{Name: t.Name}
+	d.instances[key] = desc
+
+	d.depth++
+	build(desc, instanceParams)
+	d.depth--
+
+	return desc
+}
+
+func (d *typeDescriber) describeFields(scope *Schema, params map[string]*TypeDesc, fields []*FieldDecl) []*FieldDesc {
+	descs := make([]*FieldDesc, len(fields))
+	for idx, field := range fields {
+		descs[idx] = &FieldDesc{Name: field.Name, Type: d.describe(scope, params, field.Type)}
+	}
+
+	return descs
+}
@@ -0,0 +1,207 @@
+package scale_codec
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseTypeExpr(t *testing.T) {
+	cases := []struct {
+		input         string
+		expected      string
+		expectedError string
+	}{
+		{input: "u32", expected: "u32"},
+		{input: "Vec<(u32, Option<bool>)>", expected: "Vec<(u32, Option<bool>)>"},
+		{input: " Result< [u8;4] ,()>", expected: "Result<[u8; 4], ()>"},
+		{input: "BTreeMap<String, Compact<u64>>", expected: "BTreeMap<String, Compact<u64>>"},
+		{input: "common.MaybeRef<BTreeSet<i8>>", expected: "common.MaybeRef<BTreeSet<i8>>"},
+		{
+			input:         "Vec<u32",
+			expectedError: "<input>:1:8: expected '>' after Vec type, found end of file",
+		},
+		{
+			input:         "Option<bool, u8>",
+			expectedError: "<input>:1:12: expected '>' after Option type, found ','",
+		},
+		{
+			input:         "(u8, bool) u16",
+			expectedError: "<input>:1:12: unexpected 'u16'",
+		},
+		{
+			input:         "",
+			expectedError: "<input>:1:1: unexpected end of file",
+		},
+	}
+
+	for _, tt := range cases {
+		typeExpr, err := ParseTypeExpr(tt.input)
+		if tt.expectedError != "" {
+			if err == nil || err.Error() != tt.expectedError {
+				t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedError, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("parsing %q: %v", tt.input, err)
+		}
+
+		if typeExpr.String() != tt.expected {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expected, typeExpr)
+		}
+	}
+}
+
+func TestParseTypeDesc(t *testing.T) {
+	const src = `
+type Amount = Compact<u128>;
+
+newtype Id(u16)
+
+struct Pair<A, B>(A, B)
+
+enum Tree<T> {
+	Leaf(T)
+	@index(5) Node { left: Tree<T>, right: Tree<T> }
+}
+
+struct Payment {
+	to: Id,
+	amount: Amount,
+}
+`
+	schema, err := ParseSchema("payments.scale", strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		expr     string
+		encoded  []byte
+		expected string
+	}{
+		{
+			expr:     "Vec<(u32, Option<bool>)>",
+			encoded:  []byte{4, 7, 0, 0, 0, 1, 0},
+			expected: "[(7, Some(false))]",
+		},
+		{
+			expr:     "Payment",
+			encoded:  []byte{1, 0, 2, 0, 0, 1},
+			expected: "Payment { to: Id(1), amount: 4194304 }",
+		},
+		{
+			expr:     "Tree<Pair<u8, Amount>>",
+			encoded:  []byte{5, 0, 1, 4, 0, 2, 8},
+			expected: "Node { left: Leaf(Pair(1, 1)), right: Leaf(Pair(2, 2)) }",
+		},
+		{
+			expr:     "Option<Id>",
+			encoded:  []byte{1, 2, 1},
+			expected: "Some(Id(258))",
+		},
+	}
+
+	for _, tt := range cases {
+		desc, err := ParseTypeDesc(tt.expr, schema)
+		if err != nil {
+			t.Fatalf("describing %s: %v", tt.expr, err)
+		}
+
+		value, err := DecodeValue(desc, bytes.NewReader(tt.encoded))
+		if err != nil {
+			t.Fatalf("decoding %s: %v", tt.expr, err)
+		}
+
+		if value.String() != tt.expected {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expected, value)
+		}
+
+		encoded, err := EncodeValue(desc, value)
+		if err != nil {
+			t.Fatalf("encoding %s: %v", tt.expr, err)
+		}
+
+		if !bytes.Equal(encoded, tt.encoded) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.encoded, encoded)
+		}
+	}
+
+	tree, err := ParseTypeDesc("Tree<u8>", schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	left := tree.variantNamed("Node").Fields[0].Type
+	if left != tree {
+		t.Fatalf("expected Tree<u8> to reference itself, got %p and %p", left, tree)
+	}
+
+	errorCases := []struct {
+		expr          string
+		schema        *Schema
+		expectedError string
+	}{
+		{
+			expr:          "Vec<Payment>",
+			expectedError: "<input>:1:5: undefined: Payment",
+		},
+		{
+			expr:   "(Tree, Amount<u8>)",
+			schema: schema,
+			expectedError: "<input>:1:2: wrong number of type arguments for Tree: got 0, want 1\n" +
+				"<input>:1:8: wrong number of type arguments for Amount: got 1, want 0",
+		},
+		{
+			expr: "(Compact<bool>, Compact<i32>)",
+			expectedError: "<input>:1:10: Compact is only defined for unsigned integers, found bool\n" +
+				"<input>:1:25: Compact is only defined for unsigned integers, found i32",
+		},
+		{
+			expr:          "common.AccountId",
+			schema:        schema,
+			expectedError: "<input>:1:1: undefined: common",
+		},
+	}
+
+	for _, tt := range errorCases {
+		_, err := ParseTypeDesc(tt.expr, tt.schema)
+		if err == nil || err.Error() != tt.expectedError {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedError, err)
+		}
+	}
+}
+
+func TestParseTypeDescImports(t *testing.T) {
+	path := filepath.Join("tests", "imports", "service", "service.scale")
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schema, err := ParseSchema(path, bytes.NewReader(contents))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	desc, err := ParseTypeDesc("Vec<common.MaybeRef<Call>>", schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// MaybeRef::Inline(Call::Sudo(Origin::Root, None))
+	encoded := []byte{4, 0, 1, 0, 0}
+	value, err := DecodeValue(desc, bytes.NewReader(encoded))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "[Inline(Sudo(Root, None))]"
+	if value.String() != expected {
+		t.Fatalf("\nexpected: %v\ngot: %v", expected, value)
+	}
+}
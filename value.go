@@ -0,0 +1,597 @@
+package scale_codec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/exp/constraints"
+)
+
+var ErrUnexpectedValue = errors.New("unexpected value")
+
+// ValueKind is the kind of a Value
+type ValueKind int
+
+const (
+	CompositeValue ValueKind = iota
+	VariantValue
+	SequenceValue
+	PrimitiveValue
+	BitSequenceValue
+)
+
+// Value is a SCALE value decoded without a Go type, as Rust scale-value
+// does, its shape is given by the TypeDesc it is decoded with. Structs
+// and tuples are composites, enums variants, sequences and arrays are
+// sequences, maps sequences of (key, value) composites, and the primitives
+// are held as bool, string and *big.Int
+type Value struct {
+	Kind ValueKind
+	// Name and Index identify the variant of a VariantValue, EncodeValue
+	// looks the variant up by Name unless it is empty. Name is also the
+	// struct name of a CompositeValue, when its description has one
+	Name  string
+	Index uint8
+	// Fields are the fields of composites and variants
+	Fields []ValueField
+	// Items are the items of sequences
+	Items []*Value
+	// Primitive is the bool, string or *big.Int of a PrimitiveValue
+	Primitive any
+	// Bits are the bits of a BitSequenceValue
+	Bits []bool
+}
+
+// ValueField is a field of a composite or of a variant,
+// the fields of tuples and tuple variants have no Name
+type ValueField struct {
+	Name  string
+	Value *Value
+}
+
+// NewComposite returns a struct or tuple value
+func NewComposite(fields ...ValueField) *Value {
+	return &Value{Kind: CompositeValue, Fields: fields}
+}
+
+// NewVariant returns the value of the enum variant name
+func NewVariant(name string, fields ...ValueField) *Value {
+	return &Value{Kind: VariantValue, Name: name, Fields: fields}
+}
+
+// Unnamed wraps values into the fields of a tuple or tuple variant
+func Unnamed(values ...*Value) []ValueField {
+	fields := make([]ValueField, len(values))
+	for idx, value := range values {
+		fields[idx] = ValueField{Value: value}
+	}
+
+	return fields
+}
+
+// NewSequence returns a sequence or array value
+func NewSequence(items ...*Value) *Value {
+	if items == nil {
+		items = []*Value{}
+	}
+
+	return &Value{Kind: SequenceValue, Items: items}
+}
+
+// NewPrimitive returns a primitive value from a bool, a string, a *big.Int
+// or a Go integer, which is stored as a *big.Int. It panics on other types
+func NewPrimitive(primitive any) *Value {
+	switch value := primitive.(type) {
+	case bool, string, *big.Int:
+		return &Value{Kind: PrimitiveValue, Primitive: value}
+	case int:
+		return NewPrimitive(big.NewInt(int64(value)))
+	case int8:
+		return NewPrimitive(big.NewInt(int64(value)))
+	case int16:
+		return NewPrimitive(big.NewInt(int64(value)))
+	case int32:
+		return NewPrimitive(big.NewInt(int64(value)))
+	case int64:
+		return NewPrimitive(big.NewInt(value))
+	case uint:
+		return NewPrimitive(new(big.Int).SetUint64(uint64(value)))
+	case uint8:
+		return NewPrimitive(new(big.Int).SetUint64(uint64(value)))
+	case uint16:
+		return NewPrimitive(new(big.Int).SetUint64(uint64(value)))
+	case uint32:
+		return NewPrimitive(new(big.Int).SetUint64(uint64(value)))
+	case uint64:
+		return NewPrimitive(new(big.Int).SetUint64(value))
+	default:
+		panic(fmt.Sprintf("unexpected primitive type: %T", primitive))
+	}
+}
+
+// NewBitSequence returns a bit sequence value
+func NewBitSequence(bits ...bool) *Value {
+	if bits == nil {
+		bits = []bool{}
+	}
+
+	return &Value{Kind: BitSequenceValue, Bits: bits}
+}
+
+// String formats the value as Rust debug does, `Transfer { to: 1, amount: 5 }`,
+// `(1, true)` or `[1, 2]`, strings are quoted and bit sequences are `<0110>`
+func (v *Value) String() string {
+	switch v.Kind {
+	case CompositeValue, VariantValue:
+		if len(v.Fields) == 0 && (v.Kind == VariantValue || v.Name != "") {
+			return v.Name
+		}
+
+		values := make([]any, len(v.Fields))
+		names := make([]string, len(v.Fields))
+		for idx, field := range v.Fields {
+			values[idx] = field.Value
+			names[idx] = field.Name
+		}
+
+		if len(v.Fields) == 0 || v.Fields[0].Name == "" {
+			return DebugTuple(v.Name, values...)
+		}
+		return strings.TrimPrefix(DebugStruct(v.Name, names, values...), " ")
+	case SequenceValue:
+		return debugList(v.Items)
+	case PrimitiveValue:
+		if text, ok := v.Primitive.(string); ok {
+			return strconv.Quote(text)
+		}
+		return fmt.Sprint(v.Primitive)
+	case BitSequenceValue:
+		digits := make([]byte, len(v.Bits))
+		for idx, bit := range v.Bits {
+			digits[idx] = '0'
+			if bit {
+				digits[idx] = '1'
+			}
+		}
+		return "<" + string(digits) + ">"
+	default:
+		return fmt.Sprintf("unexpected value kind: %d", v.Kind)
+	}
+}
+
+// DecodeValue decodes a value of the type described by desc from reader
+func DecodeValue(desc *TypeDesc, reader io.Reader) (*Value, error) {
+	switch desc.Kind {
+	case PrimitiveDesc:
+		codec, err := primitiveValueCodec(desc.Primitive)
+		if err != nil {
+			return nil, err
+		}
+		return codec.decode(reader)
+	case CompactDesc:
+		compact, err := CompactFromRawBytes(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		size, _, _ := primitiveIntegerSize(desc.Primitive)
+		value := compact.toBigInt()
+		if !integerFits(value, uint(size*8), false) {
+			return nil, fmt.Errorf("%w: %v does not fit in Compact<%s>", ErrUnexpectedInteger, value, desc.Primitive)
+		}
+		return NewPrimitive(value), nil
+	case CompositeDesc:
+		fields, err := decodeValueFields(desc.Fields, reader)
+		if err != nil {
+			return nil, err
+		}
+
+		value := NewComposite(fields...)
+		value.Name = desc.Name
+		return value, nil
+	case EnumDesc:
+		enumTag := make([]byte, 1)
+		err := readFull(reader, enumTag)
+		if err != nil {
+			return nil, err
+		}
+
+		variant := desc.variantIndexed(enumTag[0])
+		if variant == nil {
+			return nil, fmt.Errorf("%w: %v", ErrWrongEnumTag, enumTag[0])
+		}
+
+		fields, err := decodeValueFields(variant.Fields, reader)
+		if err != nil {
+			return nil, fmt.Errorf("decoding variant %s: %w", variant.Name, err)
+		}
+
+		value := NewVariant(variant.Name, fields...)
+		value.Index = variant.Index
+		return value, nil
+	case SequenceDesc, SetDesc, MapDesc:
+		length, err := decodeCompactLength(reader)
+		if err != nil {
+			return nil, err
+		}
+		return decodeValueItems(desc.Elem, length, reader)
+	case ArrayDesc:
+		return decodeValueItems(desc.Elem, uint64(desc.Len), reader)
+	case BitSequenceDesc:
+		length, err := decodeCompactLength(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		// the length is at most math.MaxInt64, rounding it up to bytes
+		// does not overflow, and the bits are sized from the bytes read
+		packed := new(bytes.Buffer)
+		if err := copyBytes(packed, reader, (length+7)/8); err != nil {
+			return nil, err
+		}
+
+		bits := make([]bool, 8*packed.Len())
+		for idx := range bits {
+			bits[idx] = packed.Bytes()[idx/8]&(1<<(idx%8)) != 0
+		}
+		return NewBitSequence(bits[:length]...), nil
+	default:
+		return nil, fmt.Errorf("unexpected type kind: %d", desc.Kind)
+	}
+}
+
+func decodeValueFields(descs []*FieldDesc, reader io.Reader) ([]ValueField, error) {
+	fields := make([]ValueField, len(descs))
+	for idx, field := range descs {
+		value, err := DecodeValue(field.Type, reader)
+		if err != nil {
+			return nil, fmt.Errorf("decoding field %s: %w", fieldLabel(field.Name, idx), err)
+		}
+
+		fields[idx] = ValueField{Name: field.Name, Value: value}
+	}
+
+	return fields, nil
+}
+
+func decodeValueItems(elem *TypeDesc, length uint64, reader io.Reader) (*Value, error) {
+	items := make([]*Value, 0)
+	for idx := uint64(0); idx < length; idx++ {
+		item, err := DecodeValue(elem, reader)
+		if err != nil {
+			return nil, fmt.Errorf("decoding item at index %v: %w", idx, err)
+		}
+
+		items = append(items, item)
+	}
+
+	return NewSequence(items...), nil
+}
+
+// EncodeValue encodes value as the type described by desc, the value
+// must have the shape of the description, named fields are matched by
+// name and unnamed ones by position
+func EncodeValue(desc *TypeDesc, value *Value) ([]byte, error) {
+	switch desc.Kind {
+	case PrimitiveDesc, CompactDesc:
+		if value.Kind != PrimitiveValue {
+			return nil, unexpectedValue("a primitive", value)
+		}
+
+		if desc.Kind == CompactDesc {
+			size, _, _ := primitiveIntegerSize(desc.Primitive)
+			integer, err := valueInteger(value.Primitive, uint(size*8), false)
+			if err != nil {
+				return nil, err
+			}
+			return Compact{Value: &CompactBigInt{Value: integer}}.MarshalSCALE()
+		}
+
+		codec, err := primitiveValueCodec(desc.Primitive)
+		if err != nil {
+			return nil, err
+		}
+		return codec.encode(value.Primitive)
+	case CompositeDesc:
+		if value.Kind != CompositeValue {
+			return nil, unexpectedValue("a composite", value)
+		}
+		return encodeValueFields(desc.Fields, value.Fields)
+	case EnumDesc:
+		if value.Kind != VariantValue {
+			return nil, unexpectedValue("a variant", value)
+		}
+
+		variant, err := desc.valueVariant(value)
+		if err != nil {
+			return nil, err
+		}
+
+		encodedFields, err := encodeValueFields(variant.Fields, value.Fields)
+		if err != nil {
+			return nil, fmt.Errorf("encoding variant %s: %w", variant.Name, err)
+		}
+		return append([]byte{variant.Index}, encodedFields...), nil
+	case SequenceDesc, SetDesc, ArrayDesc, MapDesc:
+		if value.Kind != SequenceValue {
+			return nil, unexpectedValue("a sequence", value)
+		}
+
+		if desc.Kind == ArrayDesc && len(value.Items) != desc.Len {
+			return nil, fmt.Errorf("%w: want: %v items, got: %v", ErrUnexpectedLength, desc.Len, len(value.Items))
+		}
+
+		encodedItems := make([][]byte, len(value.Items))
+		for idx, item := range value.Items {
+			encodedItem, err := EncodeValue(desc.Elem, item)
+			if err != nil {
+				return nil, fmt.Errorf("encoding item at index %v: %w", idx, err)
+			}
+
+			encodedItems[idx] = encodedItem
+		}
+
+		// the entries of maps are encoded in the order of their keys and
+		// the items of sets in their order without duplicates, as BTreeMap
+		// and BTreeSet do, whatever the order they are given in
+		order := make([]int, len(value.Items))
+		for idx := range order {
+			order[idx] = idx
+		}
+
+		switch desc.Kind {
+		case MapDesc:
+			keys, err := mapKeys(desc, value.Items)
+			if err != nil {
+				return nil, err
+			}
+
+			if order, err = keyOrder(desc.Elem.Fields[0].Type, keys); err != nil {
+				return nil, err
+			}
+		case SetDesc:
+			order = uniqueOrder(desc.Elem, value.Items)
+		}
+
+		output := make([]byte, 0)
+		if desc.Kind != ArrayDesc {
+			length, err := encodeCompactLength(len(order))
+			if err != nil {
+				return nil, err
+			}
+			output = length
+		}
+
+		for _, idx := range order {
+			output = append(output, encodedItems[idx]...)
+		}
+		return output, nil
+	case BitSequenceDesc:
+		if value.Kind != BitSequenceValue {
+			return nil, unexpectedValue("a bit sequence", value)
+		}
+
+		output, err := encodeCompactLength(len(value.Bits))
+		if err != nil {
+			return nil, err
+		}
+
+		packed := make([]byte, (len(value.Bits)+7)/8)
+		for idx, bit := range value.Bits {
+			if bit {
+				packed[idx/8] |= 1 << (idx % 8)
+			}
+		}
+		return append(output, packed...), nil
+	default:
+		return nil, fmt.Errorf("unexpected type kind: %d", desc.Kind)
+	}
+}
+
+func encodeValueFields(descs []*FieldDesc, fields []ValueField) ([]byte, error) {
+	values, err := matchValueFields(descs, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]byte, 0)
+	for idx, desc := range descs {
+		encodedField, err := EncodeValue(desc.Type, values[idx])
+		if err != nil {
+			return nil, fmt.Errorf("encoding field %s: %w", fieldLabel(desc.Name, idx), err)
+		}
+
+		output = append(output, encodedField...)
+	}
+
+	return output, nil
+}
+
+// matchValueFields returns the values of fields in the order of their
+// descriptions, named fields are matched by name and the others by position
+func matchValueFields(descs []*FieldDesc, fields []ValueField) ([]*Value, error) {
+	if len(fields) != len(descs) {
+		return nil, fmt.Errorf("%w: want: %v fields, got: %v", ErrUnexpectedLength, len(descs), len(fields))
+	}
+
+	values := make([]*Value, len(descs))
+	for idx, desc := range descs {
+		field := fields[idx]
+		if desc.Name != "" && field.Name != "" {
+			field = valueFieldNamed(fields, desc.Name)
+		}
+
+		if field.Value == nil {
+			return nil, fmt.Errorf("%w: missing field %s", ErrUnexpectedValue, fieldLabel(desc.Name, idx))
+		}
+
+		values[idx] = field.Value
+	}
+
+	return values, nil
+}
+
+// valueFieldNamed returns the field named name, or an empty field
+func valueFieldNamed(fields []ValueField, name string) ValueField {
+	for _, field := range fields {
+		if field.Name == name {
+			return field
+		}
+	}
+
+	return ValueField{}
+}
+
+// fieldLabel names the fields in errors, unnamed fields by their position
+func fieldLabel(name string, idx int) string {
+	if name == "" {
+		return strconv.Itoa(idx)
+	}
+
+	return name
+}
+
+func unexpectedValue(expected string, value *Value) error {
+	return fmt.Errorf("%w: expected %s, got %v", ErrUnexpectedValue, expected, value)
+}
+
+// valueCodec decodes and encodes the primitive values through the library codecs
+type valueCodec struct {
+	decode func(io.Reader) (*Value, error)
+	encode func(primitive any) ([]byte, error)
+}
+
+// primitiveValueCodec returns the codec of the primitive name
+func primitiveValueCodec(name string) (valueCodec, error) {
+	switch primitiveName(name) {
+	case "bool":
+		return valueCodec{
+			decode: func(reader io.Reader) (*Value, error) {
+				value, err := BoolFromRawBytes(reader)
+				if err != nil {
+					return nil, err
+				}
+				return NewPrimitive(value.Value), nil
+			},
+			encode: func(primitive any) ([]byte, error) {
+				value, ok := primitive.(bool)
+				if !ok {
+					return nil, fmt.Errorf("%w: expected a bool, got %v", ErrUnexpectedValue, primitive)
+				}
+				return Bool{Value: value}.MarshalSCALE()
+			},
+		}, nil
+	case "String":
+		return valueCodec{
+			decode: func(reader io.Reader) (*Value, error) {
+				value, err := StringFromRawBytes(reader)
+				if err != nil {
+					return nil, err
+				}
+				return NewPrimitive(value.Value), nil
+			},
+			encode: func(primitive any) ([]byte, error) {
+				value, ok := primitive.(string)
+				if !ok {
+					return nil, fmt.Errorf("%w: expected a string, got %v", ErrUnexpectedValue, primitive)
+				}
+				return String{Value: value}.MarshalSCALE()
+			},
+		}, nil
+	case "uint8":
+		return integerValueCodec[uint8](), nil
+	case "int8":
+		return integerValueCodec[int8](), nil
+	case "uint16":
+		return integerValueCodec[uint16](), nil
+	case "int16":
+		return integerValueCodec[int16](), nil
+	case "uint32":
+		return integerValueCodec[uint32](), nil
+	case "int32":
+		return integerValueCodec[int32](), nil
+	case "uint64":
+		return integerValueCodec[uint64](), nil
+	case "int64":
+		return integerValueCodec[int64](), nil
+	case "uint128":
+		return valueCodec{
+			decode: func(reader io.Reader) (*Value, error) {
+				value, err := U128FromRawBytes(reader)
+				if err != nil {
+					return nil, err
+				}
+				return NewPrimitive(value.ToBigInt()), nil
+			},
+			encode: func(primitive any) ([]byte, error) {
+				value, err := valueInteger(primitive, 128, false)
+				if err != nil {
+					return nil, err
+				}
+				return U128FromBigInt(value).MarshalSCALE()
+			},
+		}, nil
+	case "int128":
+		return valueCodec{
+			decode: func(reader io.Reader) (*Value, error) {
+				value, err := I128FromRawBytes(reader)
+				if err != nil {
+					return nil, err
+				}
+				return NewPrimitive(value.ToBigInt()), nil
+			},
+			encode: func(primitive any) ([]byte, error) {
+				value, err := valueInteger(primitive, 128, true)
+				if err != nil {
+					return nil, err
+				}
+				return I128FromBigInt(value).MarshalSCALE()
+			},
+		}, nil
+	default:
+		return valueCodec{}, fmt.Errorf("unexpected primitive: %s", name)
+	}
+}
+
+func integerValueCodec[T constraints.Integer]() valueCodec {
+	return valueCodec{
+		decode: func(reader io.Reader) (*Value, error) {
+			value, err := IntegerFromRawBytes[T](reader)
+			if err != nil {
+				return nil, err
+			}
+			return NewPrimitive(value.toBigInt()), nil
+		},
+		encode: func(primitive any) ([]byte, error) {
+			value, err := valueInteger(primitive, uint(8*unsafe.Sizeof(T(0))), isSigned[T]())
+			if err != nil {
+				return nil, err
+			}
+
+			if isSigned[T]() {
+				return Integer[T]{Value: T(value.Int64())}.MarshalSCALE()
+			}
+			return Integer[T]{Value: T(value.Uint64())}.MarshalSCALE()
+		},
+	}
+}
+
+// valueInteger returns the *big.Int of an integer primitive that fits in bits
+func valueInteger(primitive any, bits uint, signed bool) (*big.Int, error) {
+	value, ok := primitive.(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected an integer, got %v", ErrUnexpectedValue, primitive)
+	}
+
+	if !integerFits(value, bits, signed) {
+		return nil, fmt.Errorf("%w: %v does not fit in %d bits", ErrUnexpectedInteger, value, bits)
+	}
+
+	return value, nil
+}
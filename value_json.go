@@ -0,0 +1,589 @@
+package scale_codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MarshalValueJSON writes value, of the type described by desc, with the JSON
+// conventions of the generated types: variants are `{"Name": payload}`,
+// enums without data their variant name, Option null or its value, Result
+// `{"ok": ...}` or `{"err": ...}`, structs objects keyed by the camelCase
+// field names, tuples arrays, newtypes their inner value, maps objects,
+// byte sequences and arrays 0x hex strings and the integers numbers while
+// they fit in a javascript number and hex strings otherwise. Bit sequences
+// are arrays of booleans
+func MarshalValueJSON(desc *TypeDesc, value *Value) ([]byte, error) {
+	output := new(bytes.Buffer)
+	if err := writeValueJSON(output, desc, value); err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
+
+func writeValueJSON(output *bytes.Buffer, desc *TypeDesc, value *Value) error {
+	switch desc.Kind {
+	case PrimitiveDesc, CompactDesc:
+		if value.Kind != PrimitiveValue {
+			return unexpectedValue("a primitive", value)
+		}
+
+		size, signed, isInteger := integerSize(desc)
+		if !isInteger {
+			encoded, err := json.Marshal(value.Primitive)
+			output.Write(encoded)
+			return err
+		}
+
+		integer, err := valueInteger(value.Primitive, uint(size*8), signed)
+		if err != nil {
+			return err
+		}
+
+		encoded, err := marshalJSONInteger(integer, size)
+		output.Write(encoded)
+		return err
+	case CompositeDesc:
+		if value.Kind != CompositeValue {
+			return unexpectedValue("a composite", value)
+		}
+
+		values, err := matchValueFields(desc.Fields, value.Fields)
+		if err != nil {
+			return err
+		}
+
+		if desc.Newtype {
+			return writeValueJSON(output, desc.Fields[0].Type, values[0])
+		}
+		return writeFieldsJSON(output, desc.Fields, values)
+	case EnumDesc:
+		if value.Kind != VariantValue {
+			return unexpectedValue("a variant", value)
+		}
+
+		variant, err := desc.valueVariant(value)
+		if err != nil {
+			return err
+		}
+
+		values, err := matchValueFields(variant.Fields, value.Fields)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case desc.isOption():
+			if len(values) == 0 {
+				output.WriteString("null")
+				return nil
+			}
+			return writeValueJSON(output, variant.Fields[0].Type, values[0])
+		case desc.unitOnly():
+			return writeJSONString(output, variant.Name)
+		}
+
+		name := variant.Name
+		if desc.isResult() {
+			name = strings.ToLower(name)
+		}
+
+		output.WriteByte('{')
+		if err := writeJSONString(output, name); err != nil {
+			return err
+		}
+		output.WriteByte(':')
+
+		if err := writePayloadJSON(output, variant.Fields, values); err != nil {
+			return fmt.Errorf("encoding variant %s: %w", variant.Name, err)
+		}
+		output.WriteByte('}')
+		return nil
+	case SequenceDesc, SetDesc, ArrayDesc, MapDesc:
+		if value.Kind != SequenceValue {
+			return unexpectedValue("a sequence", value)
+		}
+
+		if desc.Kind == ArrayDesc && len(value.Items) != desc.Len {
+			return fmt.Errorf("%w: want: %v items, got: %v", ErrUnexpectedLength, desc.Len, len(value.Items))
+		}
+
+		switch {
+		case desc.Kind == MapDesc:
+			return writeMapJSON(output, desc, value)
+		case desc.isByteString():
+			encoded := make([]byte, len(value.Items))
+			for idx, item := range value.Items {
+				if item.Kind != PrimitiveValue {
+					return unexpectedValue("a byte", item)
+				}
+
+				integer, err := valueInteger(item.Primitive, 8, false)
+				if err != nil {
+					return fmt.Errorf("encoding item at index %v: %w", idx, err)
+				}
+				encoded[idx] = byte(integer.Uint64())
+			}
+
+			hexString, err := marshalJSONHex(encoded)
+			output.Write(hexString)
+			return err
+		}
+
+		output.WriteByte('[')
+		for idx, item := range value.Items {
+			if idx > 0 {
+				output.WriteByte(',')
+			}
+
+			if err := writeValueJSON(output, desc.Elem, item); err != nil {
+				return fmt.Errorf("encoding item at index %v: %w", idx, err)
+			}
+		}
+		output.WriteByte(']')
+		return nil
+	case BitSequenceDesc:
+		if value.Kind != BitSequenceValue {
+			return unexpectedValue("a bit sequence", value)
+		}
+
+		encoded, err := json.Marshal(value.Bits)
+		output.Write(encoded)
+		return err
+	default:
+		return fmt.Errorf("unexpected type kind: %d", desc.Kind)
+	}
+}
+
+// writeFieldsJSON writes the fields of a composite, an object when they
+// are named, an array when they are not and null for `()`
+func writeFieldsJSON(output *bytes.Buffer, descs []*FieldDesc, values []*Value) error {
+	if len(descs) == 0 {
+		output.WriteString("null")
+		return nil
+	}
+
+	named := descs[0].Name != ""
+	closer := byte(']')
+	if named {
+		output.WriteByte('{')
+		closer = '}'
+	} else {
+		output.WriteByte('[')
+	}
+
+	for idx, desc := range descs {
+		if idx > 0 {
+			output.WriteByte(',')
+		}
+
+		if named {
+			if err := writeJSONString(output, jsonFieldName(desc.Name)); err != nil {
+				return err
+			}
+			output.WriteByte(':')
+		}
+
+		if err := writeValueJSON(output, desc.Type, values[idx]); err != nil {
+			return fmt.Errorf("encoding field %s: %w", fieldLabel(desc.Name, idx), err)
+		}
+	}
+
+	output.WriteByte(closer)
+	return nil
+}
+
+// writePayloadJSON writes the fields of a variant, a single unnamed
+// field is written as is and the variants without fields as null
+func writePayloadJSON(output *bytes.Buffer, descs []*FieldDesc, values []*Value) error {
+	if len(descs) == 1 && descs[0].Name == "" {
+		return writeValueJSON(output, descs[0].Type, values[0])
+	}
+
+	return writeFieldsJSON(output, descs, values)
+}
+
+// writeMapJSON writes a map as an object in the order of its entries, keys
+// encoded as JSON strings are used as is and other keys by their JSON text
+func writeMapJSON(output *bytes.Buffer, desc *TypeDesc, value *Value) error {
+	keyDesc, valueDesc := desc.Elem.Fields[0].Type, desc.Elem.Fields[1].Type
+
+	output.WriteByte('{')
+	for idx, entry := range value.Items {
+		if idx > 0 {
+			output.WriteByte(',')
+		}
+
+		if entry.Kind != CompositeValue || len(entry.Fields) != 2 {
+			return unexpectedValue("a (key, value) entry", entry)
+		}
+
+		encodedKey, err := MarshalValueJSON(keyDesc, entry.Fields[0].Value)
+		if err != nil {
+			return fmt.Errorf("encoding key at index %v: %w", idx, err)
+		}
+
+		if err := writeJSONString(output, jsonMapKey(encodedKey)); err != nil {
+			return err
+		}
+		output.WriteByte(':')
+
+		if err := writeValueJSON(output, valueDesc, entry.Fields[1].Value); err != nil {
+			return fmt.Errorf("encoding value at index %v: %w", idx, err)
+		}
+	}
+	output.WriteByte('}')
+	return nil
+}
+
+// jsonMapKey returns the object key of a map key encoded as
+// encodedKey, the string itself or the JSON text of other keys
+func jsonMapKey(encodedKey []byte) string {
+	key := string(encodedKey)
+	if err := json.Unmarshal(encodedKey, &key); err != nil {
+		key = string(encodedKey)
+	}
+
+	return key
+}
+
+func writeJSONString(output *bytes.Buffer, text string) error {
+	encoded, err := json.Marshal(text)
+	output.Write(encoded)
+	return err
+}
+
+// UnmarshalValueJSON reads a value of the type described by desc from JSON
+// written with the conventions of MarshalValueJSON. Like the generated types,
+// unit variants are also read from `{"Name": null}`, enums without data from
+// `"Name"` and integers from decimal strings
+func UnmarshalValueJSON(desc *TypeDesc, data []byte) (*Value, error) {
+	switch desc.Kind {
+	case PrimitiveDesc, CompactDesc:
+		size, signed, isInteger := integerSize(desc)
+		if isInteger {
+			integer, err := unmarshalJSONInteger(data, size, signed)
+			if err != nil {
+				return nil, err
+			}
+			return NewPrimitive(integer), nil
+		}
+
+		switch primitiveName(desc.Primitive) {
+		case "bool":
+			var value bool
+			if err := json.Unmarshal(data, &value); err != nil {
+				return nil, fmt.Errorf("%w: expected a bool: %v", ErrInvalidJSON, err)
+			}
+			return NewPrimitive(value), nil
+		case "String":
+			var value string
+			if err := json.Unmarshal(data, &value); err != nil {
+				return nil, fmt.Errorf("%w: expected a string: %v", ErrInvalidJSON, err)
+			}
+			return NewPrimitive(value), nil
+		default:
+			return nil, fmt.Errorf("unexpected primitive: %s", desc.Primitive)
+		}
+	case CompositeDesc:
+		var fields []ValueField
+		var err error
+		if desc.Newtype {
+			fields, err = unmarshalPayloadJSON(desc.Fields, data)
+		} else {
+			fields, err = unmarshalFieldsJSON(desc.Fields, data)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		value := NewComposite(fields...)
+		value.Name = desc.Name
+		return value, nil
+	case EnumDesc:
+		if desc.isOption() {
+			if isJSONNull(data) {
+				return desc.variantValue(desc.Variants[0], nil), nil
+			}
+
+			fields, err := unmarshalPayloadJSON(desc.Variants[1].Fields, data)
+			if err != nil {
+				return nil, err
+			}
+			return desc.variantValue(desc.Variants[1], fields), nil
+		}
+
+		name, payload, err := UnmarshalJSONVariant(data)
+		if err != nil {
+			return nil, err
+		}
+
+		variant := desc.variantNamed(name)
+		if desc.isResult() {
+			switch strings.ToLower(name) {
+			case "ok":
+				variant = desc.Variants[0]
+			case "err":
+				variant = desc.Variants[1]
+			}
+		}
+
+		if variant == nil {
+			return nil, fmt.Errorf("%w: unknown variant %q", ErrInvalidJSON, name)
+		}
+
+		fields, err := unmarshalPayloadJSON(variant.Fields, payload)
+		if err != nil {
+			return nil, fmt.Errorf("decoding variant %s: %w", variant.Name, err)
+		}
+		return desc.variantValue(variant, fields), nil
+	case SequenceDesc, SetDesc, ArrayDesc:
+		length := -1
+		if desc.Kind == ArrayDesc {
+			length = desc.Len
+		}
+
+		if desc.isByteString() {
+			encoded, err := unmarshalJSONHex(data)
+			if err != nil {
+				return nil, err
+			}
+
+			if length >= 0 && len(encoded) != length {
+				return nil, fmt.Errorf("%w: want: %v bytes, got: %v", ErrUnexpectedLength, length, len(encoded))
+			}
+
+			items := make([]*Value, len(encoded))
+			for idx, item := range encoded {
+				items[idx] = NewPrimitive(item)
+			}
+			return NewSequence(items...), nil
+		}
+
+		var encodedItems []json.RawMessage
+		if err := json.Unmarshal(data, &encodedItems); err != nil {
+			return nil, fmt.Errorf("%w: expected an array: %v", ErrInvalidJSON, err)
+		}
+
+		if length >= 0 && len(encodedItems) != length {
+			return nil, fmt.Errorf("%w: want: %v items, got: %v", ErrUnexpectedLength, length, len(encodedItems))
+		}
+
+		items := make([]*Value, len(encodedItems))
+		for idx, encodedItem := range encodedItems {
+			item, err := UnmarshalValueJSON(desc.Elem, encodedItem)
+			if err != nil {
+				return nil, fmt.Errorf("decoding item at index %v: %w", idx, err)
+			}
+			items[idx] = item
+		}
+
+		// the items of sets are sorted without duplicates, as BTreeSet does
+		if desc.Kind == SetDesc {
+			order := uniqueOrder(desc.Elem, items)
+			unique := make([]*Value, len(order))
+			for idx, position := range order {
+				unique[idx] = items[position]
+			}
+			items = unique
+		}
+		return NewSequence(items...), nil
+	case MapDesc:
+		return unmarshalMapJSON(desc, data)
+	case BitSequenceDesc:
+		var bits []bool
+		if err := json.Unmarshal(data, &bits); err != nil {
+			return nil, fmt.Errorf("%w: expected an array of booleans: %v", ErrInvalidJSON, err)
+		}
+		return NewBitSequence(bits...), nil
+	default:
+		return nil, fmt.Errorf("unexpected type kind: %d", desc.Kind)
+	}
+}
+
+// unmarshalFieldsJSON reads the fields of a composite from
+// an object, an array or null, as writeFieldsJSON writes them
+func unmarshalFieldsJSON(descs []*FieldDesc, data []byte) ([]ValueField, error) {
+	if len(descs) == 0 {
+		if !isJSONNull(data) {
+			return nil, fmt.Errorf("%w: expected null, got %s", ErrInvalidJSON, data)
+		}
+		return []ValueField{}, nil
+	}
+
+	var items []json.RawMessage
+	var err error
+	if descs[0].Name != "" {
+		keys := make([]string, len(descs))
+		for idx, desc := range descs {
+			keys[idx] = jsonFieldName(desc.Name)
+		}
+		items, err = UnmarshalJSONObject(data, keys)
+	} else {
+		items, err = UnmarshalJSONTuple(data, len(descs))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]ValueField, len(descs))
+	for idx, desc := range descs {
+		value, err := UnmarshalValueJSON(desc.Type, items[idx])
+		if err != nil {
+			return nil, fmt.Errorf("decoding field %s: %w", fieldLabel(desc.Name, idx), err)
+		}
+		fields[idx] = ValueField{Name: desc.Name, Value: value}
+	}
+
+	return fields, nil
+}
+
+// unmarshalPayloadJSON reads the fields of a variant as writePayloadJSON
+// writes them, the payload of variants without fields is ignored
+func unmarshalPayloadJSON(descs []*FieldDesc, data []byte) ([]ValueField, error) {
+	switch {
+	case len(descs) == 0:
+		return []ValueField{}, nil
+	case len(descs) == 1 && descs[0].Name == "":
+		value, err := UnmarshalValueJSON(descs[0].Type, data)
+		if err != nil {
+			return nil, err
+		}
+		return Unnamed(value), nil
+	default:
+		return unmarshalFieldsJSON(descs, data)
+	}
+}
+
+// unmarshalMapJSON sorts the entries by their keys, as BTreeMap
+// does, object keys decoding to the same key are an error
+func unmarshalMapJSON(desc *TypeDesc, data []byte) (*Value, error) {
+	keyDesc, valueDesc := desc.Elem.Fields[0].Type, desc.Elem.Fields[1].Type
+	entries, err := unmarshalJSONEntries(data)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*Value, len(entries))
+	for idx, entry := range entries {
+		key, err := unmarshalMapKeyJSON(keyDesc, entry.key)
+		if err != nil {
+			return nil, fmt.Errorf("decoding key at index %v: %w", idx, err)
+		}
+
+		value, err := UnmarshalValueJSON(valueDesc, entry.value)
+		if err != nil {
+			return nil, fmt.Errorf("decoding value at index %v: %w", idx, err)
+		}
+
+		items[idx] = NewComposite(Unnamed(key, value)...)
+	}
+
+	keys, err := mapKeys(desc, items)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := keyOrder(keyDesc, keys)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+
+	sorted := make([]*Value, len(items))
+	for idx, position := range order {
+		sorted[idx] = items[position]
+	}
+	return NewSequence(sorted...), nil
+}
+
+// unmarshalMapKeyJSON reads a map key from an object key, as
+// a JSON string first and as JSON text when that fails
+func unmarshalMapKeyJSON(desc *TypeDesc, key string) (*Value, error) {
+	quotedKey, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := UnmarshalValueJSON(desc, quotedKey)
+	if err != nil && json.Valid([]byte(key)) {
+		value, err = UnmarshalValueJSON(desc, []byte(key))
+	}
+
+	return value, err
+}
+
+// variantValue returns the value of variant holding fields
+func (t *TypeDesc) variantValue(variant *VariantDesc, fields []ValueField) *Value {
+	value := NewVariant(variant.Name, fields...)
+	value.Index = variant.Index
+	return value
+}
+
+// isOption reports whether t is described by NewOptionDesc
+func (t *TypeDesc) isOption() bool {
+	return t.Name == "Option" && len(t.Variants) == 2 &&
+		t.Variants[0].Name == "None" && len(t.Variants[0].Fields) == 0 &&
+		t.Variants[1].Name == "Some" && len(t.Variants[1].Fields) == 1
+}
+
+// isResult reports whether t is described by NewResultDesc
+func (t *TypeDesc) isResult() bool {
+	return t.Name == "Result" && len(t.Variants) == 2 &&
+		t.Variants[0].Name == "Ok" && t.Variants[1].Name == "Err"
+}
+
+// unitOnly reports whether no variant of the enum carries data, the enums
+// generated as uint8 constants are written in JSON as their variant name
+func (t *TypeDesc) unitOnly() bool {
+	for _, variant := range t.Variants {
+		if len(variant.Fields) > 0 {
+			return false
+		}
+	}
+
+	return len(t.Variants) > 0
+}
+
+// isByte reports whether t is u8
+func (t *TypeDesc) isByte() bool {
+	return t.Kind == PrimitiveDesc && primitiveName(t.Primitive) == "uint8"
+}
+
+// isByteString reports whether t is a sequence or an array of u8, which
+// are written in JSON as hex strings, sets of u8 are arrays of numbers
+func (t *TypeDesc) isByteString() bool {
+	return (t.Kind == SequenceDesc || t.Kind == ArrayDesc) && t.Elem.isByte()
+}
+
+// integerSize returns the size in bytes of the integer primitives and
+// compact integers, isInteger is false for the bool and String primitives.
+// Compacts are written in JSON as 128 bits integers, as the Compact type
+func integerSize(desc *TypeDesc) (size int, signed bool, isInteger bool) {
+	if desc.Kind == CompactDesc {
+		return 16, false, true
+	}
+
+	return primitiveIntegerSize(desc.Primitive)
+}
+
+// primitiveIntegerSize returns the size in bytes of the integer primitive name
+func primitiveIntegerSize(primitive string) (size int, signed bool, isInteger bool) {
+	name := primitiveName(primitive)
+	digits, signed := strings.CutPrefix(name, "int")
+	if !signed {
+		digits = strings.TrimPrefix(name, "uint")
+	}
+
+	bits, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0, false, false
+	}
+
+	return bits / 8, signed, true
+}
@@ -0,0 +1,152 @@
+package scale_codec
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValueJSON(t *testing.T) {
+	const src = `
+newtype AccountId([u8; 2])
+
+struct Pair(u8, bool)
+
+struct Transfer {
+	to_account: AccountId,
+	amounts: BTreeMap<String, u128>,
+	pairs: BTreeMap<u8, Pair>,
+}
+
+enum Event {
+	Halted
+	Moved { from: u8, to: u8 }
+	Flags(Vec<bool>, ())
+}
+`
+	schema, err := ParseSchema("events.scale", strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		expr     string
+		encoded  []byte
+		expected string
+	}{
+		{
+			expr: "Transfer",
+			encoded: append(append([]byte{0xaa, 0xbb, 4, 4, 'a'}, append([]byte{1}, make([]byte, 15)...)...),
+				4, 7, 9, 1),
+			expected: `{"toAccount":"0xaabb","amounts":{"a":1},"pairs":{"7":[9,true]}}`,
+		},
+		{
+			expr:     "Vec<Event>",
+			encoded:  []byte{12, 0, 1, 2, 3, 2, 8, 1, 0},
+			expected: `[{"Halted":null},{"Moved":{"from":2,"to":3}},{"Flags":[[true,false],null]}]`,
+		},
+		{
+			expr:     "(Option<u64>, Compact<u128>, i64)",
+			encoded:  append([]byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 0x04}, bytes.Repeat([]byte{0xff}, 8)...),
+			expected: `[1,1,-1]`,
+		},
+	}
+
+	for _, tt := range cases {
+		desc, err := ParseTypeDesc(tt.expr, schema)
+		if err != nil {
+			t.Fatalf("describing %s: %v", tt.expr, err)
+		}
+
+		value, err := DecodeValue(desc, bytes.NewReader(tt.encoded))
+		if err != nil {
+			t.Fatalf("decoding %s: %v", tt.expr, err)
+		}
+
+		output, err := MarshalValueJSON(desc, value)
+		if err != nil {
+			t.Fatalf("encoding %s: %v", tt.expr, err)
+		}
+
+		if string(output) != tt.expected {
+			t.Fatalf("\nexpected: %v\ngot: %s", tt.expected, output)
+		}
+
+		fromJSON, err := UnmarshalValueJSON(desc, output)
+		if err != nil {
+			t.Fatalf("decoding %s: %v", output, err)
+		}
+
+		encoded, err := EncodeValue(desc, fromJSON)
+		if err != nil {
+			t.Fatalf("encoding %v: %v", fromJSON, err)
+		}
+
+		if !bytes.Equal(encoded, tt.encoded) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.encoded, encoded)
+		}
+	}
+
+	// maps are sorted by key as BTreeMap does
+	mapDesc, err := ParseTypeDesc("BTreeMap<u8, bool>", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	value, err := UnmarshalValueJSON(mapDesc, []byte(`{"2":false,"1":true}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if value.String() != "[(1, true), (2, false)]" {
+		t.Fatalf("\nexpected: [(1, true), (2, false)]\ngot: %v", value)
+	}
+
+	// sets are arrays, sorted and deduplicated as BTreeSet does
+	setDesc, err := ParseTypeDesc("BTreeSet<u8>", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	value, err = UnmarshalValueJSON(setDesc, []byte(`[2,1,2]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if value.String() != "[1, 2]" {
+		t.Fatalf("\nexpected: [1, 2]\ngot: %v", value)
+	}
+
+	bits := NewBitSequence(true, false, true)
+	output, err := MarshalValueJSON(NewBitSequenceDesc(), bits)
+	if err != nil || string(output) != "[true,false,true]" {
+		t.Fatalf("\nexpected: [true,false,true]\ngot: %s, %v", output, err)
+	}
+
+	errorCases := []struct {
+		expr        string
+		input       string
+		expectedErr error
+	}{
+		{expr: "u8", input: "256", expectedErr: ErrUnexpectedInteger},
+		{expr: "[u8; 2]", input: `"0xaabbcc"`, expectedErr: ErrUnexpectedLength},
+		{expr: "Event", input: `{"Stopped":null}`, expectedErr: ErrInvalidJSON},
+		{expr: "Event", input: `{"Moved":{"from":1}}`, expectedErr: ErrInvalidJSON},
+		{expr: "Pair", input: `[1]`, expectedErr: ErrInvalidJSON},
+		{expr: "()", input: `[]`, expectedErr: ErrInvalidJSON},
+		{expr: "BTreeMap<u8, bool>", input: `{"1":true,"1":false}`, expectedErr: ErrUnexpectedValue},
+	}
+
+	for _, tt := range errorCases {
+		desc, err := ParseTypeDesc(tt.expr, schema)
+		if err != nil {
+			t.Fatalf("describing %s: %v", tt.expr, err)
+		}
+
+		_, err = UnmarshalValueJSON(desc, []byte(tt.input))
+		if !errors.Is(err, tt.expectedErr) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedErr, err)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package scale_codec
+
+import (
+	"cmp"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// keyOrder returns the indexes of keys sorted as Rust orders them, the
+// order in which a BTreeMap encodes its entries. Equal keys are an error
+func keyOrder(desc *TypeDesc, keys []*Value) ([]int, error) {
+	order := make([]int, len(keys))
+	for idx := range order {
+		order[idx] = idx
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return compareValues(desc, keys[order[i]], keys[order[j]]) < 0
+	})
+
+	for idx := 1; idx < len(order); idx++ {
+		if compareValues(desc, keys[order[idx-1]], keys[order[idx]]) == 0 {
+			return nil, fmt.Errorf("%w: duplicate key %v", ErrUnexpectedValue, keys[order[idx]])
+		}
+	}
+
+	return order, nil
+}
+
+// uniqueOrder returns the indexes of items sorted as Rust orders them,
+// the order in which a BTreeSet encodes its items, without the items
+// equal to a previous one
+func uniqueOrder(desc *TypeDesc, items []*Value) []int {
+	order := make([]int, len(items))
+	for idx := range order {
+		order[idx] = idx
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return compareValues(desc, items[order[i]], items[order[j]]) < 0
+	})
+
+	unique := order[:0]
+	for _, idx := range order {
+		if len(unique) == 0 || compareValues(desc, items[unique[len(unique)-1]], items[idx]) != 0 {
+			unique = append(unique, idx)
+		}
+	}
+
+	return unique
+}
+
+// mapKeys returns the keys of the (key, value) entries of a map
+func mapKeys(desc *TypeDesc, entries []*Value) ([]*Value, error) {
+	keys := make([]*Value, len(entries))
+	for idx, entry := range entries {
+		if entry.Kind != CompositeValue {
+			return nil, unexpectedValue("a (key, value) entry", entry)
+		}
+
+		values, err := matchValueFields(desc.Elem.Fields, entry.Fields)
+		if err != nil {
+			return nil, fmt.Errorf("entry at index %v: %w", idx, err)
+		}
+		keys[idx] = values[0]
+	}
+
+	return keys, nil
+}
+
+// compareValues compares two values of the type described by desc as the
+// derived Ord of the Rust type does: integers by value, false before true,
+// strings by their bytes, composites field by field, enums by the position
+// of their variant and then by their fields, and the sequences item by item,
+// a sequence being before the longer ones it starts. The values are expected
+// to have the shape of desc, as checked by EncodeValue
+func compareValues(desc *TypeDesc, a, b *Value) int {
+	switch desc.Kind {
+	case PrimitiveDesc, CompactDesc:
+		return comparePrimitives(a.Primitive, b.Primitive)
+	case CompositeDesc:
+		return compareValueFields(desc.Fields, a.Fields, b.Fields)
+	case EnumDesc:
+		variantA, errA := desc.valueVariant(a)
+		variantB, errB := desc.valueVariant(b)
+		if errA != nil || errB != nil {
+			return 0
+		}
+
+		if variantA != variantB {
+			return cmp.Compare(variantPosition(desc, variantA), variantPosition(desc, variantB))
+		}
+		return compareValueFields(variantA.Fields, a.Fields, b.Fields)
+	case SequenceDesc, SetDesc, ArrayDesc, MapDesc:
+		for idx := 0; idx < len(a.Items) && idx < len(b.Items); idx++ {
+			if c := compareValues(desc.Elem, a.Items[idx], b.Items[idx]); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(len(a.Items), len(b.Items))
+	case BitSequenceDesc:
+		for idx := 0; idx < len(a.Bits) && idx < len(b.Bits); idx++ {
+			if c := compareBools(a.Bits[idx], b.Bits[idx]); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(len(a.Bits), len(b.Bits))
+	default:
+		return 0
+	}
+}
+
+func compareValueFields(descs []*FieldDesc, a, b []ValueField) int {
+	valuesA, errA := matchValueFields(descs, a)
+	valuesB, errB := matchValueFields(descs, b)
+	if errA != nil || errB != nil {
+		return 0
+	}
+
+	for idx, desc := range descs {
+		if c := compareValues(desc.Type, valuesA[idx], valuesB[idx]); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+func comparePrimitives(a, b any) int {
+	switch a := a.(type) {
+	case *big.Int:
+		if b, ok := b.(*big.Int); ok {
+			return a.Cmp(b)
+		}
+	case bool:
+		if b, ok := b.(bool); ok {
+			return compareBools(a, b)
+		}
+	case string:
+		if b, ok := b.(string); ok {
+			return strings.Compare(a, b)
+		}
+	}
+
+	return 0
+}
+
+func compareBools(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// variantPosition returns the position of variant in the enum declaration
+func variantPosition(desc *TypeDesc, variant *VariantDesc) int {
+	for idx, candidate := range desc.Variants {
+		if candidate == variant {
+			return idx
+		}
+	}
+
+	return -1
+}
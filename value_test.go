@@ -0,0 +1,336 @@
+package scale_codec_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/big"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+func TestDecodeValue(t *testing.T) {
+	transfer := &scale_codec.TypeDesc{
+		Kind: scale_codec.EnumDesc,
+		Name: "Call",
+		Variants: []*scale_codec.VariantDesc{
+			{Name: "Remark", Index: 0, Fields: []*scale_codec.FieldDesc{
+				{Type: scale_codec.NewPrimitiveDesc("Bytes")},
+			}},
+			{Name: "Transfer", Index: 3, Fields: []*scale_codec.FieldDesc{
+				{Name: "to", Type: scale_codec.NewArrayDesc(2, scale_codec.NewPrimitiveDesc("u8"))},
+				{Name: "amount", Type: scale_codec.NewCompactDesc("u128")},
+			}},
+			{Name: "Halt", Index: 4},
+		},
+	}
+
+	// a linked list, `enum List { Nil, Cons(u8, List) }`
+	list := &scale_codec.TypeDesc{Kind: scale_codec.EnumDesc, Name: "List"}
+	list.Variants = []*scale_codec.VariantDesc{
+		{Name: "Nil", Index: 0},
+		{Name: "Cons", Index: 1, Fields: []*scale_codec.FieldDesc{
+			{Type: scale_codec.NewPrimitiveDesc("u8")}, {Type: list},
+		}},
+	}
+
+	cases := []struct {
+		desc     *scale_codec.TypeDesc
+		encoded  []byte
+		expected string
+	}{
+		{
+			desc:     scale_codec.NewPrimitiveDesc("bool"),
+			encoded:  []byte{1},
+			expected: "true",
+		},
+		{
+			desc:     scale_codec.NewPrimitiveDesc("String"),
+			encoded:  []byte{8, 'h', 'i'},
+			expected: `"hi"`,
+		},
+		{
+			desc:     scale_codec.NewPrimitiveDesc("int16"),
+			encoded:  []byte{0xfe, 0xff},
+			expected: "-2",
+		},
+		{
+			desc:     scale_codec.NewPrimitiveDesc("u128"),
+			encoded:  append([]byte{1}, make([]byte, 15)...),
+			expected: "1",
+		},
+		{
+			desc:     scale_codec.NewPrimitiveDesc("i128"),
+			encoded:  bytes.Repeat([]byte{0xff}, 16),
+			expected: "-1",
+		},
+		{
+			desc:     scale_codec.NewOptionDesc(scale_codec.NewPrimitiveDesc("u32")),
+			encoded:  []byte{1, 5, 0, 0, 0},
+			expected: "Some(5)",
+		},
+		{
+			desc:     scale_codec.NewOptionDesc(scale_codec.NewPrimitiveDesc("u32")),
+			encoded:  []byte{0},
+			expected: "None",
+		},
+		{
+			desc: scale_codec.NewResultDesc(
+				scale_codec.NewTupleDesc(scale_codec.NewPrimitiveDesc("u64"), scale_codec.NewTupleDesc()),
+				scale_codec.NewPrimitiveDesc("String")),
+			encoded:  []byte{0, 5, 0, 0, 0, 0, 0, 0, 0},
+			expected: "Ok((5, ()))",
+		},
+		{
+			desc: scale_codec.NewMapDesc(
+				scale_codec.NewPrimitiveDesc("u8"), scale_codec.NewPrimitiveDesc("bool")),
+			encoded:  []byte{8, 1, 0, 2, 1},
+			expected: "[(1, false), (2, true)]",
+		},
+		{
+			desc:     transfer,
+			encoded:  []byte{3, 0xaa, 0xbb, 0x15, 0x01},
+			expected: "Transfer { to: [170, 187], amount: 69 }",
+		},
+		{
+			desc:     transfer,
+			encoded:  []byte{0, 8, 1, 2},
+			expected: "Remark([1, 2])",
+		},
+		{
+			desc:     transfer,
+			encoded:  []byte{4},
+			expected: "Halt",
+		},
+		{
+			desc:     list,
+			encoded:  []byte{1, 7, 1, 8, 0},
+			expected: "Cons(7, Cons(8, Nil))",
+		},
+		{
+			desc: &scale_codec.TypeDesc{
+				Kind: scale_codec.CompositeDesc,
+				Name: "Header",
+				Fields: []*scale_codec.FieldDesc{
+					{Name: "number", Type: scale_codec.NewPrimitiveDesc("u16")},
+					{Name: "flags", Type: scale_codec.NewBitSequenceDesc()},
+				},
+			},
+			encoded:  []byte{1, 0, 40, 0b0000_0101, 0b10},
+			expected: "Header { number: 1, flags: <1010000001> }",
+		},
+	}
+
+	for _, tt := range cases {
+		value, err := scale_codec.DecodeValue(tt.desc, bytes.NewReader(tt.encoded))
+		if err != nil {
+			t.Fatalf("decoding %v: %v", tt.encoded, err)
+		}
+
+		if value.String() != tt.expected {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expected, value)
+		}
+
+		encoded, err := scale_codec.EncodeValue(tt.desc, value)
+		if err != nil {
+			t.Fatalf("encoding %v: %v", value, err)
+		}
+
+		if !bytes.Equal(encoded, tt.encoded) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.encoded, encoded)
+		}
+	}
+}
+
+func TestEncodeValue(t *testing.T) {
+	header := &scale_codec.TypeDesc{
+		Kind: scale_codec.CompositeDesc,
+		Fields: []*scale_codec.FieldDesc{
+			{Name: "number", Type: scale_codec.NewPrimitiveDesc("u8")},
+			{Name: "parent", Type: scale_codec.NewOptionDesc(scale_codec.NewPrimitiveDesc("uint8"))},
+		},
+	}
+
+	entry := func(key int, value bool) *scale_codec.Value {
+		return scale_codec.NewComposite(scale_codec.Unnamed(
+			scale_codec.NewPrimitive(key), scale_codec.NewPrimitive(value))...)
+	}
+	boolMap := scale_codec.NewMapDesc(scale_codec.NewPrimitiveDesc("u16"), scale_codec.NewPrimitiveDesc("bool"))
+
+	cases := []struct {
+		desc        *scale_codec.TypeDesc
+		value       *scale_codec.Value
+		expected    []byte
+		expectedErr error
+	}{
+		{
+			// map entries are sorted by key as BTreeMap does, 256 after 1
+			// although its little endian encoding is lower
+			desc:     boolMap,
+			value:    scale_codec.NewSequence(entry(256, false), entry(2, false), entry(1, true)),
+			expected: []byte{12, 1, 0, 1, 2, 0, 0, 0, 1, 0},
+		},
+		{
+			desc:        boolMap,
+			value:       scale_codec.NewSequence(entry(1, true), entry(1, false)),
+			expectedErr: scale_codec.ErrUnexpectedValue,
+		},
+		{
+			// set items are sorted and deduplicated as BTreeSet does
+			desc: scale_codec.NewSetDesc(scale_codec.NewPrimitiveDesc("u8")),
+			value: scale_codec.NewSequence(scale_codec.NewPrimitive(3),
+				scale_codec.NewPrimitive(1), scale_codec.NewPrimitive(1)),
+			expected: []byte{8, 1, 3},
+		},
+		{
+			// named fields are matched by name whatever their order
+			desc: header,
+			value: scale_codec.NewComposite(
+				scale_codec.ValueField{Name: "parent", Value: scale_codec.NewVariant("Some",
+					scale_codec.Unnamed(scale_codec.NewPrimitive(uint8(9)))...)},
+				scale_codec.ValueField{Name: "number", Value: scale_codec.NewPrimitive(10)},
+			),
+			expected: []byte{10, 1, 9},
+		},
+		{
+			desc: header,
+			value: scale_codec.NewComposite(scale_codec.Unnamed(
+				scale_codec.NewPrimitive(10), scale_codec.NewVariant("None"))...),
+			expected: []byte{10, 0},
+		},
+		{
+			desc: header,
+			value: scale_codec.NewComposite(
+				scale_codec.ValueField{Name: "number", Value: scale_codec.NewPrimitive(10)},
+				scale_codec.ValueField{Name: "other", Value: scale_codec.NewVariant("None")},
+			),
+			expectedErr: scale_codec.ErrUnexpectedValue,
+		},
+		{
+			desc:        header,
+			value:       scale_codec.NewComposite(),
+			expectedErr: scale_codec.ErrUnexpectedLength,
+		},
+		{
+			desc:        scale_codec.NewPrimitiveDesc("u8"),
+			value:       scale_codec.NewPrimitive(256),
+			expectedErr: scale_codec.ErrUnexpectedInteger,
+		},
+		{
+			desc:        scale_codec.NewPrimitiveDesc("i8"),
+			value:       scale_codec.NewPrimitive(-129),
+			expectedErr: scale_codec.ErrUnexpectedInteger,
+		},
+		{
+			desc:     scale_codec.NewPrimitiveDesc("i64"),
+			value:    scale_codec.NewPrimitive(-1),
+			expected: bytes.Repeat([]byte{0xff}, 8),
+		},
+		{
+			desc:        scale_codec.NewCompactDesc("u128"),
+			value:       scale_codec.NewPrimitive(big.NewInt(-1)),
+			expectedErr: scale_codec.ErrUnexpectedInteger,
+		},
+		{
+			desc:        scale_codec.NewCompactDesc("u8"),
+			value:       scale_codec.NewPrimitive(300),
+			expectedErr: scale_codec.ErrUnexpectedInteger,
+		},
+		{
+			desc:        scale_codec.NewCompactDesc("u32"),
+			value:       scale_codec.NewPrimitive(uint64(1) << 32),
+			expectedErr: scale_codec.ErrUnexpectedInteger,
+		},
+		{
+			desc:     scale_codec.NewCompactDesc("u8"),
+			value:    scale_codec.NewPrimitive(255),
+			expected: []byte{0xfd, 0x03},
+		},
+		{
+			desc:        scale_codec.NewPrimitiveDesc("bool"),
+			value:       scale_codec.NewPrimitive("true"),
+			expectedErr: scale_codec.ErrUnexpectedValue,
+		},
+		{
+			desc:        scale_codec.NewOptionDesc(scale_codec.NewPrimitiveDesc("bool")),
+			value:       scale_codec.NewVariant("Maybe"),
+			expectedErr: scale_codec.ErrUnexpectedValue,
+		},
+		{
+			desc:        scale_codec.NewArrayDesc(2, scale_codec.NewPrimitiveDesc("u8")),
+			value:       scale_codec.NewSequence(scale_codec.NewPrimitive(1)),
+			expectedErr: scale_codec.ErrUnexpectedLength,
+		},
+		{
+			desc:        scale_codec.NewSequenceDesc(scale_codec.NewPrimitiveDesc("u8")),
+			value:       scale_codec.NewPrimitive(1),
+			expectedErr: scale_codec.ErrUnexpectedValue,
+		},
+	}
+
+	for _, tt := range cases {
+		encoded, err := scale_codec.EncodeValue(tt.desc, tt.value)
+		if !errors.Is(err, tt.expectedErr) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedErr, err)
+		}
+
+		if !bytes.Equal(encoded, tt.expected) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expected, encoded)
+		}
+	}
+}
+
+func TestDecodeValueErrors(t *testing.T) {
+	cases := []struct {
+		desc        *scale_codec.TypeDesc
+		encoded     []byte
+		expectedErr error
+	}{
+		{
+			desc:        scale_codec.NewOptionDesc(scale_codec.NewPrimitiveDesc("u8")),
+			encoded:     []byte{2},
+			expectedErr: scale_codec.ErrWrongEnumTag,
+		},
+		{
+			desc:        scale_codec.NewPrimitiveDesc("u32"),
+			encoded:     []byte{1, 2},
+			expectedErr: scale_codec.ErrUnexpectedReadBytes,
+		},
+		{
+			// the length prefix claims a huge sequence
+			desc:        scale_codec.NewPrimitiveDesc("Bytes"),
+			encoded:     []byte{0b11, 0xff, 0xff, 0xff, 0xff, 1},
+			expectedErr: io.EOF,
+		},
+		{
+			desc:        scale_codec.NewBitSequenceDesc(),
+			encoded:     []byte{40, 1},
+			expectedErr: scale_codec.ErrUnexpectedReadBytes,
+		},
+		{
+			// 2^64 - 1 bits, whose byte count overflowed to zero
+			desc:        scale_codec.NewBitSequenceDesc(),
+			encoded:     []byte{0x13, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			expectedErr: scale_codec.ErrUnexpectedLength,
+		},
+		{
+			desc:        scale_codec.NewBitSequenceDesc(),
+			encoded:     []byte{0x13, 0xf8, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f},
+			expectedErr: scale_codec.ErrUnexpectedReadBytes,
+		},
+		{
+			// 300 does not fit in Compact<u8>
+			desc:        scale_codec.NewCompactDesc("u8"),
+			encoded:     []byte{0xb1, 0x04},
+			expectedErr: scale_codec.ErrUnexpectedInteger,
+		},
+	}
+
+	for _, tt := range cases {
+		_, err := scale_codec.DecodeValue(tt.desc, bytes.NewReader(tt.encoded))
+		if !errors.Is(err, tt.expectedErr) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.expectedErr, err)
+		}
+	}
+}
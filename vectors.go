@@ -1 +1,205 @@
 package scale_codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// VecG is a sequence of T prefixed by its compact encoded length
+type VecG[T Marshaler] struct {
+	Items []T
+}
+
+func UnmarshalVecFromRawBytes[T Marshaler](
+	f func(io.Reader) (T, error)) func(reader io.Reader) (*VecG[T], error) {
+	return func(reader io.Reader) (*VecG[T], error) {
+		vec := &VecG[T]{}
+		err := vec.UnmarshalSCALE(reader, f)
+		if err != nil {
+			return nil, err
+		}
+		return vec, nil
+	}
+}
+
+func (v VecG[T]) MarshalSCALE() ([]byte, error) {
+	output, err := encodeCompactLength(len(v.Items))
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, item := range v.Items {
+		encodedItem, err := item.MarshalSCALE()
+		if err != nil {
+			return nil, fmt.Errorf("encoding item at index %v: %w", idx, err)
+		}
+
+		output = append(output, encodedItem...)
+	}
+
+	return output, nil
+}
+
+func (v *VecG[T]) UnmarshalSCALE(reader io.Reader, f func(io.Reader) (T, error)) error {
+	length, err := decodeCompactLength(reader)
+	if err != nil {
+		return err
+	}
+
+	v.Items = make([]T, 0)
+	for idx := uint64(0); idx < length; idx++ {
+		item, err := f(reader)
+		if err != nil {
+			return fmt.Errorf("decoding item at index %v: %w", idx, err)
+		}
+
+		v.Items = append(v.Items, item)
+	}
+
+	return nil
+}
+
+func UnmarshalVecFromJSON[T Marshaler](
+	f func([]byte) (T, error)) func(data []byte) (*VecG[T], error) {
+	return func(data []byte) (*VecG[T], error) {
+		vec := &VecG[T]{}
+		err := vec.UnmarshalJSONWith(data, f)
+		if err != nil {
+			return nil, err
+		}
+		return vec, nil
+	}
+}
+
+func (v VecG[T]) String() string {
+	return debugList(v.Items)
+}
+
+func (v VecG[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONItems(v.Items)
+}
+
+func (v *VecG[T]) UnmarshalJSON(data []byte) error {
+	return v.UnmarshalJSONWith(data, decodeJSON[T])
+}
+
+func (v *VecG[T]) UnmarshalJSONWith(data []byte, f func([]byte) (T, error)) (err error) {
+	v.Items, err = unmarshalJSONItems(data, -1, f)
+	return err
+}
+
+// ArrayG is a fixed size sequence, it is encoded without a length
+// prefix so the amount of items to decode is given by its length
+type ArrayG[T Marshaler] struct {
+	Items []T
+}
+
+func NewArrayG[T Marshaler](length int) *ArrayG[T] {
+	return &ArrayG[T]{Items: make([]T, length)}
+}
+
+func UnmarshalArrayFromRawBytes[T Marshaler](length int,
+	f func(io.Reader) (T, error)) func(reader io.Reader) (*ArrayG[T], error) {
+	return func(reader io.Reader) (*ArrayG[T], error) {
+		array := NewArrayG[T](length)
+		err := array.UnmarshalSCALE(reader, f)
+		if err != nil {
+			return nil, err
+		}
+		return array, nil
+	}
+}
+
+func (a ArrayG[T]) MarshalSCALE() ([]byte, error) {
+	output := make([]byte, 0)
+	for idx, item := range a.Items {
+		encodedItem, err := item.MarshalSCALE()
+		if err != nil {
+			return nil, fmt.Errorf("encoding item at index %v: %w", idx, err)
+		}
+
+		output = append(output, encodedItem...)
+	}
+
+	return output, nil
+}
+
+func (a *ArrayG[T]) UnmarshalSCALE(reader io.Reader, f func(io.Reader) (T, error)) (err error) {
+	for idx := range a.Items {
+		a.Items[idx], err = f(reader)
+		if err != nil {
+			return fmt.Errorf("decoding item at index %v: %w", idx, err)
+		}
+	}
+
+	return nil
+}
+
+func UnmarshalArrayFromJSON[T Marshaler](length int,
+	f func([]byte) (T, error)) func(data []byte) (*ArrayG[T], error) {
+	return func(data []byte) (*ArrayG[T], error) {
+		array := NewArrayG[T](length)
+		err := array.UnmarshalJSONWith(data, f)
+		if err != nil {
+			return nil, err
+		}
+		return array, nil
+	}
+}
+
+func (a ArrayG[T]) String() string {
+	return debugList(a.Items)
+}
+
+func (a ArrayG[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONItems(a.Items)
+}
+
+func (a *ArrayG[T]) UnmarshalJSON(data []byte) error {
+	return a.UnmarshalJSONWith(data, decodeJSON[T])
+}
+
+// UnmarshalJSONWith expects as many items as the array
+// length, unless the array was not allocated
+func (a *ArrayG[T]) UnmarshalJSONWith(data []byte, f func([]byte) (T, error)) (err error) {
+	length := -1
+	if a.Items != nil {
+		length = len(a.Items)
+	}
+
+	a.Items, err = unmarshalJSONItems(data, length, f)
+	return err
+}
+
+func marshalJSONItems[T Marshaler](items []T) ([]byte, error) {
+	if items == nil {
+		items = []T{}
+	}
+	return json.Marshal(items)
+}
+
+// unmarshalJSONItems decodes a JSON array of length
+// items, or of any length when length is negative
+func unmarshalJSONItems[T Marshaler](data []byte, length int,
+	f func([]byte) (T, error)) ([]T, error) {
+	var encodedItems []json.RawMessage
+	if err := json.Unmarshal(data, &encodedItems); err != nil {
+		return nil, fmt.Errorf("%w: expected an array: %v", ErrInvalidJSON, err)
+	}
+
+	if length >= 0 && len(encodedItems) != length {
+		return nil, fmt.Errorf("%w: want: %v items, got: %v", ErrUnexpectedLength, length, len(encodedItems))
+	}
+
+	items := make([]T, len(encodedItems))
+	for idx, encodedItem := range encodedItems {
+		item, err := f(encodedItem)
+		if err != nil {
+			return nil, fmt.Errorf("decoding item at index %v: %w", idx, err)
+		}
+		items[idx] = item
+	}
+
+	return items, nil
+}
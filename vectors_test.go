@@ -0,0 +1,89 @@
+package scale_codec_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	scale_codec "github.com/crypto2lab/scale-codec"
+)
+
+func TestVec(t *testing.T) {
+	cases := []struct {
+		vec     scale_codec.VecG[*scale_codec.Integer[uint16]]
+		encoded []byte
+	}{
+		{
+			vec:     scale_codec.VecG[*scale_codec.Integer[uint16]]{Items: []*scale_codec.Integer[uint16]{}},
+			encoded: []byte{0},
+		},
+		{
+			vec: scale_codec.VecG[*scale_codec.Integer[uint16]]{
+				Items: []*scale_codec.Integer[uint16]{{Value: 4}, {Value: 8}, {Value: 15}, {Value: 16}, {Value: 23}, {Value: 42}},
+			},
+			encoded: []byte{24, 4, 0, 8, 0, 15, 0, 16, 0, 23, 0, 42, 0},
+		},
+	}
+
+	for _, tt := range cases {
+		output, err := tt.vec.MarshalSCALE()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !bytes.Equal(tt.encoded, output) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.encoded, output)
+		}
+
+		unmarshal := scale_codec.UnmarshalVecFromRawBytes(scale_codec.IntegerFromRawBytes[uint16])
+		decoded, err := unmarshal(bytes.NewReader(tt.encoded))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(&tt.vec, decoded) {
+			t.Fatalf("\nexpected: %v\ngot: %v", tt.vec, decoded)
+		}
+	}
+}
+
+func TestVecWithCorruptedLength(t *testing.T) {
+	// the length prefix claims 2^30 items but there are no bytes left
+	unmarshal := scale_codec.UnmarshalVecFromRawBytes(scale_codec.IntegerFromRawBytes[uint8])
+	_, err := unmarshal(bytes.NewReader([]byte{3, 0, 0, 0, 64}))
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
+
+func TestArray(t *testing.T) {
+	array := scale_codec.NewArrayG[*scale_codec.Integer[uint16]](3)
+	array.Items[0] = &scale_codec.Integer[uint16]{Value: 1}
+	array.Items[1] = &scale_codec.Integer[uint16]{Value: 2}
+	array.Items[2] = &scale_codec.Integer[uint16]{Value: 3}
+
+	expected := []byte{1, 0, 2, 0, 3, 0}
+	output, err := array.MarshalSCALE()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(expected, output) {
+		t.Fatalf("\nexpected: %v\ngot: %v", expected, output)
+	}
+
+	unmarshal := scale_codec.UnmarshalArrayFromRawBytes(3, scale_codec.IntegerFromRawBytes[uint16])
+	decoded, err := unmarshal(bytes.NewReader(expected))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(array, decoded) {
+		t.Fatalf("\nexpected: %v\ngot: %v", array, decoded)
+	}
+
+	_, err = unmarshal(bytes.NewReader(expected[:4]))
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}